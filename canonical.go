@@ -0,0 +1,654 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sort"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// CanonicalEncodingVersion is the version of the canonical encoding.
+// It is the first byte of every canonical encoding,
+// and is incremented whenever the encoding changes.
+//
+// The canonical encoding is specified in docs/canonical-encoding-spec.md
+const CanonicalEncodingVersion byte = 1
+
+// CanonicalHashLength is the length of a canonical hash, in bytes
+const CanonicalHashLength = 32
+
+type CanonicalHash [CanonicalHashLength]byte
+
+func (h CanonicalHash) String() string {
+	return fmt.Sprintf("%x", h[:])
+}
+
+// Value tags
+const (
+	canonicalVoidTag       byte = 0x00
+	canonicalNilTag        byte = 0x01
+	canonicalSomeTag       byte = 0x02
+	canonicalBoolTag       byte = 0x03
+	canonicalStringTag     byte = 0x04
+	canonicalAddressTag    byte = 0x05
+	canonicalBytesTag      byte = 0x06
+	canonicalPathTag       byte = 0x07
+	canonicalCapabilityTag byte = 0x08
+	canonicalLinkTag       byte = 0x09
+	canonicalTypeValueTag  byte = 0x0a
+
+	canonicalArrayTag      byte = 0x10
+	canonicalDictionaryTag byte = 0x11
+	canonicalStructTag     byte = 0x12
+	canonicalResourceTag   byte = 0x13
+	canonicalEventTag      byte = 0x14
+	canonicalContractTag   byte = 0x15
+	canonicalEnumTag       byte = 0x16
+
+	canonicalIntTag    byte = 0x20
+	canonicalInt8Tag   byte = 0x21
+	canonicalInt16Tag  byte = 0x22
+	canonicalInt32Tag  byte = 0x23
+	canonicalInt64Tag  byte = 0x24
+	canonicalInt128Tag byte = 0x25
+	canonicalInt256Tag byte = 0x26
+
+	canonicalUIntTag    byte = 0x28
+	canonicalUInt8Tag   byte = 0x29
+	canonicalUInt16Tag  byte = 0x2a
+	canonicalUInt32Tag  byte = 0x2b
+	canonicalUInt64Tag  byte = 0x2c
+	canonicalUInt128Tag byte = 0x2d
+	canonicalUInt256Tag byte = 0x2e
+
+	canonicalWord8Tag  byte = 0x30
+	canonicalWord16Tag byte = 0x31
+	canonicalWord32Tag byte = 0x32
+	canonicalWord64Tag byte = 0x33
+
+	canonicalFix64Tag   byte = 0x38
+	canonicalUFix64Tag  byte = 0x39
+	canonicalFix128Tag  byte = 0x3a
+	canonicalUFix128Tag byte = 0x3b
+)
+
+// Type tags
+const (
+	canonicalSimpleTypeTag             byte = 0x80
+	canonicalOptionalTypeTag           byte = 0x81
+	canonicalVariableSizedArrayTypeTag byte = 0x82
+	canonicalConstantSizedArrayTypeTag byte = 0x83
+	canonicalDictionaryTypeTag         byte = 0x84
+	canonicalStructTypeTag             byte = 0x85
+	canonicalResourceTypeTag           byte = 0x86
+	canonicalEventTypeTag              byte = 0x87
+	canonicalContractTypeTag           byte = 0x88
+	canonicalEnumTypeTag               byte = 0x89
+	canonicalStructInterfaceTypeTag    byte = 0x8a
+	canonicalResourceInterfaceTypeTag  byte = 0x8b
+	canonicalContractInterfaceTypeTag  byte = 0x8c
+	canonicalFunctionTypeTag           byte = 0x8d
+	canonicalReferenceTypeTag          byte = 0x8e
+	canonicalRestrictedTypeTag         byte = 0x8f
+	canonicalCapabilityTypeTag         byte = 0x90
+	canonicalResourcePointerTypeTag    byte = 0x91
+	canonicalStructPointerTypeTag      byte = 0x92
+	canonicalEventPointerTypeTag       byte = 0x93
+)
+
+// EncodeCanonical returns the canonical encoding of the given value.
+//
+// The canonical encoding is deterministic: equal values have equal encodings,
+// independent of the order of dictionary entries and composite fields,
+// and independent of how the value was decoded (e.g. from JSON-CDC).
+func EncodeCanonical(value Value) ([]byte, error) {
+	encoder := newCanonicalEncoder()
+	encoder.buf.WriteByte(CanonicalEncodingVersion)
+
+	err := encoder.encodeValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return encoder.buf.Bytes(), nil
+}
+
+// EncodeCanonicalType returns the canonical encoding of the given type.
+func EncodeCanonicalType(t Type) ([]byte, error) {
+	encoder := newCanonicalEncoder()
+	encoder.buf.WriteByte(CanonicalEncodingVersion)
+
+	err := encoder.encodeType(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return encoder.buf.Bytes(), nil
+}
+
+// HashCanonical returns the SHA3-256 hash of the canonical encoding of the given value.
+func HashCanonical(value Value) (CanonicalHash, error) {
+	encoded, err := EncodeCanonical(value)
+	if err != nil {
+		return CanonicalHash{}, err
+	}
+
+	return sha3.Sum256(encoded), nil
+}
+
+// HashCanonicalType returns the SHA3-256 hash of the canonical encoding of the given type.
+func HashCanonicalType(t Type) (CanonicalHash, error) {
+	encoded, err := EncodeCanonicalType(t)
+	if err != nil {
+		return CanonicalHash{}, err
+	}
+
+	return sha3.Sum256(encoded), nil
+}
+
+type canonicalEncoder struct {
+	buf *bytes.Buffer
+}
+
+func newCanonicalEncoder() canonicalEncoder {
+	return canonicalEncoder{
+		buf: &bytes.Buffer{},
+	}
+}
+
+func (e canonicalEncoder) writeLength(length int) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], uint64(length))
+	e.buf.Write(b[:n])
+}
+
+func (e canonicalEncoder) writeBytes(b []byte) {
+	e.writeLength(len(b))
+	e.buf.Write(b)
+}
+
+func (e canonicalEncoder) writeString(s string) error {
+	if !utf8.ValidString(s) {
+		return fmt.Errorf("canonical encoding: invalid UTF-8 string: %q", s)
+	}
+	e.writeLength(len(s))
+	e.buf.WriteString(s)
+	return nil
+}
+
+// writeBigInt writes a sign byte (0 for non-negative integers, 1 for negative integers),
+// followed by the length-prefixed big-endian magnitude without leading zeros
+func (e canonicalEncoder) writeBigInt(i *big.Int, signed bool) error {
+	if i == nil {
+		return fmt.Errorf("canonical encoding: missing integer value")
+	}
+
+	sign := i.Sign()
+	if sign < 0 && !signed {
+		return fmt.Errorf("canonical encoding: negative unsigned integer: %s", i)
+	}
+
+	if sign < 0 {
+		e.buf.WriteByte(1)
+	} else {
+		e.buf.WriteByte(0)
+	}
+
+	// NOTE: big.Int.Bytes returns the absolute value, without leading zeros
+	e.writeBytes(i.Bytes())
+	return nil
+}
+
+func (e canonicalEncoder) writeFixedSize(tag byte, value NumberValue) {
+	e.buf.WriteByte(tag)
+	e.buf.Write(value.ToBigEndianBytes())
+}
+
+func (e canonicalEncoder) writePath(path Path) error {
+	err := e.writeString(path.Domain)
+	if err != nil {
+		return err
+	}
+	return e.writeString(path.Identifier)
+}
+
+func (e canonicalEncoder) encodeValue(value Value) error {
+	switch v := value.(type) {
+	case nil:
+		return fmt.Errorf("canonical encoding: missing value")
+
+	case Void:
+		e.buf.WriteByte(canonicalVoidTag)
+
+	case Optional:
+		if v.Value == nil {
+			e.buf.WriteByte(canonicalNilTag)
+			return nil
+		}
+		e.buf.WriteByte(canonicalSomeTag)
+		return e.encodeValue(v.Value)
+
+	case Bool:
+		e.buf.WriteByte(canonicalBoolTag)
+		if v {
+			e.buf.WriteByte(1)
+		} else {
+			e.buf.WriteByte(0)
+		}
+
+	case String:
+		e.buf.WriteByte(canonicalStringTag)
+		return e.writeString(string(v))
+
+	case Address:
+		e.buf.WriteByte(canonicalAddressTag)
+		e.buf.Write(v[:])
+
+	case Bytes:
+		e.buf.WriteByte(canonicalBytesTag)
+		e.writeBytes(v)
+
+	case Int:
+		e.buf.WriteByte(canonicalIntTag)
+		return e.writeBigInt(v.Value, true)
+	case Int8:
+		e.writeFixedSize(canonicalInt8Tag, v)
+	case Int16:
+		e.writeFixedSize(canonicalInt16Tag, v)
+	case Int32:
+		e.writeFixedSize(canonicalInt32Tag, v)
+	case Int64:
+		e.writeFixedSize(canonicalInt64Tag, v)
+	case Int128:
+		e.buf.WriteByte(canonicalInt128Tag)
+		return e.writeBigInt(v.Value, true)
+	case Int256:
+		e.buf.WriteByte(canonicalInt256Tag)
+		return e.writeBigInt(v.Value, true)
+
+	case UInt:
+		e.buf.WriteByte(canonicalUIntTag)
+		return e.writeBigInt(v.Value, false)
+	case UInt8:
+		e.writeFixedSize(canonicalUInt8Tag, v)
+	case UInt16:
+		e.writeFixedSize(canonicalUInt16Tag, v)
+	case UInt32:
+		e.writeFixedSize(canonicalUInt32Tag, v)
+	case UInt64:
+		e.writeFixedSize(canonicalUInt64Tag, v)
+	case UInt128:
+		e.buf.WriteByte(canonicalUInt128Tag)
+		return e.writeBigInt(v.Value, false)
+	case UInt256:
+		e.buf.WriteByte(canonicalUInt256Tag)
+		return e.writeBigInt(v.Value, false)
+
+	case Word8:
+		e.writeFixedSize(canonicalWord8Tag, v)
+	case Word16:
+		e.writeFixedSize(canonicalWord16Tag, v)
+	case Word32:
+		e.writeFixedSize(canonicalWord32Tag, v)
+	case Word64:
+		e.writeFixedSize(canonicalWord64Tag, v)
+
+	case Fix64:
+		e.writeFixedSize(canonicalFix64Tag, v)
+	case UFix64:
+		e.writeFixedSize(canonicalUFix64Tag, v)
+	case Fix128:
+		e.buf.WriteByte(canonicalFix128Tag)
+		return e.writeBigInt(v.Value, true)
+	case UFix128:
+		e.buf.WriteByte(canonicalUFix128Tag)
+		return e.writeBigInt(v.Value, false)
+
+	case Array:
+		e.buf.WriteByte(canonicalArrayTag)
+		e.writeLength(len(v.Values))
+		for _, element := range v.Values {
+			err := e.encodeValue(element)
+			if err != nil {
+				return err
+			}
+		}
+
+	case Dictionary:
+		return e.encodeDictionary(v)
+
+	case Struct:
+		if v.StructType == nil {
+			return fmt.Errorf("canonical encoding: missing struct type")
+		}
+		return e.encodeComposite(canonicalStructTag, v.StructType, v.Fields)
+
+	case Resource:
+		if v.ResourceType == nil {
+			return fmt.Errorf("canonical encoding: missing resource type")
+		}
+		return e.encodeComposite(canonicalResourceTag, v.ResourceType, v.Fields)
+
+	case Event:
+		if v.EventType == nil {
+			return fmt.Errorf("canonical encoding: missing event type")
+		}
+		return e.encodeComposite(canonicalEventTag, v.EventType, v.Fields)
+
+	case Contract:
+		if v.ContractType == nil {
+			return fmt.Errorf("canonical encoding: missing contract type")
+		}
+		return e.encodeComposite(canonicalContractTag, v.ContractType, v.Fields)
+
+	case Enum:
+		if v.EnumType == nil {
+			return fmt.Errorf("canonical encoding: missing enum type")
+		}
+		return e.encodeComposite(canonicalEnumTag, v.EnumType, v.Fields)
+
+	case Path:
+		e.buf.WriteByte(canonicalPathTag)
+		return e.writePath(v)
+
+	case Capability:
+		e.buf.WriteByte(canonicalCapabilityTag)
+		err := e.writePath(v.Path)
+		if err != nil {
+			return err
+		}
+		e.buf.Write(v.Address[:])
+		return e.writeString(v.BorrowType)
+
+	case Link:
+		e.buf.WriteByte(canonicalLinkTag)
+		err := e.writePath(v.TargetPath)
+		if err != nil {
+			return err
+		}
+		return e.writeString(v.BorrowType)
+
+	case TypeValue:
+		e.buf.WriteByte(canonicalTypeValueTag)
+		return e.writeString(v.StaticType)
+
+	default:
+		return fmt.Errorf("canonical encoding: unsupported value: %T", value)
+	}
+
+	return nil
+}
+
+type canonicalEntry struct {
+	key   []byte
+	value []byte
+}
+
+// sortCanonicalEntries sorts the given entries by their keys, bytewise,
+// and returns an error if any key occurs more than once
+func sortCanonicalEntries(entries []canonicalEntry, kind string) error {
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	for i := 1; i < len(entries); i++ {
+		if bytes.Equal(entries[i-1].key, entries[i].key) {
+			return fmt.Errorf("canonical encoding: duplicate %s", kind)
+		}
+	}
+
+	return nil
+}
+
+func (e canonicalEncoder) encodeDictionary(v Dictionary) error {
+	entries := make([]canonicalEntry, len(v.Pairs))
+
+	for i, pair := range v.Pairs {
+		keyEncoder := newCanonicalEncoder()
+		err := keyEncoder.encodeValue(pair.Key)
+		if err != nil {
+			return err
+		}
+
+		valueEncoder := newCanonicalEncoder()
+		err = valueEncoder.encodeValue(pair.Value)
+		if err != nil {
+			return err
+		}
+
+		entries[i] = canonicalEntry{
+			key:   keyEncoder.buf.Bytes(),
+			value: valueEncoder.buf.Bytes(),
+		}
+	}
+
+	err := sortCanonicalEntries(entries, "dictionary key")
+	if err != nil {
+		return err
+	}
+
+	e.buf.WriteByte(canonicalDictionaryTag)
+	e.writeLength(len(entries))
+	for _, entry := range entries {
+		e.buf.Write(entry.key)
+		e.buf.Write(entry.value)
+	}
+
+	return nil
+}
+
+func (e canonicalEncoder) encodeComposite(tag byte, compositeType CompositeType, values []Value) error {
+	fields := compositeType.CompositeFields()
+
+	if len(fields) != len(values) {
+		return fmt.Errorf(
+			"canonical encoding: composite %s has %d fields, but %d values",
+			compositeType.ID(),
+			len(fields),
+			len(values),
+		)
+	}
+
+	entries := make([]canonicalEntry, len(fields))
+
+	for i, field := range fields {
+		nameEncoder := newCanonicalEncoder()
+		err := nameEncoder.writeString(field.Identifier)
+		if err != nil {
+			return err
+		}
+
+		valueEncoder := newCanonicalEncoder()
+		err = valueEncoder.encodeValue(values[i])
+		if err != nil {
+			return err
+		}
+
+		entries[i] = canonicalEntry{
+			key:   nameEncoder.buf.Bytes(),
+			value: valueEncoder.buf.Bytes(),
+		}
+	}
+
+	err := sortCanonicalEntries(entries, "composite field")
+	if err != nil {
+		return err
+	}
+
+	e.buf.WriteByte(tag)
+
+	err = e.writeString(compositeType.ID())
+	if err != nil {
+		return err
+	}
+
+	e.writeLength(len(entries))
+	for _, entry := range entries {
+		e.buf.Write(entry.key)
+		e.buf.Write(entry.value)
+	}
+
+	return nil
+}
+
+func (e canonicalEncoder) encodeOptionalType(t Type) error {
+	if t == nil {
+		e.buf.WriteByte(0)
+		return nil
+	}
+	e.buf.WriteByte(1)
+	return e.encodeType(t)
+}
+
+func (e canonicalEncoder) encodeNominalType(tag byte, typeID string) error {
+	e.buf.WriteByte(tag)
+	return e.writeString(typeID)
+}
+
+func (e canonicalEncoder) encodeType(t Type) error {
+	switch t := t.(type) {
+	case nil:
+		return fmt.Errorf("canonical encoding: missing type")
+
+	case OptionalType:
+		e.buf.WriteByte(canonicalOptionalTypeTag)
+		return e.encodeType(t.Type)
+
+	case VariableSizedArrayType:
+		e.buf.WriteByte(canonicalVariableSizedArrayTypeTag)
+		return e.encodeType(t.ElementType)
+
+	case ConstantSizedArrayType:
+		e.buf.WriteByte(canonicalConstantSizedArrayTypeTag)
+		e.writeLength(int(t.Size))
+		return e.encodeType(t.ElementType)
+
+	case DictionaryType:
+		e.buf.WriteByte(canonicalDictionaryTypeTag)
+		err := e.encodeType(t.KeyType)
+		if err != nil {
+			return err
+		}
+		return e.encodeType(t.ElementType)
+
+	// Composite and interface types are nominal,
+	// so only their type ID is encoded
+
+	case *StructType:
+		return e.encodeNominalType(canonicalStructTypeTag, t.ID())
+	case *ResourceType:
+		return e.encodeNominalType(canonicalResourceTypeTag, t.ID())
+	case *EventType:
+		return e.encodeNominalType(canonicalEventTypeTag, t.ID())
+	case *ContractType:
+		return e.encodeNominalType(canonicalContractTypeTag, t.ID())
+	case *EnumType:
+		return e.encodeNominalType(canonicalEnumTypeTag, t.ID())
+	case *StructInterfaceType:
+		return e.encodeNominalType(canonicalStructInterfaceTypeTag, t.ID())
+	case *ResourceInterfaceType:
+		return e.encodeNominalType(canonicalResourceInterfaceTypeTag, t.ID())
+	case *ContractInterfaceType:
+		return e.encodeNominalType(canonicalContractInterfaceTypeTag, t.ID())
+
+	case Function:
+		e.buf.WriteByte(canonicalFunctionTypeTag)
+		e.writeLength(len(t.Parameters))
+		for _, parameter := range t.Parameters {
+			err := e.writeString(parameter.Label)
+			if err != nil {
+				return err
+			}
+			err = e.writeString(parameter.Identifier)
+			if err != nil {
+				return err
+			}
+			err = e.encodeType(parameter.Type)
+			if err != nil {
+				return err
+			}
+		}
+		return e.encodeOptionalType(t.ReturnType)
+
+	case ReferenceType:
+		e.buf.WriteByte(canonicalReferenceTypeTag)
+		if t.Authorized {
+			e.buf.WriteByte(1)
+		} else {
+			e.buf.WriteByte(0)
+		}
+		return e.encodeType(t.Type)
+
+	case RestrictedType:
+		// Restrictions are a set, so they are sorted by their encoding
+		restrictions := make([]canonicalEntry, len(t.Restrictions))
+		for i, restriction := range t.Restrictions {
+			restrictionEncoder := newCanonicalEncoder()
+			err := restrictionEncoder.encodeType(restriction)
+			if err != nil {
+				return err
+			}
+			restrictions[i] = canonicalEntry{
+				key: restrictionEncoder.buf.Bytes(),
+			}
+		}
+
+		err := sortCanonicalEntries(restrictions, "restriction")
+		if err != nil {
+			return err
+		}
+
+		e.buf.WriteByte(canonicalRestrictedTypeTag)
+		err = e.encodeOptionalType(t.Type)
+		if err != nil {
+			return err
+		}
+		e.writeLength(len(restrictions))
+		for _, restriction := range restrictions {
+			e.buf.Write(restriction.key)
+		}
+
+	case CapabilityType:
+		e.buf.WriteByte(canonicalCapabilityTypeTag)
+		return e.encodeOptionalType(t.BorrowType)
+
+	case ResourcePointer:
+		return e.encodeNominalType(canonicalResourcePointerTypeTag, t.TypeName)
+	case StructPointer:
+		return e.encodeNominalType(canonicalStructPointerTypeTag, t.TypeName)
+	case EventPointer:
+		return e.encodeNominalType(canonicalEventPointerTypeTag, t.TypeName)
+
+	case Variable:
+		return fmt.Errorf("canonical encoding: unsupported type: %T", t)
+
+	default:
+		// All remaining types have no type parameters,
+		// and are identified by their ID, e.g. `Int`
+		return e.encodeNominalType(canonicalSimpleTypeTag, t.ID())
+	}
+
+	return nil
+}
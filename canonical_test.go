@@ -0,0 +1,329 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func TestEncodeCanonical(t *testing.T) {
+
+	t.Parallel()
+
+	ufix64, err := NewUFix64("1.5")
+	require.NoError(t, err)
+
+	fix64, err := NewFix64("-1.0")
+	require.NoError(t, err)
+
+	ufix128, err := NewUFix128("0.000000000000000000000001")
+	require.NoError(t, err)
+
+	fix128, err := NewFix128("-1.0")
+	require.NoError(t, err)
+
+	type testCase struct {
+		value    Value
+		expected []byte
+	}
+
+	tests := map[string]testCase{
+		"Void": {
+			value:    NewVoid(),
+			expected: []byte{0x1, 0x00},
+		},
+		"nil": {
+			value:    NewOptional(nil),
+			expected: []byte{0x1, 0x01},
+		},
+		"some": {
+			value:    NewOptional(NewBool(true)),
+			expected: []byte{0x1, 0x02, 0x03, 0x1},
+		},
+		"String": {
+			value:    NewString("abc"),
+			expected: []byte{0x1, 0x04, 0x3, 'a', 'b', 'c'},
+		},
+		"Address": {
+			value:    BytesToAddress([]byte{0x1, 0x2}),
+			expected: []byte{0x1, 0x05, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x2},
+		},
+		"Int, zero": {
+			value:    NewInt(0),
+			expected: []byte{0x1, 0x20, 0x0, 0x0},
+		},
+		"Int, negative": {
+			value:    NewInt(-256),
+			expected: []byte{0x1, 0x20, 0x1, 0x2, 0x1, 0x0},
+		},
+		"Int16": {
+			value:    NewInt16(-2),
+			expected: []byte{0x1, 0x22, 0xff, 0xfe},
+		},
+		"UInt256": {
+			value:    NewUInt256(1),
+			expected: []byte{0x1, 0x2e, 0x0, 0x1, 0x1},
+		},
+		"Word32": {
+			value:    NewWord32(1),
+			expected: []byte{0x1, 0x32, 0x0, 0x0, 0x0, 0x1},
+		},
+		"UFix64": {
+			value:    ufix64,
+			expected: []byte{0x1, 0x39, 0x0, 0x0, 0x0, 0x0, 0x08, 0xf0, 0xd1, 0x80},
+		},
+		"Fix64": {
+			value:    fix64,
+			expected: []byte{0x1, 0x38, 0xff, 0xff, 0xff, 0xff, 0xfa, 0x0a, 0x1f, 0x00},
+		},
+		"UFix128": {
+			value:    ufix128,
+			expected: []byte{0x1, 0x3b, 0x0, 0x1, 0x1},
+		},
+		"Fix128": {
+			value: fix128,
+			expected: []byte{
+				0x1, 0x3a, 0x1, 0xa,
+				0xd3, 0xc2, 0x1b, 0xce, 0xcc, 0xed, 0xa1, 0x00, 0x00, 0x00,
+			},
+		},
+		"Array": {
+			value:    NewArray([]Value{NewUInt8(1), NewUInt8(2)}),
+			expected: []byte{0x1, 0x10, 0x2, 0x29, 0x1, 0x29, 0x2},
+		},
+		"Dictionary": {
+			value: NewDictionary([]KeyValuePair{
+				{Key: NewString("b"), Value: NewUInt8(2)},
+				{Key: NewString("a"), Value: NewUInt8(1)},
+			}),
+			expected: []byte{
+				0x1, 0x11, 0x2,
+				0x04, 0x1, 'a', 0x29, 0x1,
+				0x04, 0x1, 'b', 0x29, 0x2,
+			},
+		},
+		"Path": {
+			value: Path{
+				Domain:     "public",
+				Identifier: "a",
+			},
+			expected: []byte{0x1, 0x07, 0x6, 'p', 'u', 'b', 'l', 'i', 'c', 0x1, 'a'},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := EncodeCanonical(test.value)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestEncodeCanonicalDictionaryOrder(t *testing.T) {
+
+	t.Parallel()
+
+	first := NewDictionary([]KeyValuePair{
+		{Key: NewInt(1), Value: NewString("one")},
+		{Key: NewInt(-2), Value: NewString("minus two")},
+		{Key: NewInt(300), Value: NewString("three hundred")},
+	})
+
+	second := NewDictionary([]KeyValuePair{
+		{Key: NewInt(300), Value: NewString("three hundred")},
+		{Key: NewInt(1), Value: NewString("one")},
+		{Key: NewInt(-2), Value: NewString("minus two")},
+	})
+
+	firstHash, err := HashCanonical(first)
+	require.NoError(t, err)
+
+	secondHash, err := HashCanonical(second)
+	require.NoError(t, err)
+
+	assert.Equal(t, firstHash, secondHash)
+
+	// Different values have different hashes
+
+	third := NewDictionary([]KeyValuePair{
+		{Key: NewInt(1), Value: NewString("one")},
+	})
+
+	thirdHash, err := HashCanonical(third)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, firstHash, thirdHash)
+}
+
+func TestEncodeCanonicalDuplicateDictionaryKeys(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := EncodeCanonical(
+		NewDictionary([]KeyValuePair{
+			{Key: NewString("a"), Value: NewInt(1)},
+			{Key: NewString("a"), Value: NewInt(2)},
+		}),
+	)
+	require.Error(t, err)
+}
+
+func TestEncodeCanonicalCompositeFieldOrder(t *testing.T) {
+
+	t.Parallel()
+
+	location := common.AddressLocation{
+		Address: common.BytesToAddress([]byte{0x1}),
+		Name:    "Test",
+	}
+
+	first := NewStruct([]Value{NewInt(1), NewString("a")}).
+		WithType(&StructType{
+			Location:            location,
+			QualifiedIdentifier: "Test.S",
+			Fields: []Field{
+				{Identifier: "x", Type: IntType{}},
+				{Identifier: "y", Type: StringType{}},
+			},
+		})
+
+	second := NewStruct([]Value{NewString("a"), NewInt(1)}).
+		WithType(&StructType{
+			Location:            location,
+			QualifiedIdentifier: "Test.S",
+			Fields: []Field{
+				{Identifier: "y", Type: StringType{}},
+				{Identifier: "x", Type: IntType{}},
+			},
+		})
+
+	firstEncoding, err := EncodeCanonical(first)
+	require.NoError(t, err)
+
+	secondEncoding, err := EncodeCanonical(second)
+	require.NoError(t, err)
+
+	assert.Equal(t, firstEncoding, secondEncoding)
+
+	// Composites of different types have different encodings
+
+	third := NewResource([]Value{NewInt(1), NewString("a")}).
+		WithType(&ResourceType{
+			Location:            location,
+			QualifiedIdentifier: "Test.S",
+			Fields: []Field{
+				{Identifier: "x", Type: IntType{}},
+				{Identifier: "y", Type: StringType{}},
+			},
+		})
+
+	thirdEncoding, err := EncodeCanonical(third)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, firstEncoding, thirdEncoding)
+}
+
+func TestEncodeCanonicalInvalid(t *testing.T) {
+
+	t.Parallel()
+
+	for name, value := range map[string]Value{
+		"missing composite type": NewStruct(nil),
+		"negative UInt":          UInt{Value: big.NewInt(-1)},
+		"missing Int":            Int{},
+		"invalid UTF-8":          NewString("\xff"),
+		"missing field values": NewStruct(nil).WithType(&StructType{
+			QualifiedIdentifier: "S",
+			Fields: []Field{
+				{Identifier: "x", Type: IntType{}},
+			},
+		}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := EncodeCanonical(value)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEncodeCanonicalType(t *testing.T) {
+
+	t.Parallel()
+
+	encoded, err := EncodeCanonicalType(OptionalType{Type: IntType{}})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x1, 0x81, 0x80, 0x3, 'I', 'n', 't'}, encoded)
+
+	// Restrictions are ordered
+
+	location := common.IdentifierLocation("Test")
+
+	firstInterface := &ResourceInterfaceType{Location: location, QualifiedIdentifier: "I1"}
+	secondInterface := &ResourceInterfaceType{Location: location, QualifiedIdentifier: "I2"}
+
+	firstHash, err := HashCanonicalType(RestrictedType{
+		Type:         AnyResourceType{},
+		Restrictions: []Type{firstInterface, secondInterface},
+	})
+	require.NoError(t, err)
+
+	secondHash, err := HashCanonicalType(RestrictedType{
+		Type:         AnyResourceType{},
+		Restrictions: []Type{secondInterface, firstInterface},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, firstHash, secondHash)
+
+	// Composite types are nominal
+
+	firstHash, err = HashCanonicalType(&StructType{
+		Location:            location,
+		QualifiedIdentifier: "S",
+	})
+	require.NoError(t, err)
+
+	secondHash, err = HashCanonicalType(&StructType{
+		Location:            location,
+		QualifiedIdentifier: "S",
+		Fields: []Field{
+			{Identifier: "x", Type: IntType{}},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, firstHash, secondHash)
+
+	// Authorization is part of reference types
+
+	firstHash, err = HashCanonicalType(ReferenceType{Authorized: true, Type: IntType{}})
+	require.NoError(t, err)
+
+	secondHash, err = HashCanonicalType(ReferenceType{Authorized: false, Type: IntType{}})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, firstHash, secondHash)
+}
@@ -0,0 +1,422 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/format"
+)
+
+// ValueChangeKind is the kind of a change between two values
+type ValueChangeKind uint8
+
+const (
+	ValueChangeKindUnknown ValueChangeKind = iota
+	ValueChangeKindAdded
+	ValueChangeKindRemoved
+	ValueChangeKindModified
+)
+
+func (k ValueChangeKind) String() string {
+	switch k {
+	case ValueChangeKindAdded:
+		return "added"
+	case ValueChangeKindRemoved:
+		return "removed"
+	case ValueChangeKindModified:
+		return "modified"
+	default:
+		return "unknown"
+	}
+}
+
+// ValuePathElement is an element of a ValuePath.
+// It is either a FieldPathElement, an IndexPathElement, or a KeyPathElement
+type ValuePathElement interface {
+	isValuePathElement()
+	String() string
+}
+
+// FieldPathElement is the name of a field of a composite or capability
+type FieldPathElement string
+
+func (FieldPathElement) isValuePathElement() {}
+
+func (e FieldPathElement) String() string {
+	return "." + string(e)
+}
+
+// IndexPathElement is the index of an array element
+type IndexPathElement int
+
+func (IndexPathElement) isValuePathElement() {}
+
+func (e IndexPathElement) String() string {
+	return "[" + format.Int(int64(e)) + "]"
+}
+
+// KeyPathElement is the key of a dictionary entry
+type KeyPathElement struct {
+	Key Value
+}
+
+func (KeyPathElement) isValuePathElement() {}
+
+func (e KeyPathElement) String() string {
+	return "[" + e.Key.String() + "]"
+}
+
+// ValuePath is the location of a nested value, relative to the root value.
+// Optionals are transparent, i.e. they do not add an element to the path
+type ValuePath []ValuePathElement
+
+// String returns the path in a jq-like notation, e.g. `.info.tags["a"][0]`.
+// The empty path, i.e. the root value, is `.`
+func (p ValuePath) String() string {
+	var builder strings.Builder
+	for i, element := range p {
+		if _, ok := element.(FieldPathElement); i == 0 && !ok {
+			builder.WriteRune('.')
+		}
+		builder.WriteString(element.String())
+	}
+	if builder.Len() == 0 {
+		return "."
+	}
+	return builder.String()
+}
+
+func (p ValuePath) append(element ValuePathElement) ValuePath {
+	result := make(ValuePath, len(p), len(p)+1)
+	copy(result, p)
+	return append(result, element)
+}
+
+// ValueChange is a change between two values at a path.
+//
+// Old is nil for added values, and New is nil for removed values
+type ValueChange struct {
+	Kind ValueChangeKind
+	Path ValuePath
+	Old  Value
+	New  Value
+}
+
+// String returns a human-readable representation of the change.
+// Added values are prefixed with `+`, removed values with `-`, and modified values with `~`,
+// e.g. `~ .info.name: "a" => "b"`
+func (c ValueChange) String() string {
+	var builder strings.Builder
+
+	switch c.Kind {
+	case ValueChangeKindAdded:
+		builder.WriteString("+ ")
+	case ValueChangeKindRemoved:
+		builder.WriteString("- ")
+	default:
+		builder.WriteString("~ ")
+	}
+
+	builder.WriteString(c.Path.String())
+	builder.WriteString(": ")
+
+	switch c.Kind {
+	case ValueChangeKindAdded:
+		builder.WriteString(formatDiffValue(c.New))
+	case ValueChangeKindRemoved:
+		builder.WriteString(formatDiffValue(c.Old))
+	default:
+		builder.WriteString(formatDiffValue(c.Old))
+		builder.WriteString(" => ")
+		builder.WriteString(formatDiffValue(c.New))
+	}
+
+	return builder.String()
+}
+
+// ValueDiff is the list of changes between two values
+type ValueDiff []ValueChange
+
+// String returns a human-readable representation of the changes, one change per line
+func (d ValueDiff) String() string {
+	lines := make([]string, len(d))
+	for i, change := range d {
+		lines[i] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Diff returns the changes between the old and the new value.
+//
+// Arrays, dictionaries, composites, optionals and capabilities are compared element-wise,
+// all other values are compared as a whole.
+// Array elements are compared by index, dictionary entries by key, and composite fields by name.
+// Composites of different types, and values of different kinds, are reported as modified as a whole.
+//
+// The result is empty if the values are equal
+func Diff(oldValue, newValue Value) ValueDiff {
+	var d differ
+	d.diff(nil, oldValue, newValue)
+	return d.changes
+}
+
+type differ struct {
+	changes ValueDiff
+}
+
+func (d *differ) report(kind ValueChangeKind, path ValuePath, oldValue, newValue Value) {
+	d.changes = append(d.changes,
+		ValueChange{
+			Kind: kind,
+			Path: path,
+			Old:  oldValue,
+			New:  newValue,
+		},
+	)
+}
+
+func (d *differ) diff(path ValuePath, oldValue, newValue Value) {
+
+	switch {
+	case oldValue == nil && newValue == nil:
+		return
+
+	case oldValue == nil:
+		d.report(ValueChangeKindAdded, path, nil, newValue)
+		return
+
+	case newValue == nil:
+		d.report(ValueChangeKindRemoved, path, oldValue, nil)
+		return
+	}
+
+	switch oldValue := oldValue.(type) {
+	case Optional:
+		if newValue, ok := newValue.(Optional); ok &&
+			oldValue.Value != nil &&
+			newValue.Value != nil {
+
+			d.diff(path, oldValue.Value, newValue.Value)
+			return
+		}
+
+	case Array:
+		if newValue, ok := newValue.(Array); ok {
+			d.diffArrays(path, oldValue, newValue)
+			return
+		}
+
+	case Dictionary:
+		if newValue, ok := newValue.(Dictionary); ok {
+			d.diffDictionaries(path, oldValue, newValue)
+			return
+		}
+
+	case Capability:
+		if newValue, ok := newValue.(Capability); ok {
+			d.diff(path.append(FieldPathElement("path")), oldValue.Path, newValue.Path)
+			d.diff(path.append(FieldPathElement("address")), oldValue.Address, newValue.Address)
+			d.diff(path.append(FieldPathElement("borrowType")), String(oldValue.BorrowType), String(newValue.BorrowType))
+			return
+		}
+
+	case Struct, Resource, Event, Contract, Enum:
+		if d.diffComposites(path, oldValue, newValue) {
+			return
+		}
+	}
+
+	if !valuesEqual(oldValue, newValue) {
+		d.report(ValueChangeKindModified, path, oldValue, newValue)
+	}
+}
+
+func (d *differ) diffArrays(path ValuePath, oldValue, newValue Array) {
+	oldCount := len(oldValue.Values)
+	newCount := len(newValue.Values)
+
+	for i := 0; i < oldCount || i < newCount; i++ {
+		elementPath := path.append(IndexPathElement(i))
+
+		switch {
+		case i >= newCount:
+			d.report(ValueChangeKindRemoved, elementPath, oldValue.Values[i], nil)
+		case i >= oldCount:
+			d.report(ValueChangeKindAdded, elementPath, nil, newValue.Values[i])
+		default:
+			d.diff(elementPath, oldValue.Values[i], newValue.Values[i])
+		}
+	}
+}
+
+func (d *differ) diffDictionaries(path ValuePath, oldValue, newValue Dictionary) {
+
+	// Match entries by the canonical encoding of their keys
+
+	newIndices := make(map[string]int, len(newValue.Pairs))
+	for i, pair := range newValue.Pairs {
+		newIndices[diffKey(pair.Key)] = i
+	}
+
+	matched := make([]bool, len(newValue.Pairs))
+
+	for _, oldPair := range oldValue.Pairs {
+		entryPath := path.append(KeyPathElement{Key: oldPair.Key})
+
+		newIndex, ok := newIndices[diffKey(oldPair.Key)]
+		if !ok {
+			d.report(ValueChangeKindRemoved, entryPath, oldPair.Value, nil)
+			continue
+		}
+
+		matched[newIndex] = true
+		d.diff(entryPath, oldPair.Value, newValue.Pairs[newIndex].Value)
+	}
+
+	for i, newPair := range newValue.Pairs {
+		if matched[i] {
+			continue
+		}
+		entryPath := path.append(KeyPathElement{Key: newPair.Key})
+		d.report(ValueChangeKindAdded, entryPath, nil, newPair.Value)
+	}
+}
+
+// diffComposites compares the fields of two composites of the same type.
+// It returns false if the values are not composites of the same type
+func (d *differ) diffComposites(path ValuePath, oldValue, newValue Value) bool {
+	oldType, oldValues := compositeTypeAndFieldValues(oldValue)
+	newType, newValues := compositeTypeAndFieldValues(newValue)
+
+	if oldType == nil ||
+		newType == nil ||
+		reflect.TypeOf(oldValue) != reflect.TypeOf(newValue) ||
+		oldType.ID() != newType.ID() {
+
+		return false
+	}
+
+	oldFields := oldType.CompositeFields()
+	newFields := newType.CompositeFields()
+
+	if len(oldFields) != len(oldValues) || len(newFields) != len(newValues) {
+		return false
+	}
+
+	newIndices := make(map[string]int, len(newFields))
+	for i, field := range newFields {
+		newIndices[field.Identifier] = i
+	}
+
+	matched := make([]bool, len(newFields))
+
+	for i, oldField := range oldFields {
+		fieldPath := path.append(FieldPathElement(oldField.Identifier))
+
+		newIndex, ok := newIndices[oldField.Identifier]
+		if !ok {
+			d.report(ValueChangeKindRemoved, fieldPath, oldValues[i], nil)
+			continue
+		}
+
+		matched[newIndex] = true
+		d.diff(fieldPath, oldValues[i], newValues[newIndex])
+	}
+
+	for i, newField := range newFields {
+		if matched[i] {
+			continue
+		}
+		fieldPath := path.append(FieldPathElement(newField.Identifier))
+		d.report(ValueChangeKindAdded, fieldPath, nil, newValues[i])
+	}
+
+	return true
+}
+
+// compositeTypeAndFieldValues returns the type and the field values of a composite value.
+// The type is nil if the value is not a composite, or if it has no type
+func compositeTypeAndFieldValues(value Value) (CompositeType, []Value) {
+	switch value := value.(type) {
+	case Struct:
+		if value.StructType != nil {
+			return value.StructType, value.Fields
+		}
+	case Resource:
+		if value.ResourceType != nil {
+			return value.ResourceType, value.Fields
+		}
+	case Event:
+		if value.EventType != nil {
+			return value.EventType, value.Fields
+		}
+	case Contract:
+		if value.ContractType != nil {
+			return value.ContractType, value.Fields
+		}
+	case Enum:
+		if value.EnumType != nil {
+			return value.EnumType, value.Fields
+		}
+	}
+	return nil, nil
+}
+
+// diffKey returns a key which identifies a dictionary key.
+// Keys without a canonical encoding are identified by their string representation
+func diffKey(key Value) string {
+	encoded, err := EncodeCanonical(key)
+	if err != nil {
+		return "\x00" + formatDiffValue(key)
+	}
+	return string(encoded)
+}
+
+func valuesEqual(a, b Value) bool {
+	encodedA, errA := EncodeCanonical(a)
+	encodedB, errB := EncodeCanonical(b)
+	if errA == nil && errB == nil {
+		return bytes.Equal(encodedA, encodedB)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// formatDiffValue returns the string representation of the given value.
+// Unlike the String function of composite values, it does not panic for composites without a type
+func formatDiffValue(value Value) string {
+	if value == nil {
+		return format.Nil
+	}
+
+	compositeType, values := compositeTypeAndFieldValues(value)
+	switch value.(type) {
+	case Struct, Resource, Event, Contract, Enum:
+		if compositeType == nil {
+			return format.Composite("", nil)
+		}
+		if len(compositeType.CompositeFields()) != len(values) {
+			return format.Composite(compositeType.ID(), nil)
+		}
+	}
+
+	return value.String()
+}
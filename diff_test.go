@@ -0,0 +1,362 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func TestDiffEqual(t *testing.T) {
+
+	t.Parallel()
+
+	for name, value := range map[string]Value{
+		"Int":        NewInt(42),
+		"String":     NewString("test"),
+		"nil":        NewOptional(nil),
+		"Array":      NewArray([]Value{NewInt(1), NewOptional(NewString("a"))}),
+		"Dictionary": NewDictionary([]KeyValuePair{{Key: NewString("a"), Value: NewInt(1)}}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Empty(t, Diff(value, value))
+		})
+	}
+
+	// Big integers with different internal representations are equal
+
+	assert.Empty(t,
+		Diff(
+			Int{Value: new(big.Int)},
+			Int{Value: new(big.Int).SetBytes([]byte{0x0})},
+		),
+	)
+
+	// Dictionaries with different entry order are equal
+
+	assert.Empty(t,
+		Diff(
+			NewDictionary([]KeyValuePair{
+				{Key: NewString("a"), Value: NewInt(1)},
+				{Key: NewString("b"), Value: NewInt(2)},
+			}),
+			NewDictionary([]KeyValuePair{
+				{Key: NewString("b"), Value: NewInt(2)},
+				{Key: NewString("a"), Value: NewInt(1)},
+			}),
+		),
+	)
+}
+
+func TestDiffLeaves(t *testing.T) {
+
+	t.Parallel()
+
+	assert.Equal(t,
+		ValueDiff{
+			{
+				Kind: ValueChangeKindModified,
+				Path: nil,
+				Old:  NewInt(1),
+				New:  NewInt(2),
+			},
+		},
+		Diff(NewInt(1), NewInt(2)),
+	)
+
+	// Values of different types are modified
+
+	assert.Equal(t,
+		ValueDiff{
+			{
+				Kind: ValueChangeKindModified,
+				Path: nil,
+				Old:  NewInt(1),
+				New:  NewUInt(1),
+			},
+		},
+		Diff(NewInt(1), NewUInt(1)),
+	)
+
+	// Optionals are transparent
+
+	assert.Equal(t,
+		ValueDiff{
+			{
+				Kind: ValueChangeKindModified,
+				Path: nil,
+				Old:  NewString("a"),
+				New:  NewString("b"),
+			},
+		},
+		Diff(
+			NewOptional(NewString("a")),
+			NewOptional(NewString("b")),
+		),
+	)
+
+	assert.Equal(t,
+		ValueDiff{
+			{
+				Kind: ValueChangeKindModified,
+				Path: nil,
+				Old:  NewOptional(nil),
+				New:  NewOptional(NewString("b")),
+			},
+		},
+		Diff(
+			NewOptional(nil),
+			NewOptional(NewString("b")),
+		),
+	)
+}
+
+func TestDiffArrays(t *testing.T) {
+
+	t.Parallel()
+
+	changes := Diff(
+		NewArray([]Value{NewInt(1), NewInt(2), NewInt(3)}),
+		NewArray([]Value{NewInt(1), NewInt(4)}),
+	)
+
+	assert.Equal(t,
+		ValueDiff{
+			{
+				Kind: ValueChangeKindModified,
+				Path: ValuePath{IndexPathElement(1)},
+				Old:  NewInt(2),
+				New:  NewInt(4),
+			},
+			{
+				Kind: ValueChangeKindRemoved,
+				Path: ValuePath{IndexPathElement(2)},
+				Old:  NewInt(3),
+			},
+		},
+		changes,
+	)
+
+	assert.Equal(t,
+		"~ .[1]: 2 => 4\n"+
+			"- .[2]: 3",
+		changes.String(),
+	)
+}
+
+func TestDiffDictionaries(t *testing.T) {
+
+	t.Parallel()
+
+	changes := Diff(
+		NewDictionary([]KeyValuePair{
+			{Key: NewString("a"), Value: NewInt(1)},
+			{Key: NewString("b"), Value: NewArray([]Value{NewInt(2)})},
+		}),
+		NewDictionary([]KeyValuePair{
+			{Key: NewString("c"), Value: NewInt(3)},
+			{Key: NewString("b"), Value: NewArray([]Value{NewInt(2), NewInt(3)})},
+		}),
+	)
+
+	assert.Equal(t,
+		ValueDiff{
+			{
+				Kind: ValueChangeKindRemoved,
+				Path: ValuePath{KeyPathElement{Key: NewString("a")}},
+				Old:  NewInt(1),
+			},
+			{
+				Kind: ValueChangeKindAdded,
+				Path: ValuePath{KeyPathElement{Key: NewString("b")}, IndexPathElement(1)},
+				New:  NewInt(3),
+			},
+			{
+				Kind: ValueChangeKindAdded,
+				Path: ValuePath{KeyPathElement{Key: NewString("c")}},
+				New:  NewInt(3),
+			},
+		},
+		changes,
+	)
+
+	assert.Equal(t,
+		"- .[\"a\"]: 1\n"+
+			"+ .[\"b\"][1]: 3\n"+
+			"+ .[\"c\"]: 3",
+		changes.String(),
+	)
+}
+
+func TestDiffComposites(t *testing.T) {
+
+	t.Parallel()
+
+	location := common.AddressLocation{
+		Address: common.BytesToAddress([]byte{0x1}),
+		Name:    "Test",
+	}
+
+	oldType := &StructType{
+		Location:            location,
+		QualifiedIdentifier: "Test.Info",
+		Fields: []Field{
+			{Identifier: "name", Type: StringType{}},
+			{Identifier: "tags", Type: DictionaryType{KeyType: StringType{}, ElementType: UInt64Type{}}},
+			{Identifier: "owner", Type: OptionalType{Type: AddressType{}}},
+		},
+	}
+
+	newType := &StructType{
+		Location:            location,
+		QualifiedIdentifier: "Test.Info",
+		Fields: []Field{
+			{Identifier: "tags", Type: DictionaryType{KeyType: StringType{}, ElementType: UInt64Type{}}},
+			{Identifier: "name", Type: StringType{}},
+			{Identifier: "count", Type: IntType{}},
+		},
+	}
+
+	oldValue := NewStruct([]Value{
+		NewString("a"),
+		NewDictionary([]KeyValuePair{
+			{Key: NewString("x"), Value: NewUInt64(1)},
+		}),
+		NewOptional(BytesToAddress([]byte{0x2})),
+	}).WithType(oldType)
+
+	newValue := NewStruct([]Value{
+		NewDictionary([]KeyValuePair{
+			{Key: NewString("x"), Value: NewUInt64(2)},
+		}),
+		NewString("a"),
+		NewInt(1),
+	}).WithType(newType)
+
+	changes := Diff(
+		NewArray([]Value{oldValue}),
+		NewArray([]Value{newValue}),
+	)
+
+	assert.Equal(t,
+		ValueDiff{
+			{
+				Kind: ValueChangeKindModified,
+				Path: ValuePath{
+					IndexPathElement(0),
+					FieldPathElement("tags"),
+					KeyPathElement{Key: NewString("x")},
+				},
+				Old: NewUInt64(1),
+				New: NewUInt64(2),
+			},
+			{
+				Kind: ValueChangeKindRemoved,
+				Path: ValuePath{
+					IndexPathElement(0),
+					FieldPathElement("owner"),
+				},
+				Old: NewOptional(BytesToAddress([]byte{0x2})),
+			},
+			{
+				Kind: ValueChangeKindAdded,
+				Path: ValuePath{
+					IndexPathElement(0),
+					FieldPathElement("count"),
+				},
+				New: NewInt(1),
+			},
+		},
+		changes,
+	)
+
+	assert.Equal(t,
+		"~ .[0].tags[\"x\"]: 1 => 2\n"+
+			"- .[0].owner: 0x2\n"+
+			"+ .[0].count: 1",
+		changes.String(),
+	)
+
+	// Composites of different types are modified as a whole
+
+	otherValue := NewResource(oldValue.Fields).
+		WithType(&ResourceType{
+			Location:            location,
+			QualifiedIdentifier: "Test.Info",
+			Fields:              oldType.Fields,
+		})
+
+	changes = Diff(oldValue, otherValue)
+
+	assert.Equal(t,
+		ValueDiff{
+			{
+				Kind: ValueChangeKindModified,
+				Old:  oldValue,
+				New:  otherValue,
+			},
+		},
+		changes,
+	)
+}
+
+func TestDiffCapabilities(t *testing.T) {
+
+	t.Parallel()
+
+	changes := Diff(
+		Capability{
+			Path:       Path{Domain: "public", Identifier: "foo"},
+			Address:    BytesToAddress([]byte{0x1}),
+			BorrowType: "&Int",
+		},
+		Capability{
+			Path:       Path{Domain: "public", Identifier: "bar"},
+			Address:    BytesToAddress([]byte{0x1}),
+			BorrowType: "&Int",
+		},
+	)
+
+	assert.Equal(t,
+		"~ .path: /public/foo => /public/bar",
+		changes.String(),
+	)
+}
+
+func TestValuePathString(t *testing.T) {
+
+	t.Parallel()
+
+	assert.Equal(t, ".", ValuePath(nil).String())
+	assert.Equal(t, ".a", ValuePath{FieldPathElement("a")}.String())
+	assert.Equal(t, ".[1]", ValuePath{IndexPathElement(1)}.String())
+	assert.Equal(t,
+		".a[1][\"b\"].c",
+		ValuePath{
+			FieldPathElement("a"),
+			IndexPathElement(1),
+			KeyPathElement{Key: NewString("b")},
+			FieldPathElement("c"),
+		}.String(),
+	)
+}
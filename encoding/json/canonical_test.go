@@ -0,0 +1,153 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// TestCanonicalHashRoundTrip ensures that the canonical hash of a value
+// is the same after the value is encoded to and decoded from JSON-CDC
+func TestCanonicalHashRoundTrip(t *testing.T) {
+
+	t.Parallel()
+
+	location := common.AddressLocation{
+		Address: common.BytesToAddress([]byte{0x1}),
+		Name:    "Test",
+	}
+
+	fix64, err := cadence.NewFix64("-12.34567891")
+	require.NoError(t, err)
+
+	ufix64, err := cadence.NewUFix64("0.00000001")
+	require.NoError(t, err)
+
+	largeInt, ok := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	structValue := cadence.NewStruct([]cadence.Value{
+		cadence.NewString("foo"),
+		cadence.NewOptional(cadence.NewUInt64(42)),
+	}).WithType(&cadence.StructType{
+		Location:            location,
+		QualifiedIdentifier: "Test.S",
+		Fields: []cadence.Field{
+			{Identifier: "name", Type: cadence.StringType{}},
+			{Identifier: "count", Type: cadence.OptionalType{Type: cadence.UInt64Type{}}},
+		},
+	})
+
+	values := map[string]cadence.Value{
+		"Void":     cadence.NewVoid(),
+		"nil":      cadence.NewOptional(nil),
+		"Bool":     cadence.NewBool(true),
+		"String":   cadence.NewString("é \U0001F600"),
+		"Address":  cadence.BytesToAddress([]byte{0x1, 0x2, 0x3}),
+		"Int":      cadence.NewIntFromBig(largeInt),
+		"Int8":     cadence.NewInt8(-8),
+		"Int256":   cadence.NewInt256(-256),
+		"UInt":     cadence.NewUInt(0),
+		"UInt128":  cadence.NewUInt128(128),
+		"UInt64":   cadence.NewUInt64(64),
+		"Word16":   cadence.NewWord16(16),
+		"Fix64":    fix64,
+		"UFix64":   ufix64,
+		"Array":    cadence.NewArray([]cadence.Value{cadence.NewInt(1), cadence.NewString("a")}),
+		"Struct":   structValue,
+		"nested":   cadence.NewArray([]cadence.Value{structValue, cadence.NewOptional(structValue)}),
+		"Path":     cadence.Path{Domain: "storage", Identifier: "foo"},
+		"Type":     cadence.TypeValue{StaticType: "Int"},
+		"Resource": cadence.NewResource([]cadence.Value{cadence.NewUInt64(1)}).WithType(&cadence.ResourceType{Location: location, QualifiedIdentifier: "Test.R", Fields: []cadence.Field{{Identifier: "uuid", Type: cadence.UInt64Type{}}}}),
+		"Event":    cadence.NewEvent([]cadence.Value{cadence.NewInt(1)}).WithType(&cadence.EventType{Location: location, QualifiedIdentifier: "Test.E", Fields: []cadence.Field{{Identifier: "id", Type: cadence.IntType{}}}}),
+		"Dictionary": cadence.NewDictionary([]cadence.KeyValuePair{
+			{Key: cadence.NewString("b"), Value: structValue},
+			{Key: cadence.NewString("a"), Value: cadence.NewOptional(nil)},
+		}),
+		"Capability": cadence.Capability{
+			Path:       cadence.Path{Domain: "public", Identifier: "foo"},
+			Address:    cadence.BytesToAddress([]byte{0x1}),
+			BorrowType: "&Int",
+		},
+	}
+
+	for name, value := range values {
+		t.Run(name, func(t *testing.T) {
+
+			expected, err := cadence.HashCanonical(value)
+			require.NoError(t, err)
+
+			encoded, err := json.Encode(value)
+			require.NoError(t, err)
+
+			decoded, err := json.Decode(encoded)
+			require.NoError(t, err)
+
+			actual, err := cadence.HashCanonical(decoded)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+// TestCanonicalHashJSONDictionaryOrder ensures that the canonical hash of dictionaries
+// decoded from JSON-CDC does not depend on the order of the entries
+func TestCanonicalHashJSONDictionaryOrder(t *testing.T) {
+
+	t.Parallel()
+
+	first, err := json.Decode([]byte(`
+      {
+        "type": "Dictionary",
+        "value": [
+          {"key": {"type": "String", "value": "a"}, "value": {"type": "UFix64", "value": "1.00000000"}},
+          {"key": {"type": "String", "value": "b"}, "value": {"type": "UFix64", "value": "2.50000000"}}
+        ]
+      }
+    `))
+	require.NoError(t, err)
+
+	second, err := json.Decode([]byte(`
+      {
+        "type": "Dictionary",
+        "value": [
+          {"key": {"type": "String", "value": "b"}, "value": {"type": "UFix64", "value": "2.5"}},
+          {"key": {"type": "String", "value": "a"}, "value": {"type": "UFix64", "value": "1.0"}}
+        ]
+      }
+    `))
+	require.NoError(t, err)
+
+	firstHash, err := cadence.HashCanonical(first)
+	require.NoError(t, err)
+
+	secondHash, err := cadence.HashCanonical(second)
+	require.NoError(t, err)
+
+	assert.Equal(t, firstHash, secondHash)
+}
@@ -174,6 +174,10 @@ func decodeJSON(v interface{}) cadence.Value {
 		return decodeFix64(valueJSON)
 	case ufix64TypeStr:
 		return decodeUFix64(valueJSON)
+	case fix128TypeStr:
+		return decodeFix128(valueJSON)
+	case ufix128TypeStr:
+		return decodeUFix128(valueJSON)
 	case arrayTypeStr:
 		return decodeArray(valueJSON)
 	case dictionaryTypeStr:
@@ -444,6 +448,24 @@ func decodeUFix64(valueJSON interface{}) cadence.UFix64 {
 	return v
 }
 
+func decodeFix128(valueJSON interface{}) cadence.Fix128 {
+	v, err := cadence.NewFix128(toString(valueJSON))
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return v
+}
+
+func decodeUFix128(valueJSON interface{}) cadence.UFix128 {
+	v, err := cadence.NewUFix128(toString(valueJSON))
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return v
+}
+
 func decodeValues(valueJSON interface{}) []cadence.Value {
 	v := toSlice(valueJSON)
 
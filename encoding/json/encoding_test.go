@@ -537,6 +537,74 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeFix128(t *testing.T) {
+
+	t.Parallel()
+
+	newFix128 := func(s string) cadence.Fix128 {
+		value, err := cadence.NewFix128(s)
+		require.NoError(t, err)
+		return value
+	}
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			newFix128("0.0"),
+			`{"type":"Fix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"789.00123010",
+			newFix128("789.00123010"),
+			`{"type":"Fix128","value":"789.001230100000000000000000"}`,
+		},
+		{
+			"-12345.000000000000000000000001",
+			newFix128("-12345.000000000000000000000001"),
+			`{"type":"Fix128","value":"-12345.000000000000000000000001"}`,
+		},
+		{
+			"Min",
+			cadence.Fix128{Value: sema.Fix128TypeMinScaledBig},
+			`{"type":"Fix128","value":"-170141183460469.231731687303715884105728"}`,
+		},
+		{
+			"Max",
+			cadence.Fix128{Value: sema.Fix128TypeMaxScaledBig},
+			`{"type":"Fix128","value":"170141183460469.231731687303715884105727"}`,
+		},
+	}...)
+}
+
+func TestEncodeUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	newUFix128 := func(s string) cadence.UFix128 {
+		value, err := cadence.NewUFix128(s)
+		require.NoError(t, err)
+		return value
+	}
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			newUFix128("0.0"),
+			`{"type":"UFix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"0.123456789012345678901234",
+			newUFix128("0.123456789012345678901234"),
+			`{"type":"UFix128","value":"0.123456789012345678901234"}`,
+		},
+		{
+			"Max",
+			cadence.UFix128{Value: sema.UFix128TypeMaxScaledBig},
+			`{"type":"UFix128","value":"340282366920938.463463374607431768211455"}`,
+		},
+	}...)
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
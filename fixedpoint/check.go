@@ -54,8 +54,52 @@ const UFix64TypeMaxFractional = math.MaxUint64 % uint64(Fix64Factor)
 var UFix64TypeMinFractionalBig = new(big.Int).SetUint64(UFix64TypeMinFractional)
 var UFix64TypeMaxFractionalBig = new(big.Int).SetUint64(UFix64TypeMaxFractional)
 
+const Fix128Scale uint = 24
+
+var Fix128FactorBig = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(Fix128Scale)), nil)
+
+// Fix128
+
+// Fix128TypeMinScaledBig and Fix128TypeMaxScaledBig are the bounds
+// of the scaled integer representation of Fix128 values,
+// i.e. the bounds of a 128-bit signed integer
+
+var Fix128TypeMinScaledBig = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+var Fix128TypeMaxScaledBig = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+
+var Fix128TypeMinIntBig, Fix128TypeMinFractionalBig = new(big.Int).QuoRem(
+	Fix128TypeMinScaledBig,
+	Fix128FactorBig,
+	new(big.Int),
+)
+
+var Fix128TypeMaxIntBig, Fix128TypeMaxFractionalBig = new(big.Int).QuoRem(
+	Fix128TypeMaxScaledBig,
+	Fix128FactorBig,
+	new(big.Int),
+)
+
+// UFix128
+
+// UFix128TypeMinScaledBig and UFix128TypeMaxScaledBig are the bounds
+// of the scaled integer representation of UFix128 values,
+// i.e. the bounds of a 128-bit unsigned integer
+
+var UFix128TypeMinScaledBig = new(big.Int)
+var UFix128TypeMaxScaledBig = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+var UFix128TypeMinIntBig = new(big.Int)
+var UFix128TypeMinFractionalBig = new(big.Int)
+
+var UFix128TypeMaxIntBig, UFix128TypeMaxFractionalBig = new(big.Int).QuoRem(
+	UFix128TypeMaxScaledBig,
+	Fix128FactorBig,
+	new(big.Int),
+)
+
 func init() {
 	Fix64TypeMinFractionalBig.Abs(Fix64TypeMinFractionalBig)
+	Fix128TypeMinFractionalBig.Abs(Fix128TypeMinFractionalBig)
 }
 
 func CheckRange(
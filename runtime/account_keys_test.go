@@ -595,6 +595,217 @@ func TestRuntimePublicAccountKeys(t *testing.T) {
 	})
 }
 
+func TestRuntimeAccountKeysIteration(t *testing.T) {
+
+	t.Parallel()
+
+	newStorage := func() *testAccountKeyStorage {
+		accountKeyC := *accountKeyA
+		accountKeyC.KeyIndex = 2
+		accountKeyC.HashAlgo = sema.HashAlgorithmSHA2_256
+
+		storage := newTestAccountKeyStorage()
+		storage.keys = append(storage.keys, revokedAccountKeyA, accountKeyB, &accountKeyC)
+		return storage
+	}
+
+	t.Run("count", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := NewInterpreterRuntime()
+		runtimeInterface := getAccountKeyTestRuntimeInterface(newStorage())
+
+		test := accountKeyTestCase{
+			code: `
+				pub fun main(): UInt64 {
+					return getAccount(0x02).keys.count
+				}`,
+		}
+
+		value, err := test.executeScript(runtime, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.NewUInt64(3), value)
+	})
+
+	t.Run("count after add", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage := newTestAccountKeyStorage()
+		runtime := NewInterpreterRuntime()
+		runtimeInterface := getAccountKeyTestRuntimeInterface(storage)
+
+		var loggedMessages []string
+		runtimeInterface.log = func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		}
+
+		test := accountKeyTestCase{
+			code: `
+				transaction {
+					prepare(signer: AuthAccount) {
+						log(signer.keys.count)
+
+						signer.keys.add(
+							publicKey: PublicKey(
+								publicKey: "010203".decodeHex(),
+								signatureAlgorithm: SignatureAlgorithm.ECDSA_P256
+							),
+							hashAlgorithm: HashAlgorithm.SHA3_256,
+							weight: 100.0
+						)
+
+						log(signer.keys.count)
+					}
+				}`,
+		}
+
+		err := test.executeTransaction(runtime, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"0", "1"}, loggedMessages)
+	})
+
+	t.Run("forEach", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := NewInterpreterRuntime()
+		runtimeInterface := getAccountKeyTestRuntimeInterface(newStorage())
+
+		test := accountKeyTestCase{
+			code: `
+				pub fun main(): [AccountKey] {
+					let keys: [AccountKey] = []
+					getAccount(0x02).keys.forEach(fun (key: AccountKey): Bool {
+						keys.append(key)
+						return true
+					})
+					return keys
+				}`,
+		}
+
+		value, err := test.executeScript(runtime, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				accountKeyExportedValue(
+					0,
+					[]byte{1, 2, 3},
+					sema.SignatureAlgorithmECDSA_P256,
+					sema.HashAlgorithmSHA3_256,
+					"100.0",
+					true,
+				),
+				accountKeyExportedValue(
+					1,
+					[]byte{4, 5, 6},
+					sema.SignatureAlgorithmECDSA_Secp256k1,
+					sema.HashAlgorithmSHA3_256,
+					"100.0",
+					false,
+				),
+				accountKeyExportedValue(
+					2,
+					[]byte{1, 2, 3},
+					sema.SignatureAlgorithmECDSA_P256,
+					sema.HashAlgorithmSHA2_256,
+					"100.0",
+					false,
+				),
+			}),
+			value,
+		)
+	})
+
+	t.Run("forEach, stop", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage := newStorage()
+		runtime := NewInterpreterRuntime()
+		runtimeInterface := getAccountKeyTestRuntimeInterface(storage)
+
+		test := accountKeyTestCase{
+			code: `
+				transaction {
+					prepare(signer: AuthAccount) {
+						var count = 0
+						signer.keys.forEach(fun (key: AccountKey): Bool {
+							count = count + 1
+							return key.isRevoked
+						})
+						assert(count == 2)
+					}
+				}`,
+		}
+
+		err := test.executeTransaction(runtime, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t, accountKeyB, storage.returnedKey)
+	})
+
+	t.Run("getByPublicKey", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := NewInterpreterRuntime()
+		runtimeInterface := getAccountKeyTestRuntimeInterface(newStorage())
+
+		test := accountKeyTestCase{
+			code: `
+				pub fun main(): [[Int]] {
+					let keys = getAccount(0x02).keys
+					let indices: [[Int]] = []
+
+					for publicKey in [
+						PublicKey(
+							publicKey: "010203".decodeHex(),
+							signatureAlgorithm: SignatureAlgorithm.ECDSA_P256
+						),
+						PublicKey(
+							publicKey: "010203".decodeHex(),
+							signatureAlgorithm: SignatureAlgorithm.ECDSA_Secp256k1
+						),
+						PublicKey(
+							publicKey: "040506".decodeHex(),
+							signatureAlgorithm: SignatureAlgorithm.ECDSA_Secp256k1
+						)
+					] {
+						indices.append(
+							keys.getByPublicKey(publicKey).map(fun (key: AccountKey): Int {
+								return key.keyIndex
+							})
+						)
+					}
+
+					return indices
+				}`,
+		}
+
+		value, err := test.executeScript(runtime, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewArray([]cadence.Value{
+					cadence.NewInt(0),
+					cadence.NewInt(2),
+				}),
+				cadence.NewArray([]cadence.Value{}),
+				cadence.NewArray([]cadence.Value{
+					cadence.NewInt(1),
+				}),
+			}),
+			value,
+		)
+	})
+}
+
 func TestRuntimeHashAlgorithm(t *testing.T) {
 
 	t.Parallel()
@@ -847,6 +1058,10 @@ func getAccountKeyTestRuntimeInterface(storage *testAccountKeyStorage) *testRunt
 			return accountKey, nil
 		},
 
+		getAccountKeyCount: func(address Address) (int, error) {
+			return len(storage.keys), nil
+		},
+
 		removeAccountKey: func(address Address, index int) (*AccountKey, error) {
 			if index >= len(storage.keys) {
 				storage.returnedKey = nil
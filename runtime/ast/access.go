@@ -35,6 +35,7 @@ const (
 	AccessPrivate
 	AccessContract
 	AccessAccount
+	AccessEntitlements
 	AccessPublic
 	AccessPublicSettable
 )
@@ -77,6 +78,8 @@ func (a Access) Keyword() string {
 		return "access(account)"
 	case AccessContract:
 		return "access(contract)"
+	case AccessEntitlements:
+		return "access(entitlements)"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -96,6 +99,8 @@ func (a Access) Description() string {
 		return "account"
 	case AccessContract:
 		return "contract"
+	case AccessEntitlements:
+		return "entitlement"
 	}
 
 	panic(errors.NewUnreachableError())
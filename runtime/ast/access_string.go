@@ -12,13 +12,14 @@ func _() {
 	_ = x[AccessPrivate-1]
 	_ = x[AccessContract-2]
 	_ = x[AccessAccount-3]
-	_ = x[AccessPublic-4]
-	_ = x[AccessPublicSettable-5]
+	_ = x[AccessEntitlements-4]
+	_ = x[AccessPublic-5]
+	_ = x[AccessPublicSettable-6]
 }
 
-const _Access_name = "AccessNotSpecifiedAccessPrivateAccessContractAccessAccountAccessPublicAccessPublicSettable"
+const _Access_name = "AccessNotSpecifiedAccessPrivateAccessContractAccessAccountAccessEntitlementsAccessPublicAccessPublicSettable"
 
-var _Access_index = [...]uint8{0, 18, 31, 45, 58, 70, 90}
+var _Access_index = [...]uint8{0, 18, 31, 45, 58, 76, 88, 108}
 
 func (i Access) String() string {
 	if i >= Access(len(_Access_index)-1) {
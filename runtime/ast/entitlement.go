@@ -0,0 +1,94 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// EntitlementDeclaration
+
+type EntitlementDeclaration struct {
+	Access     Access
+	Identifier Identifier
+	DocString  string
+	Range
+}
+
+func (*EntitlementDeclaration) isDeclaration() {}
+
+func (d *EntitlementDeclaration) Accept(visitor Visitor) Repr {
+	return visitor.VisitEntitlementDeclaration(d)
+}
+
+func (d *EntitlementDeclaration) DeclarationIdentifier() *Identifier {
+	return &d.Identifier
+}
+
+func (d *EntitlementDeclaration) DeclarationKind() common.DeclarationKind {
+	return common.DeclarationKindEntitlement
+}
+
+func (d *EntitlementDeclaration) DeclarationAccess() Access {
+	return d.Access
+}
+
+func (d *EntitlementDeclaration) DeclarationMembers() *Members {
+	return nil
+}
+
+func (d *EntitlementDeclaration) MarshalJSON() ([]byte, error) {
+	type Alias EntitlementDeclaration
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "EntitlementDeclaration",
+		Alias: (*Alias)(d),
+	})
+}
+
+// EntitlementSet is the set of entitlements of an entitlement access modifier,
+// e.g. `access(E1, E2)` or `access(E1 | E2)`.
+//
+// If the set is a disjunction, any one of the entitlements is required,
+// otherwise all entitlements are required
+//
+type EntitlementSet struct {
+	Entitlements  []*NominalType
+	IsDisjunction bool `json:",omitempty"`
+	Range
+}
+
+func (s *EntitlementSet) String() string {
+	separator := ", "
+	if s.IsDisjunction {
+		separator = " | "
+	}
+
+	entitlements := make([]string, len(s.Entitlements))
+	for i, entitlement := range s.Entitlements {
+		entitlements[i] = entitlement.String()
+	}
+
+	return strings.Join(entitlements, separator)
+}
@@ -150,6 +150,55 @@ func (e *StringExpression) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// StringTemplateExpression
+
+type StringTemplateExpression struct {
+	// Values are the string parts of the template, around the interpolated expressions,
+	// i.e. there is always one more value than there are expressions
+	Values      []string
+	Expressions []Expression
+	Range
+}
+
+func (*StringTemplateExpression) isExpression() {}
+
+func (*StringTemplateExpression) isIfStatementTest() {}
+
+func (e *StringTemplateExpression) Accept(visitor Visitor) Repr {
+	return e.AcceptExp(visitor)
+}
+
+func (e *StringTemplateExpression) AcceptExp(visitor ExpressionVisitor) Repr {
+	return visitor.VisitStringTemplateExpression(e)
+}
+
+func (e *StringTemplateExpression) String() string {
+	var builder strings.Builder
+	builder.WriteRune('"')
+	for i, value := range e.Values {
+		quoted := strconv.Quote(value)
+		builder.WriteString(quoted[1 : len(quoted)-1])
+		if i < len(e.Expressions) {
+			builder.WriteString("\\(")
+			builder.WriteString(e.Expressions[i].String())
+			builder.WriteRune(')')
+		}
+	}
+	builder.WriteRune('"')
+	return builder.String()
+}
+
+func (e *StringTemplateExpression) MarshalJSON() ([]byte, error) {
+	type Alias StringTemplateExpression
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "StringTemplateExpression",
+		Alias: (*Alias)(e),
+	})
+}
+
 // IntegerExpression
 
 type IntegerExpression struct {
@@ -724,6 +773,7 @@ func (e *BinaryExpression) MarshalJSON() ([]byte, error) {
 // FunctionExpression
 
 type FunctionExpression struct {
+	Purity               FunctionPurity `json:",omitempty"`
 	ParameterList        *ParameterList
 	ReturnTypeAnnotation *TypeAnnotation
 	FunctionBlock        *FunctionBlock
@@ -26,7 +26,10 @@ import (
 
 type FunctionDeclaration struct {
 	Access               Access
+	Entitlements         *EntitlementSet `json:",omitempty"`
+	Purity               FunctionPurity  `json:",omitempty"`
 	Identifier           Identifier
+	TypeParameterList    *TypeParameterList `json:",omitempty"`
 	ParameterList        *ParameterList
 	ReturnTypeAnnotation *TypeAnnotation
 	FunctionBlock        *FunctionBlock
@@ -69,6 +72,7 @@ func (d *FunctionDeclaration) DeclarationAccess() Access {
 
 func (d *FunctionDeclaration) ToExpression() *FunctionExpression {
 	return &FunctionExpression{
+		Purity:               d.Purity,
 		ParameterList:        d.ParameterList,
 		ReturnTypeAnnotation: d.ReturnTypeAnnotation,
 		FunctionBlock:        d.FunctionBlock,
@@ -56,6 +56,12 @@ type memberIndices struct {
 	_composites []*CompositeDeclaration
 	// Use `EnumCases()` instead
 	_enumCases []*EnumCaseDeclaration
+	// Use `TypeAliases()` instead
+	_typeAliases []*TypeAliasDeclaration
+	// Use `Extensions()` instead
+	_extensions []*ExtensionDeclaration
+	// Use `Entitlements()` instead
+	_entitlements []*EntitlementDeclaration
 }
 
 func (i *memberIndices) FieldsByIdentifier(declarations []Declaration) map[string]*FieldDeclaration {
@@ -118,6 +124,21 @@ func (i *memberIndices) EnumCases(declarations []Declaration) []*EnumCaseDeclara
 	return i._enumCases
 }
 
+func (i *memberIndices) TypeAliases(declarations []Declaration) []*TypeAliasDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._typeAliases
+}
+
+func (i *memberIndices) Extensions(declarations []Declaration) []*ExtensionDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._extensions
+}
+
+func (i *memberIndices) Entitlements(declarations []Declaration) []*EntitlementDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._entitlements
+}
+
 func (i *memberIndices) initializer(declarations []Declaration) func() {
 	return func() {
 		i.init(declarations)
@@ -145,6 +166,12 @@ func (i *memberIndices) init(declarations []Declaration) {
 
 	i._enumCases = make([]*EnumCaseDeclaration, 0)
 
+	i._typeAliases = make([]*TypeAliasDeclaration, 0)
+
+	i._extensions = make([]*ExtensionDeclaration, 0)
+
+	i._entitlements = make([]*EntitlementDeclaration, 0)
+
 	for _, declaration := range declarations {
 		switch declaration := declaration.(type) {
 		case *FieldDeclaration:
@@ -175,6 +202,15 @@ func (i *memberIndices) init(declarations []Declaration) {
 
 		case *EnumCaseDeclaration:
 			i._enumCases = append(i._enumCases, declaration)
+
+		case *TypeAliasDeclaration:
+			i._typeAliases = append(i._typeAliases, declaration)
+
+		case *ExtensionDeclaration:
+			i._extensions = append(i._extensions, declaration)
+
+		case *EntitlementDeclaration:
+			i._entitlements = append(i._entitlements, declaration)
 		}
 	}
 }
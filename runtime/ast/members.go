@@ -65,6 +65,18 @@ func (m *Members) EnumCases() []*EnumCaseDeclaration {
 	return m.indices.EnumCases(m.declarations)
 }
 
+func (m *Members) TypeAliases() []*TypeAliasDeclaration {
+	return m.indices.TypeAliases(m.declarations)
+}
+
+func (m *Members) Extensions() []*ExtensionDeclaration {
+	return m.indices.Extensions(m.declarations)
+}
+
+func (m *Members) Entitlements() []*EntitlementDeclaration {
+	return m.indices.Entitlements(m.declarations)
+}
+
 func (m *Members) FieldsByIdentifier() map[string]*FieldDeclaration {
 	return m.indices.FieldsByIdentifier(m.declarations)
 }
@@ -85,6 +85,18 @@ func (p *Program) TransactionDeclarations() []*TransactionDeclaration {
 	return p.indices.transactionDeclarations(p.declarations)
 }
 
+func (p *Program) TypeAliasDeclarations() []*TypeAliasDeclaration {
+	return p.indices.typeAliasDeclarations(p.declarations)
+}
+
+func (p *Program) ExtensionDeclarations() []*ExtensionDeclaration {
+	return p.indices.extensionDeclarations(p.declarations)
+}
+
+func (p *Program) EntitlementDeclarations() []*EntitlementDeclaration {
+	return p.indices.entitlementDeclarations(p.declarations)
+}
+
 // SoleContractDeclaration returns the sole contract declaration, if any,
 // and if there are no other actionable declarations.
 //
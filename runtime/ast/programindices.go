@@ -38,6 +38,12 @@ type programIndices struct {
 	_functionDeclarations []*FunctionDeclaration
 	// Use `transactionDeclarations()` instead
 	_transactionDeclarations []*TransactionDeclaration
+	// Use `typeAliasDeclarations()` instead
+	_typeAliasDeclarations []*TypeAliasDeclaration
+	// Use `extensionDeclarations()` instead
+	_extensionDeclarations []*ExtensionDeclaration
+	// Use `entitlementDeclarations()` instead
+	_entitlementDeclarations []*EntitlementDeclaration
 }
 
 func (i *programIndices) pragmaDeclarations(declarations []Declaration) []*PragmaDeclaration {
@@ -70,6 +76,21 @@ func (i *programIndices) transactionDeclarations(declarations []Declaration) []*
 	return i._transactionDeclarations
 }
 
+func (i *programIndices) typeAliasDeclarations(declarations []Declaration) []*TypeAliasDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._typeAliasDeclarations
+}
+
+func (i *programIndices) extensionDeclarations(declarations []Declaration) []*ExtensionDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._extensionDeclarations
+}
+
+func (i *programIndices) entitlementDeclarations(declarations []Declaration) []*EntitlementDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._entitlementDeclarations
+}
+
 func (i *programIndices) initializer(declarations []Declaration) func() {
 	return func() {
 		i.init(declarations)
@@ -86,6 +107,9 @@ func (i *programIndices) init(declarations []Declaration) {
 	i._interfaceDeclarations = make([]*InterfaceDeclaration, 0)
 	i._functionDeclarations = make([]*FunctionDeclaration, 0)
 	i._transactionDeclarations = make([]*TransactionDeclaration, 0)
+	i._typeAliasDeclarations = make([]*TypeAliasDeclaration, 0)
+	i._extensionDeclarations = make([]*ExtensionDeclaration, 0)
+	i._entitlementDeclarations = make([]*EntitlementDeclaration, 0)
 
 	for _, declaration := range declarations {
 
@@ -107,6 +131,15 @@ func (i *programIndices) init(declarations []Declaration) {
 
 		case *TransactionDeclaration:
 			i._transactionDeclarations = append(i._transactionDeclarations, declaration)
+
+		case *TypeAliasDeclaration:
+			i._typeAliasDeclarations = append(i._typeAliasDeclarations, declaration)
+
+		case *ExtensionDeclaration:
+			i._extensionDeclarations = append(i._extensionDeclarations, declaration)
+
+		case *EntitlementDeclaration:
+			i._entitlementDeclarations = append(i._entitlementDeclarations, declaration)
 		}
 	}
 }
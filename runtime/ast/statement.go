@@ -54,6 +54,7 @@ func (s *ReturnStatement) MarshalJSON() ([]byte, error) {
 // BreakStatement
 
 type BreakStatement struct {
+	Label *Identifier `json:",omitempty"`
 	Range
 }
 
@@ -77,6 +78,7 @@ func (s *BreakStatement) MarshalJSON() ([]byte, error) {
 // ContinueStatement
 
 type ContinueStatement struct {
+	Label *Identifier `json:",omitempty"`
 	Range
 }
 
@@ -145,6 +147,7 @@ func (s *IfStatement) MarshalJSON() ([]byte, error) {
 // WhileStatement
 
 type WhileStatement struct {
+	Label    *Identifier `json:",omitempty"`
 	Test     Expression
 	Block    *Block
 	StartPos Position `json:"-"`
@@ -180,6 +183,7 @@ func (s *WhileStatement) MarshalJSON() ([]byte, error) {
 // ForStatement
 
 type ForStatement struct {
+	Label      *Identifier `json:",omitempty"`
 	Identifier Identifier
 	Value      Expression
 	Block      *Block
@@ -247,6 +247,7 @@ func (t *DictionaryType) CheckEqual(other Type, checker TypeEqualityChecker) err
 // FunctionType
 
 type FunctionType struct {
+	Purity                   FunctionPurity    `json:",omitempty"`
 	ParameterTypeAnnotations []*TypeAnnotation `json:",omitempty"`
 	ReturnTypeAnnotation     *TypeAnnotation
 	Range
@@ -263,7 +264,12 @@ func (t *FunctionType) String() string {
 		parameters.WriteString(parameterTypeAnnotation.String())
 	}
 
-	return fmt.Sprintf("((%s): %s)", parameters.String(), t.ReturnTypeAnnotation.String())
+	purity := ""
+	if t.Purity != FunctionPurityUnspecified {
+		purity = t.Purity.Keyword() + " "
+	}
+
+	return fmt.Sprintf("(%s(%s): %s)", purity, parameters.String(), t.ReturnTypeAnnotation.String())
 }
 
 func (t *FunctionType) MarshalJSON() ([]byte, error) {
@@ -284,9 +290,10 @@ func (t *FunctionType) CheckEqual(other Type, checker TypeEqualityChecker) error
 // ReferenceType
 
 type ReferenceType struct {
-	Authorized bool
-	Type       Type     `json:"ReferencedType"`
-	StartPos   Position `json:"-"`
+	Authorized   bool
+	Entitlements []*NominalType `json:",omitempty"`
+	Type         Type           `json:"ReferencedType"`
+	StartPos     Position       `json:"-"`
 }
 
 func (*ReferenceType) isType() {}
@@ -295,6 +302,15 @@ func (t *ReferenceType) String() string {
 	var builder strings.Builder
 	if t.Authorized {
 		builder.WriteString("auth ")
+	} else if len(t.Entitlements) > 0 {
+		builder.WriteString("auth(")
+		for i, entitlement := range t.Entitlements {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(entitlement.String())
+		}
+		builder.WriteString(") ")
 	}
 	builder.WriteRune('&')
 	builder.WriteString(t.Type.String())
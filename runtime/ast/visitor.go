@@ -71,6 +71,7 @@ type ExpressionVisitor interface {
 	VisitBinaryExpression(*BinaryExpression) Repr
 	VisitFunctionExpression(*FunctionExpression) Repr
 	VisitStringExpression(*StringExpression) Repr
+	VisitStringTemplateExpression(*StringTemplateExpression) Repr
 	VisitCastingExpression(*CastingExpression) Repr
 	VisitCreateExpression(*CreateExpression) Repr
 	VisitDestroyExpression(*DestroyExpression) Repr
@@ -93,4 +94,7 @@ type Visitor interface {
 	VisitPragmaDeclaration(*PragmaDeclaration) Repr
 	VisitImportDeclaration(*ImportDeclaration) Repr
 	VisitTransactionDeclaration(*TransactionDeclaration) Repr
+	VisitTypeAliasDeclaration(*TypeAliasDeclaration) Repr
+	VisitExtensionDeclaration(*ExtensionDeclaration) Repr
+	VisitEntitlementDeclaration(*EntitlementDeclaration) Repr
 }
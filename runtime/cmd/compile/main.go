@@ -57,7 +57,11 @@ func main() {
 	funcs := make([]*ir.Func, len(functionDeclarations))
 
 	for i, functionDeclaration := range functionDeclarations {
-		funcs[i] = functionDeclaration.Accept(comp).(*ir.Func)
+		function, err := comp.CompileFunction(functionDeclaration)
+		if err != nil {
+			cmd.ExitWithError(err.Error())
+		}
+		funcs[i] = function
 	}
 
 	// Generate a WebAssembly module for the functions
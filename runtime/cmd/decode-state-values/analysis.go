@@ -0,0 +1,461 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// entry is an entry of a state dump
+//
+type entry struct {
+	Owner string
+	Key   string
+	Value string
+}
+
+// decodingFailure is a value which could not be decoded
+//
+type decodingFailure struct {
+	Owner   string
+	Key     string
+	Version uint16
+	// Path is the position within the value of the last value
+	// which was visited before decoding failed
+	Path []string
+	Err  error
+}
+
+func (f decodingFailure) String() string {
+	return fmt.Sprintf(
+		"owner %s, key %q, version %d, path %q: %s",
+		f.Owner,
+		f.Key,
+		f.Version,
+		strings.Join(f.Path, "."),
+		f.Err,
+	)
+}
+
+// sizeStats are the number and the total encoded size of values
+//
+type sizeStats struct {
+	Count int
+	Size  int
+}
+
+func (s *sizeStats) add(size int) {
+	s.Count++
+	s.Size += size
+}
+
+// typeStats are the statistics of the stored values of a type
+//
+type typeStats struct {
+	// Values are the stored values of the type,
+	// i.e. the values which are directly stored under a key
+	Values sizeStats
+	// Occurrences is the number of stored and nested composite values of the type
+	Occurrences int
+}
+
+// storedValue is a value stored under a key in an account
+//
+type storedValue struct {
+	Key    string
+	TypeID string
+	Size   int
+}
+
+// accountStats are the statistics of the values stored in an account
+//
+type accountStats struct {
+	Values  sizeStats
+	Largest []storedValue
+}
+
+// decodedValue is a successfully decoded value of the state dump
+//
+type decodedValue struct {
+	Owner string
+	Key   string
+	Value interpreter.Value
+}
+
+// analyzer decodes the values of a state dump and gathers statistics about them
+//
+type analyzer struct {
+	// largestValueCount is the number of largest values recorded per account
+	largestValueCount int
+	// onDecoded is called for each successfully decoded value (optional)
+	onDecoded func(decodedValue)
+
+	total    sizeStats
+	skipped  int
+	failures []decodingFailure
+	versions map[uint16]*sizeStats
+	types    map[string]*typeStats
+	accounts map[string]*accountStats
+}
+
+func newAnalyzer(largestValueCount int, onDecoded func(decodedValue)) *analyzer {
+	return &analyzer{
+		largestValueCount: largestValueCount,
+		onDecoded:         onDecoded,
+		versions:          map[uint16]*sizeStats{},
+		types:             map[string]*typeStats{},
+		accounts:          map[string]*accountStats{},
+	}
+}
+
+// analyze decodes and analyzes the given entry.
+//
+// Entries which are not encoded values, i.e. which have no magic prefix, are skipped
+//
+func (a *analyzer) analyze(entry entry) {
+
+	data, err := hex.DecodeString(entry.Value)
+	if err != nil {
+		a.fail(decodingFailure{
+			Owner: entry.Owner,
+			Key:   entry.Key,
+			Err:   fmt.Errorf("invalid hex-encoded value: %w", err),
+		})
+		return
+	}
+
+	data, version := interpreter.StripMagic(data)
+	if version == 0 {
+		a.skipped++
+		return
+	}
+
+	size := len(data)
+
+	a.total.add(size)
+
+	versionStats, ok := a.versions[version]
+	if !ok {
+		versionStats = &sizeStats{}
+		a.versions[version] = versionStats
+	}
+	versionStats.add(size)
+
+	value, path, err := decode(entry.Owner, data, version)
+	if err != nil {
+		a.fail(decodingFailure{
+			Owner:   entry.Owner,
+			Key:     entry.Key,
+			Version: version,
+			Path:    path,
+			Err:     err,
+		})
+		return
+	}
+
+	typeID := valueTypeID(value)
+
+	a.typeStats(typeID).Values.add(size)
+	a.countOccurrences(value)
+	a.recordAccountValue(entry.Owner, storedValue{
+		Key:    entry.Key,
+		TypeID: typeID,
+		Size:   size,
+	})
+
+	if a.onDecoded != nil {
+		a.onDecoded(decodedValue{
+			Owner: entry.Owner,
+			Key:   entry.Key,
+			Value: value,
+		})
+	}
+}
+
+func (a *analyzer) fail(failure decodingFailure) {
+	a.failures = append(a.failures, failure)
+}
+
+func (a *analyzer) typeStats(typeID string) *typeStats {
+	stats, ok := a.types[typeID]
+	if !ok {
+		stats = &typeStats{}
+		a.types[typeID] = stats
+	}
+	return stats
+}
+
+// decode decodes the given data.
+//
+// If decoding fails, the path of the last visited value is returned.
+// The decoder might panic for malformed data, so panics are reported as errors
+//
+func decode(owner string, data []byte, version uint16) (value interpreter.Value, path []string, err error) {
+
+	var ownerAddress *common.Address
+	if owner != "" {
+		var address common.Address
+		address, err = parseAddress(owner)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid owner: %w", err)
+		}
+		ownerAddress = &address
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("decoding panicked: %v", r)
+		}
+	}()
+
+	value, err = interpreter.DecodeValue(
+		data,
+		ownerAddress,
+		nil,
+		version,
+		func(_ interface{}, valuePath []string) {
+			path = valuePath
+		},
+	)
+
+	return value, path, err
+}
+
+func parseAddress(s string) (common.Address, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(data) > common.AddressLength {
+		return common.Address{}, fmt.Errorf("address too long: %s", s)
+	}
+	return common.BytesToAddress(data), nil
+}
+
+// countOccurrences counts the given value and all nested composite values by type
+//
+func (a *analyzer) countOccurrences(value interpreter.Value) {
+	switch value := value.(type) {
+	case *interpreter.SomeValue:
+		a.countOccurrences(value.Value)
+
+	case *interpreter.ArrayValue:
+		for _, element := range value.Values {
+			a.countOccurrences(element)
+		}
+
+	case *interpreter.DictionaryValue:
+		value.Entries.Foreach(func(_ string, entryValue interpreter.Value) {
+			a.countOccurrences(entryValue)
+		})
+
+	case *interpreter.CompositeValue:
+		a.typeStats(string(value.TypeID())).Occurrences++
+
+		value.Fields.Foreach(func(_ string, fieldValue interpreter.Value) {
+			a.countOccurrences(fieldValue)
+		})
+	}
+}
+
+// recordAccountValue records the value for the account,
+// and keeps the account's largest values, ordered by size, largest first
+//
+func (a *analyzer) recordAccountValue(owner string, value storedValue) {
+	stats, ok := a.accounts[owner]
+	if !ok {
+		stats = &accountStats{}
+		a.accounts[owner] = stats
+	}
+
+	stats.Values.add(value.Size)
+
+	if a.largestValueCount <= 0 {
+		return
+	}
+
+	index := sort.Search(len(stats.Largest), func(i int) bool {
+		return stats.Largest[i].Size < value.Size
+	})
+
+	if index >= a.largestValueCount {
+		return
+	}
+
+	stats.Largest = append(stats.Largest, storedValue{})
+	copy(stats.Largest[index+1:], stats.Largest[index:])
+	stats.Largest[index] = value
+
+	if len(stats.Largest) > a.largestValueCount {
+		stats.Largest = stats.Largest[:a.largestValueCount]
+	}
+}
+
+// valueTypeID returns the type ID of composite values,
+// and the kind of other values
+//
+func valueTypeID(value interpreter.Value) string {
+	switch value := value.(type) {
+	case *interpreter.CompositeValue:
+		return string(value.TypeID())
+	case *interpreter.SomeValue:
+		return valueTypeID(value.Value) + "?"
+	case interpreter.NilValue:
+		return "Never?"
+	case *interpreter.ArrayValue:
+		return "Array"
+	case *interpreter.DictionaryValue:
+		return "Dictionary"
+	case interpreter.LinkValue:
+		return "Link"
+	case interpreter.PathValue:
+		return "Path"
+	case interpreter.CapabilityValue:
+		return "Capability"
+	case interpreter.TypeValue:
+		return "Type"
+	}
+
+	staticType := value.StaticType()
+	if staticType == nil {
+		return fmt.Sprintf("%T", value)
+	}
+	return staticTypeID(staticType)
+}
+
+// report writes the gathered statistics to the given writer.
+// At most accountLimit accounts are reported, the accounts with the largest values first.
+// If accountLimit is 0, all accounts are reported
+//
+func (a *analyzer) report(w io.Writer, accountLimit int) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	write := func(format string, args ...interface{}) {
+		_, _ = fmt.Fprintf(tw, format, args...)
+	}
+
+	write("values:\t%d\tbytes:\t%d\t\n", a.total.Count, a.total.Size)
+	write("skipped:\t%d\t\t\t\n", a.skipped)
+	write("failed:\t%d\t\t\t\n", len(a.failures))
+
+	// Versions
+
+	versions := make([]uint16, 0, len(a.versions))
+	for version := range a.versions { //nolint:maprangecheck
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] < versions[j]
+	})
+
+	write("\nversion\tvalues\tbytes\t\n")
+	for _, version := range versions {
+		stats := a.versions[version]
+		write("%d\t%d\t%d\t\n", version, stats.Count, stats.Size)
+	}
+
+	// Types, largest total size first
+
+	typeIDs := make([]string, 0, len(a.types))
+	for typeID := range a.types { //nolint:maprangecheck
+		typeIDs = append(typeIDs, typeID)
+	}
+	sort.Slice(typeIDs, func(i, j int) bool {
+		first := a.types[typeIDs[i]]
+		second := a.types[typeIDs[j]]
+		if first.Values.Size != second.Values.Size {
+			return first.Values.Size > second.Values.Size
+		}
+		if first.Occurrences != second.Occurrences {
+			return first.Occurrences > second.Occurrences
+		}
+		return typeIDs[i] < typeIDs[j]
+	})
+
+	write("\ntype\tvalues\tbytes\toccurrences\t\n")
+	for _, typeID := range typeIDs {
+		stats := a.types[typeID]
+		write("%s\t%d\t%d\t%d\t\n", typeID, stats.Values.Count, stats.Values.Size, stats.Occurrences)
+	}
+
+	// Accounts, largest value first
+
+	owners := make([]string, 0, len(a.accounts))
+	for owner := range a.accounts { //nolint:maprangecheck
+		owners = append(owners, owner)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		first := a.accounts[owners[i]]
+		second := a.accounts[owners[j]]
+		if len(first.Largest) > 0 &&
+			len(second.Largest) > 0 &&
+			first.Largest[0].Size != second.Largest[0].Size {
+
+			return first.Largest[0].Size > second.Largest[0].Size
+		}
+		if first.Values.Size != second.Values.Size {
+			return first.Values.Size > second.Values.Size
+		}
+		return owners[i] < owners[j]
+	})
+
+	if accountLimit > 0 && len(owners) > accountLimit {
+		owners = owners[:accountLimit]
+	}
+
+	if a.largestValueCount > 0 {
+		write("\naccount\tkey\ttype\tbytes\t\n")
+		for _, owner := range owners {
+			for _, value := range a.accounts[owner].Largest {
+				write("%s\t%q\t%s\t%d\t\n", owner, value.Key, value.TypeID, value.Size)
+			}
+		}
+	}
+
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	// Failures
+
+	if len(a.failures) > 0 {
+		_, err = fmt.Fprintln(w, "\nfailures:")
+		if err != nil {
+			return err
+		}
+
+		for _, failure := range a.failures {
+			_, err = fmt.Fprintln(w, failure)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+const testOwner = "0000000000000001"
+
+var testLocation = common.AddressLocation{
+	Address: common.BytesToAddress([]byte{0x1}),
+	Name:    "Test",
+}
+
+func encodeTestValue(t *testing.T, value interpreter.Value) string {
+	encoded, _, err := interpreter.EncodeValue(value, nil, false, nil)
+	require.NoError(t, err)
+
+	return hex.EncodeToString(
+		interpreter.PrependMagic(encoded, interpreter.CurrentEncodingVersion),
+	)
+}
+
+func newTestComposite(qualifiedIdentifier string, fields map[string]interpreter.Value, names ...string) *interpreter.CompositeValue {
+	fieldValues := interpreter.NewStringValueOrderedMap()
+	for _, name := range names {
+		fieldValues.Set(name, fields[name])
+	}
+
+	return interpreter.NewCompositeValue(
+		testLocation,
+		qualifiedIdentifier,
+		common.CompositeKindStructure,
+		fieldValues,
+		nil,
+	)
+}
+
+func TestAnalyze(t *testing.T) {
+
+	t.Parallel()
+
+	inner := newTestComposite(
+		"Test.Inner",
+		map[string]interpreter.Value{
+			"id": interpreter.UInt64Value(42),
+		},
+		"id",
+	)
+
+	outer := newTestComposite(
+		"Test.Outer",
+		map[string]interpreter.Value{
+			"name":  interpreter.NewStringValue("test"),
+			"inner": interpreter.NewSomeValueOwningNonCopying(inner),
+		},
+		"name", "inner",
+	)
+
+	var exported bytes.Buffer
+
+	analyzer := newAnalyzer(
+		1,
+		newExporter(&exported, []string{"A.0000000000000001.Test.Outer"}, nil),
+	)
+
+	for _, entry := range []entry{
+		{
+			Owner: testOwner,
+			Key:   "storage\x1fouter",
+			Value: encodeTestValue(t, outer),
+		},
+		{
+			Owner: testOwner,
+			Key:   "storage\x1finner",
+			Value: encodeTestValue(t, inner),
+		},
+		{
+			Owner: testOwner,
+			Key:   "storage\x1fnumbers",
+			Value: encodeTestValue(t,
+				interpreter.NewArrayValueUnownedNonCopying(
+					interpreter.NewIntValueFromInt64(1),
+					interpreter.NewIntValueFromInt64(2),
+				),
+			),
+		},
+		// Not an encoded value, skipped
+		{
+			Owner: testOwner,
+			Key:   "contract_names",
+			Value: "0102",
+		},
+		// Invalid encoded value
+		{
+			Owner: "0000000000000002",
+			Key:   "storage\x1fbroken",
+			Value: hex.EncodeToString(
+				interpreter.PrependMagic([]byte{0xff}, interpreter.CurrentEncodingVersion),
+			),
+		},
+	} {
+		analyzer.analyze(entry)
+	}
+
+	assert.Equal(t, 4, analyzer.total.Count)
+	assert.Equal(t, 1, analyzer.skipped)
+
+	require.Len(t, analyzer.failures, 1)
+	failure := analyzer.failures[0]
+	assert.Equal(t, "0000000000000002", failure.Owner)
+	assert.Equal(t, "storage\x1fbroken", failure.Key)
+	assert.Equal(t, interpreter.CurrentEncodingVersion, failure.Version)
+
+	require.Len(t, analyzer.versions, 1)
+	assert.Equal(t, 4, analyzer.versions[interpreter.CurrentEncodingVersion].Count)
+
+	outerStats := analyzer.types["A.0000000000000001.Test.Outer"]
+	require.NotNil(t, outerStats)
+	assert.Equal(t, 1, outerStats.Values.Count)
+	assert.Equal(t, 1, outerStats.Occurrences)
+
+	innerStats := analyzer.types["A.0000000000000001.Test.Inner"]
+	require.NotNil(t, innerStats)
+	assert.Equal(t, 1, innerStats.Values.Count)
+	assert.Equal(t, 2, innerStats.Occurrences)
+
+	arrayStats := analyzer.types["Array"]
+	require.NotNil(t, arrayStats)
+	assert.Equal(t, 1, arrayStats.Values.Count)
+
+	// Only the largest value of the account is recorded
+
+	account := analyzer.accounts[testOwner]
+	require.NotNil(t, account)
+	assert.Equal(t, 3, account.Values.Count)
+	require.Len(t, account.Largest, 1)
+	assert.Equal(t, "storage\x1fouter", account.Largest[0].Key)
+	assert.Equal(t, "A.0000000000000001.Test.Outer", account.Largest[0].TypeID)
+
+	// Only the selected type is exported
+
+	lines := strings.Split(strings.TrimSpace(exported.String()), "\n")
+	require.Len(t, lines, 1)
+
+	var exportedEntry exportedEntry
+	err := json.Unmarshal([]byte(lines[0]), &exportedEntry)
+	require.NoError(t, err)
+
+	assert.Equal(t, testOwner, exportedEntry.Owner)
+	assert.Equal(t, "storage\x1fouter", exportedEntry.Key)
+
+	exportedValue, err := jsoncdc.Decode(exportedEntry.Value)
+	require.NoError(t, err)
+
+	outerValue, ok := exportedValue.(cadence.Struct)
+	require.True(t, ok)
+	assert.Equal(t, "A.0000000000000001.Test.Outer", outerValue.StructType.ID())
+	require.Len(t, outerValue.Fields, 2)
+
+	exportedFields := map[string]cadence.Value{}
+	for i, field := range outerValue.StructType.Fields {
+		exportedFields[field.Identifier] = outerValue.Fields[i]
+	}
+	assert.Equal(t, cadence.NewString("test"), exportedFields["name"])
+	assert.IsType(t, cadence.Optional{}, exportedFields["inner"])
+
+	// The report contains all sections
+
+	var report bytes.Buffer
+	err = analyzer.report(&report, 0)
+	require.NoError(t, err)
+
+	assert.Contains(t, report.String(), "A.0000000000000001.Test.Outer")
+	assert.Contains(t, report.String(), "failures:")
+	assert.Contains(t, report.String(), `key "storage\x1fbroken"`)
+}
+
+func TestStaticTypeID(t *testing.T) {
+
+	t.Parallel()
+
+	assert.Equal(t,
+		"Capability<&A.0000000000000001.Test.R{A.0000000000000001.Test.I}>",
+		staticTypeID(
+			interpreter.CapabilityStaticType{
+				BorrowType: interpreter.ReferenceStaticType{
+					Type: &interpreter.RestrictedStaticType{
+						Type: interpreter.CompositeStaticType{
+							Location:            testLocation,
+							QualifiedIdentifier: "Test.R",
+						},
+						Restrictions: []interpreter.InterfaceStaticType{
+							{
+								Location:            testLocation,
+								QualifiedIdentifier: "Test.I",
+							},
+						},
+					},
+				},
+			},
+		),
+	)
+
+	assert.Equal(t,
+		"{String:[Int]}",
+		staticTypeID(
+			interpreter.DictionaryStaticType{
+				KeyType:   interpreter.PrimitiveStaticTypeString,
+				ValueType: interpreter.VariableSizedStaticType{Type: interpreter.PrimitiveStaticTypeInt},
+			},
+		),
+	)
+}
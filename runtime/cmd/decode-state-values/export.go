@@ -0,0 +1,281 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// exportValue converts a decoded stored value to a cadence.Value.
+//
+// Unlike runtime.ExportValue, it does not require an interpreter and the programs of the contracts
+// which declare the stored composite types: the exported composite types only have the fields
+// of the stored value, and the field types are unknown (nil).
+//
+// Dictionaries with deferred values cannot be exported,
+// as the deferred values are stored under separate keys.
+//
+func exportValue(value interpreter.Value) (cadence.Value, error) {
+	switch v := value.(type) {
+	case interpreter.VoidValue:
+		return cadence.NewVoid(), nil
+	case interpreter.NilValue:
+		return cadence.NewOptional(nil), nil
+	case *interpreter.SomeValue:
+		inner, err := exportValue(v.Value)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewOptional(inner), nil
+	case interpreter.BoolValue:
+		return cadence.NewBool(bool(v)), nil
+	case *interpreter.StringValue:
+		return cadence.NewString(v.Str), nil
+	case *interpreter.ArrayValue:
+		return exportArrayValue(v)
+	case interpreter.IntValue:
+		return cadence.NewIntFromBig(v.ToBigInt()), nil
+	case interpreter.Int8Value:
+		return cadence.NewInt8(int8(v)), nil
+	case interpreter.Int16Value:
+		return cadence.NewInt16(int16(v)), nil
+	case interpreter.Int32Value:
+		return cadence.NewInt32(int32(v)), nil
+	case interpreter.Int64Value:
+		return cadence.NewInt64(int64(v)), nil
+	case interpreter.Int128Value:
+		return cadence.NewInt128FromBig(v.ToBigInt()), nil
+	case interpreter.Int256Value:
+		return cadence.NewInt256FromBig(v.ToBigInt()), nil
+	case interpreter.UIntValue:
+		return cadence.NewUIntFromBig(v.ToBigInt()), nil
+	case interpreter.UInt8Value:
+		return cadence.NewUInt8(uint8(v)), nil
+	case interpreter.UInt16Value:
+		return cadence.NewUInt16(uint16(v)), nil
+	case interpreter.UInt32Value:
+		return cadence.NewUInt32(uint32(v)), nil
+	case interpreter.UInt64Value:
+		return cadence.NewUInt64(uint64(v)), nil
+	case interpreter.UInt128Value:
+		return cadence.NewUInt128FromBig(v.ToBigInt()), nil
+	case interpreter.UInt256Value:
+		return cadence.NewUInt256FromBig(v.ToBigInt()), nil
+	case interpreter.Word8Value:
+		return cadence.NewWord8(uint8(v)), nil
+	case interpreter.Word16Value:
+		return cadence.NewWord16(uint16(v)), nil
+	case interpreter.Word32Value:
+		return cadence.NewWord32(uint32(v)), nil
+	case interpreter.Word64Value:
+		return cadence.NewWord64(uint64(v)), nil
+	case interpreter.Fix64Value:
+		return cadence.Fix64(v), nil
+	case interpreter.UFix64Value:
+		return cadence.UFix64(v), nil
+	case interpreter.Fix128Value:
+		return cadence.Fix128{Value: new(big.Int).Set(v.BigInt)}, nil
+	case interpreter.UFix128Value:
+		return cadence.UFix128{Value: new(big.Int).Set(v.BigInt)}, nil
+	case *interpreter.CompositeValue:
+		return exportCompositeValue(v)
+	case *interpreter.DictionaryValue:
+		return exportDictionaryValue(v)
+	case interpreter.AddressValue:
+		return cadence.NewAddress(v), nil
+	case interpreter.LinkValue:
+		return cadence.NewLink(
+			exportPathValue(v.TargetPath),
+			staticTypeID(v.Type),
+		), nil
+	case interpreter.PathValue:
+		return exportPathValue(v), nil
+	case interpreter.TypeValue:
+		return cadence.TypeValue{
+			StaticType: staticTypeID(v.Type),
+		}, nil
+	case interpreter.CapabilityValue:
+		return cadence.Capability{
+			Path:       exportPathValue(v.Path),
+			Address:    cadence.NewAddress(v.Address),
+			BorrowType: staticTypeID(v.BorrowType),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("cannot export value of type %T", value)
+}
+
+func exportArrayValue(v *interpreter.ArrayValue) (cadence.Value, error) {
+	values := make([]cadence.Value, len(v.Values))
+
+	for i, value := range v.Values {
+		exported, err := exportValue(value)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = exported
+	}
+
+	return cadence.NewArray(values), nil
+}
+
+func exportDictionaryValue(v *interpreter.DictionaryValue) (cadence.Value, error) {
+	if v.DeferredKeys != nil && v.DeferredKeys.Len() > 0 {
+		return nil, fmt.Errorf(
+			"cannot export dictionary with deferred values stored under %q",
+			v.DeferredStorageKeyBase,
+		)
+	}
+
+	pairs := make([]cadence.KeyValuePair, len(v.Keys.Values))
+
+	for i, key := range v.Keys.Values {
+
+		// NOTE: there are no deferred values, so getting the value
+		// does not require an interpreter to load it from storage
+
+		value := v.Get(nil, interpreter.ReturnEmptyLocationRange, key).(*interpreter.SomeValue).Value
+
+		exportedKey, err := exportValue(key)
+		if err != nil {
+			return nil, err
+		}
+
+		exportedValue, err := exportValue(value)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs[i] = cadence.KeyValuePair{
+			Key:   exportedKey,
+			Value: exportedValue,
+		}
+	}
+
+	return cadence.NewDictionary(pairs), nil
+}
+
+func exportCompositeValue(v *interpreter.CompositeValue) (cadence.Value, error) {
+	var fieldTypes []cadence.Field
+	var fields []cadence.Value
+	var err error
+
+	v.Fields.Foreach(func(name string, value interpreter.Value) {
+		if err != nil {
+			return
+		}
+
+		var exported cadence.Value
+		exported, err = exportValue(value)
+
+		fieldTypes = append(fieldTypes, cadence.Field{Identifier: name})
+		fields = append(fields, exported)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch v.Kind {
+	case common.CompositeKindStructure:
+		return cadence.NewStruct(fields).WithType(&cadence.StructType{
+			Location:            v.Location,
+			QualifiedIdentifier: v.QualifiedIdentifier,
+			Fields:              fieldTypes,
+		}), nil
+
+	case common.CompositeKindResource:
+		return cadence.NewResource(fields).WithType(&cadence.ResourceType{
+			Location:            v.Location,
+			QualifiedIdentifier: v.QualifiedIdentifier,
+			Fields:              fieldTypes,
+		}), nil
+
+	case common.CompositeKindEvent:
+		return cadence.NewEvent(fields).WithType(&cadence.EventType{
+			Location:            v.Location,
+			QualifiedIdentifier: v.QualifiedIdentifier,
+			Fields:              fieldTypes,
+		}), nil
+
+	case common.CompositeKindContract:
+		return cadence.NewContract(fields).WithType(&cadence.ContractType{
+			Location:            v.Location,
+			QualifiedIdentifier: v.QualifiedIdentifier,
+			Fields:              fieldTypes,
+		}), nil
+
+	case common.CompositeKindEnum:
+		return cadence.NewEnum(fields).WithType(&cadence.EnumType{
+			Location:            v.Location,
+			QualifiedIdentifier: v.QualifiedIdentifier,
+			Fields:              fieldTypes,
+		}), nil
+	}
+
+	return nil, fmt.Errorf("cannot export composite of kind %s", v.Kind)
+}
+
+func exportPathValue(v interpreter.PathValue) cadence.Path {
+	return cadence.Path{
+		Domain:     v.Domain.Identifier(),
+		Identifier: v.Identifier,
+	}
+}
+
+// staticTypeID returns the type ID of the given static type.
+//
+// The programs of the contracts which declare composite, interface, and entitlement types are not available,
+// so these types are only identified by their location and qualified identifier
+//
+func staticTypeID(staticType interpreter.StaticType) string {
+	if staticType == nil {
+		return ""
+	}
+
+	semaType := interpreter.ConvertStaticToSemaType(
+		staticType,
+		func(location common.Location, qualifiedIdentifier string) *sema.InterfaceType {
+			return &sema.InterfaceType{
+				Location:   location,
+				Identifier: qualifiedIdentifier,
+			}
+		},
+		func(location common.Location, qualifiedIdentifier string) *sema.CompositeType {
+			return &sema.CompositeType{
+				Location:   location,
+				Identifier: qualifiedIdentifier,
+			}
+		},
+		func(location common.Location, qualifiedIdentifier string) *sema.EntitlementType {
+			return &sema.EntitlementType{
+				Location:   location,
+				Identifier: qualifiedIdentifier,
+			}
+		},
+	)
+
+	return string(semaType.ID())
+}
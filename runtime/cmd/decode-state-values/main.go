@@ -16,32 +16,113 @@
  * limitations under the License.
  */
 
-// A utility program that parses a state dump in JSON Lines format and decodes all values
+// A utility program that parses a state dump in JSON Lines format, decodes all values,
+// and reports statistics about them:
+//
+// - Decoding failures, with the owner, key, and the position within the value
+// - The number and encoded size of values per type and per encoding version
+// - The largest values per account
+//
+// Decoded values can optionally be exported in JSON-Cadence format.
+//
+// Each line of the state dump is a JSON object with the fields `owner`, `key`, and `value`,
+// where the value is hex-encoded.
 
 package main
 
 import (
-	"encoding/hex"
+	"bufio"
 	"encoding/json"
+	"flag"
+	"fmt"
 	"io"
 	"log"
 	"os"
+	"strings"
 
-	"github.com/onflow/cadence/runtime/interpreter"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
 )
 
+var largestFlag = flag.Int("largest", 5, "number of largest values reported per account")
+var accountsFlag = flag.Int("accounts", 20, "number of accounts reported, 0 for all accounts")
+var exportFlag = flag.String("export", "", "write decoded values in JSON-Cadence format to the given JSON Lines file")
+var exportTypesFlag = flag.String("export-types", "", "only export values of the given comma-separated types")
+var exportOwnersFlag = flag.String("export-owners", "", "only export values of the given comma-separated accounts")
+
+// exportedEntry is an exported value
+//
+type exportedEntry struct {
+	Owner string          `json:"owner"`
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
 func main() {
-	file, err := os.Open(os.Args[1])
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [options] <state dump>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	os.Exit(run(flag.Arg(0)))
+}
+
+// run analyzes the given state dump and returns the exit code.
+//
+// The exit code is returned instead of exiting directly,
+// so that deferred functions, e.g. flushing the export file, are run
+//
+func run(path string) (exitCode int) {
+
+	file, err := os.Open(path)
 	if err != nil {
-		log.Fatal(err)
+		log.Print(err)
+		return 1
 	}
 	defer file.Close()
 
-	var entry struct {
-		Value string
+	var onDecoded func(decodedValue)
+
+	if *exportFlag != "" {
+		var exportFile *os.File
+		exportFile, err = os.Create(*exportFlag)
+		if err != nil {
+			log.Print(err)
+			return 1
+		}
+		defer func() {
+			err := exportFile.Close()
+			if err != nil {
+				log.Printf("failed to close export file: %s", err)
+				exitCode = 1
+			}
+		}()
+
+		writer := bufio.NewWriter(exportFile)
+		defer func() {
+			err := writer.Flush()
+			if err != nil {
+				log.Printf("failed to write export file: %s", err)
+				exitCode = 1
+			}
+		}()
+
+		onDecoded = newExporter(
+			writer,
+			splitList(*exportTypesFlag),
+			splitList(*exportOwnersFlag),
+		)
 	}
 
-	var decoded int
+	analyzer := newAnalyzer(*largestFlag, onDecoded)
+
+	var entry entry
 
 	decoder := json.NewDecoder(file)
 	for lines := 0; ; lines++ {
@@ -54,29 +135,84 @@ func main() {
 			if err == io.EOF {
 				break
 			}
-			log.Fatal(err)
+			log.Print(err)
+			return 1
 		}
 
-		var data []byte
-		data, err = hex.DecodeString(entry.Value)
+		analyzer.analyze(entry)
+	}
+
+	println()
+
+	err = analyzer.report(os.Stdout, *accountsFlag)
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+
+	if len(analyzer.failures) > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// newExporter returns a function which writes decoded values in JSON-Cadence format as JSON Lines.
+// If types or owners are given, only values of the given types and accounts are exported
+//
+func newExporter(w io.Writer, types []string, owners []string) func(decodedValue) {
+
+	selectedTypes := make(map[string]struct{}, len(types))
+	for _, typeID := range types {
+		selectedTypes[typeID] = struct{}{}
+	}
+
+	selectedOwners := make(map[string]struct{}, len(owners))
+	for _, owner := range owners {
+		selectedOwners[owner] = struct{}{}
+	}
+
+	encoder := json.NewEncoder(w)
+
+	return func(value decodedValue) {
+		if len(selectedTypes) > 0 {
+			if _, ok := selectedTypes[valueTypeID(value.Value)]; !ok {
+				return
+			}
+		}
+
+		if len(selectedOwners) > 0 {
+			if _, ok := selectedOwners[value.Owner]; !ok {
+				return
+			}
+		}
+
+		exported, err := exportValue(value.Value)
 		if err != nil {
-			log.Fatal(err)
+			log.Printf("failed to export value of owner %s, key %q: %s", value.Owner, value.Key, err)
+			return
 		}
 
-		var version uint16
-		data, version = interpreter.StripMagic(data)
-		if version == 0 {
-			continue
+		encoded, err := jsoncdc.Encode(exported)
+		if err != nil {
+			log.Printf("failed to encode value of owner %s, key %q: %s", value.Owner, value.Key, err)
+			return
 		}
-		_, err = interpreter.DecodeValue(data, nil, nil, version, nil)
+
+		err = encoder.Encode(exportedEntry{
+			Owner: value.Owner,
+			Key:   value.Key,
+			Value: encoded,
+		})
 		if err != nil {
 			log.Fatal(err)
 		}
-
-		decoded++
 	}
+}
 
-	println()
-
-	log.Printf("successfully decoded %d values", decoded)
+func splitList(list string) []string {
+	if list == "" {
+		return nil
+	}
+	return strings.Split(list, ",")
 }
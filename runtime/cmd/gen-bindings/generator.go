@@ -0,0 +1,1097 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+const (
+	cadenceImportPath = "github.com/onflow/cadence"
+	commonImportPath  = "github.com/onflow/cadence/runtime/common"
+	jsonImportPath    = "github.com/onflow/cadence/encoding/json"
+)
+
+type generatorConfig struct {
+	// PackageName is the name of the generated Go package
+	PackageName string
+	// EntryPointName is the name prefix of the generated transaction or script bindings
+	EntryPointName string
+	// DeployedLocation, if not nil, replaces the location of the checked program
+	// in the generated Cadence types, e.g. the address location of a deployed contract
+	DeployedLocation common.Location
+}
+
+type generator struct {
+	config        generatorConfig
+	checker       *sema.Checker
+	body          *bytes.Buffer
+	inits         *bytes.Buffer
+	imports       map[string]string
+	importPaths   []string
+	typeNames     map[sema.TypeID]string
+	declarations  map[*sema.CompositeType]*ast.CompositeDeclaration
+	exportedTypes map[sema.TypeID]cadence.Type
+}
+
+// generate returns the formatted Go source code of the bindings
+// for the composite types and the entry point of the given checked program.
+func generate(config generatorConfig, program *ast.Program, checker *sema.Checker) ([]byte, error) {
+	g := &generator{
+		config:        config,
+		checker:       checker,
+		body:          &bytes.Buffer{},
+		inits:         &bytes.Buffer{},
+		imports:       map[string]string{},
+		typeNames:     map[sema.TypeID]string{},
+		declarations:  map[*sema.CompositeType]*ast.CompositeDeclaration{},
+		exportedTypes: map[sema.TypeID]cadence.Type{},
+	}
+
+	compositeTypes := g.collectCompositeTypes(program.CompositeDeclarations(), nil)
+
+	for _, compositeType := range compositeTypes {
+		g.typeNames[compositeType.ID()] = goName(compositeType.QualifiedIdentifier())
+	}
+
+	for _, compositeType := range compositeTypes {
+		err := g.writeCompositeType(compositeType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(compositeTypes) > 0 {
+		g.writeCompositeFieldsFunction()
+	}
+
+	err := g.writeEntryPoint()
+	if err != nil {
+		return nil, err
+	}
+
+	return g.source()
+}
+
+func (g *generator) collectCompositeTypes(
+	declarations []*ast.CompositeDeclaration,
+	result []*sema.CompositeType,
+) []*sema.CompositeType {
+
+	for _, declaration := range declarations {
+		compositeType := g.checker.Elaboration.CompositeDeclarationTypes[declaration]
+		if compositeType == nil {
+			continue
+		}
+
+		if compositeType.Kind != common.CompositeKindContract {
+			result = append(result, compositeType)
+			g.declarations[compositeType] = declaration
+		}
+
+		result = g.collectCompositeTypes(declaration.Members.Composites(), result)
+	}
+
+	return result
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(g.body, format, args...)
+}
+
+func (g *generator) use(importPath string) {
+	name := importPath[strings.LastIndex(importPath, "/")+1:]
+	if importPath == jsonImportPath {
+		name = "jsoncdc"
+	}
+	if _, ok := g.imports[importPath]; !ok {
+		g.importPaths = append(g.importPaths, importPath)
+	}
+	g.imports[importPath] = name
+}
+
+func (g *generator) source() ([]byte, error) {
+	var source bytes.Buffer
+
+	source.WriteString("// Code generated by gen-bindings. DO NOT EDIT.\n\n")
+	_, _ = fmt.Fprintf(&source, "package %s\n\n", g.config.PackageName)
+
+	importPaths := g.importPaths
+	// Sort standard library imports before other imports
+	sort.Slice(importPaths, func(i, j int) bool {
+		iIsStandard := !strings.Contains(importPaths[i], ".")
+		jIsStandard := !strings.Contains(importPaths[j], ".")
+		if iIsStandard != jIsStandard {
+			return iIsStandard
+		}
+		return importPaths[i] < importPaths[j]
+	})
+
+	if len(importPaths) > 0 {
+		source.WriteString("import (\n")
+		for i, importPath := range importPaths {
+			// Separate standard library imports from other imports
+			if i > 0 &&
+				!strings.Contains(importPaths[i-1], ".") &&
+				strings.Contains(importPath, ".") {
+
+				source.WriteString("\n")
+			}
+
+			name := g.imports[importPath]
+			if strings.HasSuffix(importPath, "/"+name) || importPath == name {
+				_, _ = fmt.Fprintf(&source, "\t%q\n", importPath)
+			} else {
+				_, _ = fmt.Fprintf(&source, "\t%s %q\n", name, importPath)
+			}
+		}
+		source.WriteString(")\n\n")
+	}
+
+	source.Write(g.body.Bytes())
+
+	if g.inits.Len() > 0 {
+		source.WriteString("func init() {\n")
+		source.Write(g.inits.Bytes())
+		source.WriteString("}\n")
+	}
+
+	return format.Source(source.Bytes())
+}
+
+// Composite types
+
+func (g *generator) fieldMembers(compositeType *sema.CompositeType) []*sema.Member {
+	members := make([]*sema.Member, 0, len(compositeType.Fields))
+
+	for _, identifier := range compositeType.Fields {
+		member, ok := compositeType.Members.Get(identifier)
+		if !ok || member.IgnoreInSerialization {
+			continue
+		}
+		members = append(members, member)
+	}
+
+	return members
+}
+
+func (g *generator) writeCompositeType(compositeType *sema.CompositeType) error {
+	name := g.typeNames[compositeType.ID()]
+	qualifiedIdentifier := compositeType.QualifiedIdentifier()
+	fields := g.fieldMembers(compositeType)
+
+	g.use(cadenceImportPath)
+
+	// Type declaration
+
+	if compositeType.Kind == common.CompositeKindEnum {
+		g.writeEnumType(compositeType, name)
+	} else {
+		g.printf(
+			"// %s is the Go representation of the Cadence %s `%s`.\n",
+			name,
+			compositeType.Kind.Name(),
+			qualifiedIdentifier,
+		)
+		g.printf("type %s struct {\n", name)
+		for _, field := range fields {
+			g.printf("\t%s %s\n", goFieldName(field.Identifier.Identifier), g.goType(field.TypeAnnotation.Type))
+		}
+		g.printf("}\n\n")
+	}
+
+	// Cadence type
+
+	cadenceTypeName := cadenceTypeVariableName(name)
+	exportedType := g.exportType(compositeType)
+
+	location, err := g.locationExpression(compositeType.Location)
+	if err != nil {
+		return err
+	}
+
+	g.printf("// %s is the Cadence type of %s.\n", cadenceTypeName, name)
+	g.printf("var %s = &cadence.%s{\n", cadenceTypeName, reflect.TypeOf(exportedType).Elem().Name())
+	g.printf("\tLocation: %s,\n", location)
+	g.printf("\tQualifiedIdentifier: %q,\n", qualifiedIdentifier)
+	if compositeType.Kind == common.CompositeKindEnum {
+		rawType, err := g.cadenceTypeExpression(g.exportType(compositeType.EnumRawType))
+		if err != nil {
+			return err
+		}
+		g.printf("\tRawType: %s,\n", rawType)
+	}
+	g.printf("}\n\n")
+
+	_, _ = fmt.Fprintf(g.inits, "%s.Fields = []cadence.Field{\n", cadenceTypeName)
+	for _, field := range fields {
+		fieldType, err := g.cadenceTypeExpression(g.exportType(field.TypeAnnotation.Type))
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(
+			g.inits,
+			"{Identifier: %q, Type: %s},\n",
+			field.Identifier.Identifier,
+			fieldType,
+		)
+	}
+	_, _ = fmt.Fprintf(g.inits, "}\n")
+
+	// Encoding
+
+	var constructor string
+	switch compositeType.Kind {
+	case common.CompositeKindStructure:
+		constructor = "NewStruct"
+	case common.CompositeKindResource:
+		constructor = "NewResource"
+	case common.CompositeKindEvent:
+		constructor = "NewEvent"
+	case common.CompositeKindEnum:
+		constructor = "NewEnum"
+	}
+
+	g.printf("// ToCadenceValue converts the %s to a Cadence value.\n", name)
+	g.printf("func (v %s) ToCadenceValue() cadence.Value {\n", name)
+	g.printf("\treturn cadence.%s([]cadence.Value{\n", constructor)
+	if compositeType.Kind == common.CompositeKindEnum {
+		rawType := compositeType.EnumRawType
+		g.printf("\t\t%s,\n", g.encodeExpression(rawType, fmt.Sprintf("%s(v)", g.goType(rawType))))
+	} else {
+		for _, field := range fields {
+			fieldExpression := "v." + goFieldName(field.Identifier.Identifier)
+			g.printf("\t\t%s,\n", g.encodeExpression(field.TypeAnnotation.Type, fieldExpression))
+		}
+	}
+	g.printf("\t}).WithType(%s)\n", cadenceTypeName)
+	g.printf("}\n\n")
+
+	// Decoding
+
+	g.use("fmt")
+
+	g.printf("// %sFromCadenceValue converts the given Cadence value to a %s.\n", name, name)
+	g.printf("func %sFromCadenceValue(value cadence.Value) (result %s, err error) {\n", name, name)
+	if compositeType.Kind != common.CompositeKindEnum && len(fields) == 0 {
+		g.printf("\t_, err = compositeFields(value, %q)\n", qualifiedIdentifier)
+		g.printf("\treturn\n")
+		g.printf("}\n\n")
+		return nil
+	}
+	g.printf("\tfields, err := compositeFields(value, %q)\n", qualifiedIdentifier)
+	g.printf("\tif err != nil {\n\t\treturn\n\t}\n\n")
+	if compositeType.Kind == common.CompositeKindEnum {
+		rawType := compositeType.EnumRawType
+		g.printf("\trawValue, err := %s(fields[%q])\n", g.decodeFunction(rawType), sema.EnumRawValueFieldName)
+		g.printf("\tif err != nil {\n")
+		g.printf("\t\terr = fmt.Errorf(\"field `%s`: %%w\", err)\n", sema.EnumRawValueFieldName)
+		g.printf("\t\treturn\n")
+		g.printf("\t}\n\n")
+		g.printf("\treturn %s(rawValue), nil\n", name)
+	} else {
+		for _, field := range fields {
+			identifier := field.Identifier.Identifier
+			g.printf(
+				"\tresult.%s, err = %s(fields[%q])\n",
+				goFieldName(identifier),
+				g.decodeFunction(field.TypeAnnotation.Type),
+				identifier,
+			)
+			g.printf("\tif err != nil {\n")
+			g.printf("\t\terr = fmt.Errorf(\"field `%s`: %%w\", err)\n", identifier)
+			g.printf("\t\treturn\n")
+			g.printf("\t}\n\n")
+		}
+		g.printf("\treturn\n")
+	}
+	g.printf("}\n\n")
+
+	return nil
+}
+
+func (g *generator) writeEnumType(compositeType *sema.CompositeType, name string) {
+	g.printf(
+		"// %s is the Go representation of the Cadence enum `%s`.\n",
+		name,
+		compositeType.QualifiedIdentifier(),
+	)
+	g.printf("type %s %s\n\n", name, g.goType(compositeType.EnumRawType))
+
+	enumCases := g.declarations[compositeType].Members.EnumCases()
+
+	if len(enumCases) == 0 {
+		return
+	}
+
+	g.printf("const (\n")
+	for i, enumCase := range enumCases {
+		g.printf("\t%s%s %s = %d\n", name, goFieldName(enumCase.Identifier.Identifier), name, i)
+	}
+	g.printf(")\n\n")
+}
+
+func (g *generator) writeCompositeFieldsFunction() {
+	g.printf(`// compositeFields returns the fields of the given composite value by name,
+// and ensures the value has the given qualified type identifier.
+func compositeFields(value cadence.Value, qualifiedIdentifier string) (map[string]cadence.Value, error) {
+	var compositeType cadence.CompositeType
+	var fieldValues []cadence.Value
+
+	switch value := value.(type) {
+	case cadence.Struct:
+		if value.StructType != nil {
+			compositeType = value.StructType
+		}
+		fieldValues = value.Fields
+	case cadence.Resource:
+		if value.ResourceType != nil {
+			compositeType = value.ResourceType
+		}
+		fieldValues = value.Fields
+	case cadence.Event:
+		if value.EventType != nil {
+			compositeType = value.EventType
+		}
+		fieldValues = value.Fields
+	case cadence.Enum:
+		if value.EnumType != nil {
+			compositeType = value.EnumType
+		}
+		fieldValues = value.Fields
+	default:
+		return nil, fmt.Errorf("expected composite value, got %%T", value)
+	}
+
+	if compositeType == nil {
+		return nil, fmt.Errorf("missing type of composite value")
+	}
+
+	if compositeType.CompositeTypeQualifiedIdentifier() != qualifiedIdentifier {
+		return nil, fmt.Errorf(
+			"expected value of type %%s, got %%s",
+			qualifiedIdentifier,
+			compositeType.CompositeTypeQualifiedIdentifier(),
+		)
+	}
+
+	fieldTypes := compositeType.CompositeFields()
+	if len(fieldTypes) != len(fieldValues) {
+		return nil, fmt.Errorf(
+			"expected %%d fields, got %%d",
+			len(fieldTypes),
+			len(fieldValues),
+		)
+	}
+
+	fields := make(map[string]cadence.Value, len(fieldValues))
+	for i, fieldType := range fieldTypes {
+		fields[fieldType.Identifier] = fieldValues[i]
+	}
+
+	return fields, nil
+}
+
+`)
+}
+
+// Entry points
+
+func (g *generator) writeEntryPoint() error {
+	elaboration := g.checker.Elaboration
+
+	switch len(elaboration.TransactionTypes) {
+	case 0:
+		break
+	case 1:
+		transactionType := elaboration.TransactionTypes[0]
+		g.writeEntryPointArguments("transaction", transactionType.Parameters)
+
+		g.printf(
+			"// %sAuthorizerCount is the number of accounts that must authorize the transaction.\n",
+			g.entryPointName(),
+		)
+		g.printf("const %sAuthorizerCount = %d\n\n", g.entryPointName(), len(transactionType.PrepareParameters))
+		return nil
+	default:
+		return fmt.Errorf("expected at most one transaction, got %d", len(elaboration.TransactionTypes))
+	}
+
+	if _, ok := elaboration.GlobalValues.Get(sema.FunctionEntryPointName); !ok {
+		return nil
+	}
+
+	functionType, err := elaboration.FunctionEntryPointType()
+	if err != nil {
+		return err
+	}
+
+	g.writeEntryPointArguments("script", functionType.Parameters)
+
+	returnType := functionType.ReturnTypeAnnotation.Type
+	if returnType == sema.VoidType {
+		return nil
+	}
+
+	g.use("fmt")
+
+	name := g.entryPointName()
+	g.printf("// Decode%sResult converts the result of the script to its Go representation.\n", name)
+	g.printf("func Decode%sResult(value cadence.Value) (%s, error) {\n", name, g.goType(returnType))
+	g.printf("\treturn %s(value)\n", g.decodeFunction(returnType))
+	g.printf("}\n\n")
+
+	return nil
+}
+
+func (g *generator) entryPointName() string {
+	return goName(g.config.EntryPointName)
+}
+
+func (g *generator) writeEntryPointArguments(kind string, parameters []*sema.Parameter) {
+	g.use(cadenceImportPath)
+	g.use(jsonImportPath)
+
+	name := g.entryPointName() + "Arguments"
+
+	g.printf("// %s are the arguments of the %s.\n", name, kind)
+	g.printf("type %s struct {\n", name)
+	for _, parameter := range parameters {
+		g.printf("\t%s %s\n", goFieldName(parameter.Identifier), g.goType(parameter.TypeAnnotation.Type))
+	}
+	g.printf("}\n\n")
+
+	// Constructor
+
+	g.printf("// New%s returns the arguments of the %s.\n", name, kind)
+	g.printf("func New%s(\n", name)
+	for _, parameter := range parameters {
+		g.printf("\t%s %s,\n", goParameterName(parameter.Identifier), g.goType(parameter.TypeAnnotation.Type))
+	}
+	g.printf(") %s {\n", name)
+	g.printf("\treturn %s{\n", name)
+	for _, parameter := range parameters {
+		g.printf("\t\t%s: %s,\n", goFieldName(parameter.Identifier), goParameterName(parameter.Identifier))
+	}
+	g.printf("\t}\n")
+	g.printf("}\n\n")
+
+	// Values
+
+	g.printf("// Values returns the arguments as Cadence values, in parameter order.\n")
+	g.printf("func (a %s) Values() []cadence.Value {\n", name)
+	g.printf("\treturn []cadence.Value{\n")
+	for _, parameter := range parameters {
+		fieldExpression := "a." + goFieldName(parameter.Identifier)
+		g.printf("\t\t%s,\n", g.encodeExpression(parameter.TypeAnnotation.Type, fieldExpression))
+	}
+	g.printf("\t}\n")
+	g.printf("}\n\n")
+
+	// Encoding
+
+	g.printf("// Encode returns the arguments encoded as JSON-CDC, in parameter order.\n")
+	g.printf("func (a %s) Encode() ([][]byte, error) {\n", name)
+	g.printf(`	values := a.Values()
+	arguments := make([][]byte, len(values))
+	for i, value := range values {
+		argument, err := jsoncdc.Encode(value)
+		if err != nil {
+			return nil, err
+		}
+		arguments[i] = argument
+	}
+	return arguments, nil
+}
+
+`)
+}
+
+// Types
+
+type primitiveType struct {
+	// goType is the Go type the Cadence type is represented as
+	goType string
+	// valueType is the name of the Cadence value type in the cadence package
+	valueType string
+	// encode is the format of the expression converting the Go value to a Cadence value
+	encode string
+	// decode is the expression converting the Cadence value `v` to the Go value
+	decode string
+}
+
+var primitiveTypes = map[sema.TypeID]primitiveType{
+	"Bool":    {goType: "bool", valueType: "Bool", encode: "cadence.NewBool(%s)", decode: "bool(v)"},
+	"String":  {goType: "string", valueType: "String", encode: "cadence.NewString(%s)", decode: "string(v)"},
+	"Address": {goType: "cadence.Address", valueType: "Address", encode: "%s", decode: "v"},
+	"Int":     {goType: "*big.Int", valueType: "Int", encode: "cadence.NewIntFromBig(%s)", decode: "v.Big()"},
+	"Int8":    {goType: "int8", valueType: "Int8", encode: "cadence.NewInt8(%s)", decode: "int8(v)"},
+	"Int16":   {goType: "int16", valueType: "Int16", encode: "cadence.NewInt16(%s)", decode: "int16(v)"},
+	"Int32":   {goType: "int32", valueType: "Int32", encode: "cadence.NewInt32(%s)", decode: "int32(v)"},
+	"Int64":   {goType: "int64", valueType: "Int64", encode: "cadence.NewInt64(%s)", decode: "int64(v)"},
+	"Int128":  {goType: "*big.Int", valueType: "Int128", encode: "cadence.NewInt128FromBig(%s)", decode: "v.Big()"},
+	"Int256":  {goType: "*big.Int", valueType: "Int256", encode: "cadence.NewInt256FromBig(%s)", decode: "v.Big()"},
+	"UInt":    {goType: "*big.Int", valueType: "UInt", encode: "cadence.NewUIntFromBig(%s)", decode: "v.Big()"},
+	"UInt8":   {goType: "uint8", valueType: "UInt8", encode: "cadence.NewUInt8(%s)", decode: "uint8(v)"},
+	"UInt16":  {goType: "uint16", valueType: "UInt16", encode: "cadence.NewUInt16(%s)", decode: "uint16(v)"},
+	"UInt32":  {goType: "uint32", valueType: "UInt32", encode: "cadence.NewUInt32(%s)", decode: "uint32(v)"},
+	"UInt64":  {goType: "uint64", valueType: "UInt64", encode: "cadence.NewUInt64(%s)", decode: "uint64(v)"},
+	"UInt128": {goType: "*big.Int", valueType: "UInt128", encode: "cadence.NewUInt128FromBig(%s)", decode: "v.Big()"},
+	"UInt256": {goType: "*big.Int", valueType: "UInt256", encode: "cadence.NewUInt256FromBig(%s)", decode: "v.Big()"},
+	"Word8":   {goType: "uint8", valueType: "Word8", encode: "cadence.NewWord8(%s)", decode: "uint8(v)"},
+	"Word16":  {goType: "uint16", valueType: "Word16", encode: "cadence.NewWord16(%s)", decode: "uint16(v)"},
+	"Word32":  {goType: "uint32", valueType: "Word32", encode: "cadence.NewWord32(%s)", decode: "uint32(v)"},
+	"Word64":  {goType: "uint64", valueType: "Word64", encode: "cadence.NewWord64(%s)", decode: "uint64(v)"},
+	"Fix64":   {goType: "cadence.Fix64", valueType: "Fix64", encode: "%s", decode: "v"},
+	"UFix64":  {goType: "cadence.UFix64", valueType: "UFix64", encode: "%s", decode: "v"},
+	"Fix128":  {goType: "cadence.Fix128", valueType: "Fix128", encode: "%s", decode: "v"},
+	"UFix128": {goType: "cadence.UFix128", valueType: "UFix128", encode: "%s", decode: "v"},
+
+	"Path":           {goType: "cadence.Path", valueType: "Path", encode: "%s", decode: "v"},
+	"StoragePath":    {goType: "cadence.Path", valueType: "Path", encode: "%s", decode: "v"},
+	"PublicPath":     {goType: "cadence.Path", valueType: "Path", encode: "%s", decode: "v"},
+	"PrivatePath":    {goType: "cadence.Path", valueType: "Path", encode: "%s", decode: "v"},
+	"CapabilityPath": {goType: "cadence.Path", valueType: "Path", encode: "%s", decode: "v"},
+}
+
+func (g *generator) primitiveType(t sema.Type) (primitiveType, bool) {
+	switch t.(type) {
+	case *sema.CompositeType, *sema.InterfaceType:
+		return primitiveType{}, false
+	}
+
+	primitive, ok := primitiveTypes[t.ID()]
+	if ok && primitive.goType == "*big.Int" {
+		g.use("math/big")
+	}
+	return primitive, ok
+}
+
+// isComparable returns true if the Go representation of the given type
+// can be used as a Go map key, and compares like the Cadence value
+func (g *generator) isComparable(t sema.Type) bool {
+	if compositeType, ok := t.(*sema.CompositeType); ok {
+		_, ok := g.typeNames[compositeType.ID()]
+		return ok && compositeType.Kind == common.CompositeKindEnum
+	}
+
+	primitive, ok := g.primitiveType(t)
+	return ok && primitive.goType != "*big.Int"
+}
+
+// isOpaque returns true if the given type has no specific Go representation,
+// i.e. it is represented as cadence.Value
+func (g *generator) isOpaque(t sema.Type) bool {
+	return g.goType(t) == "cadence.Value"
+}
+
+// goType returns the Go type the given Cadence type is represented as.
+// Types which have no specific representation are represented as cadence.Value
+func (g *generator) goType(t sema.Type) string {
+	switch t := t.(type) {
+	case *sema.OptionalType:
+		if !g.isOpaque(t.Type) {
+			return "*" + g.goType(t.Type)
+		}
+
+	case *sema.VariableSizedType:
+		return "[]" + g.goType(t.Type)
+
+	case *sema.ConstantSizedType:
+		return fmt.Sprintf("[%d]%s", t.Size, g.goType(t.Type))
+
+	case *sema.DictionaryType:
+		if g.isComparable(t.KeyType) {
+			return fmt.Sprintf("map[%s]%s", g.goType(t.KeyType), g.goType(t.ValueType))
+		}
+
+	case *sema.CompositeType:
+		if name, ok := g.typeNames[t.ID()]; ok {
+			return name
+		}
+	}
+
+	if primitive, ok := g.primitiveType(t); ok {
+		return primitive.goType
+	}
+
+	return "cadence.Value"
+}
+
+// encodeExpression returns a Go expression converting the Go value of the given expression,
+// which has the representation of the given Cadence type, to a Cadence value
+func (g *generator) encodeExpression(t sema.Type, expression string) string {
+	switch t := t.(type) {
+	case *sema.OptionalType:
+		if g.isOpaque(t.Type) {
+			break
+		}
+		return fmt.Sprintf(
+			`func(x %s) cadence.Value {
+				if x == nil {
+					return cadence.NewOptional(nil)
+				}
+				return cadence.NewOptional(%s)
+			}(%s)`,
+			g.goType(t),
+			g.encodeExpression(t.Type, "(*x)"),
+			expression,
+		)
+
+	case *sema.VariableSizedType, *sema.ConstantSizedType:
+		elementType := t.(sema.ArrayType).ElementType(false)
+		return fmt.Sprintf(
+			`func(xs %s) cadence.Value {
+				values := make([]cadence.Value, len(xs))
+				for i, x := range xs {
+					values[i] = %s
+				}
+				return cadence.NewArray(values)
+			}(%s)`,
+			g.goType(t),
+			g.encodeExpression(elementType, "x"),
+			expression,
+		)
+
+	case *sema.DictionaryType:
+		if !g.isComparable(t.KeyType) {
+			break
+		}
+		return fmt.Sprintf(
+			`func(m %s) cadence.Value {
+				pairs := make([]cadence.KeyValuePair, 0, len(m))
+				for key, value := range m {
+					pairs = append(pairs, cadence.KeyValuePair{
+						Key: %s,
+						Value: %s,
+					})
+				}
+				return cadence.NewDictionary(pairs)
+			}(%s)`,
+			g.goType(t),
+			g.encodeExpression(t.KeyType, "key"),
+			g.encodeExpression(t.ValueType, "value"),
+			expression,
+		)
+
+	case *sema.CompositeType:
+		if _, ok := g.typeNames[t.ID()]; ok {
+			return expression + ".ToCadenceValue()"
+		}
+	}
+
+	if primitive, ok := g.primitiveType(t); ok {
+		return fmt.Sprintf(primitive.encode, expression)
+	}
+
+	return expression
+}
+
+// decodeFunction returns a Go function literal, or function name,
+// which converts a Cadence value to the Go representation of the given Cadence type
+func (g *generator) decodeFunction(t sema.Type) string {
+	goType := g.goType(t)
+
+	switch t := t.(type) {
+	case *sema.OptionalType:
+		if g.isOpaque(t.Type) {
+			break
+		}
+		return fmt.Sprintf(
+			`func(value cadence.Value) (result %s, err error) {
+				optional, ok := value.(cadence.Optional)
+				if !ok {
+					err = fmt.Errorf("expected optional, got %%T", value)
+					return
+				}
+				if optional.Value == nil {
+					return nil, nil
+				}
+				inner, err := %s(optional.Value)
+				if err != nil {
+					return
+				}
+				return &inner, nil
+			}`,
+			goType,
+			g.decodeFunction(t.Type),
+		)
+
+	case *sema.VariableSizedType:
+		return fmt.Sprintf(
+			`func(value cadence.Value) (result %s, err error) {
+				array, ok := value.(cadence.Array)
+				if !ok {
+					err = fmt.Errorf("expected array, got %%T", value)
+					return
+				}
+				result = make(%s, len(array.Values))
+				for i, element := range array.Values {
+					result[i], err = %s(element)
+					if err != nil {
+						err = fmt.Errorf("element %%d: %%w", i, err)
+						return
+					}
+				}
+				return
+			}`,
+			goType,
+			goType,
+			g.decodeFunction(t.Type),
+		)
+
+	case *sema.ConstantSizedType:
+		return fmt.Sprintf(
+			`func(value cadence.Value) (result %s, err error) {
+				array, ok := value.(cadence.Array)
+				if !ok {
+					err = fmt.Errorf("expected array, got %%T", value)
+					return
+				}
+				if len(array.Values) != len(result) {
+					err = fmt.Errorf("expected %%d elements, got %%d", len(result), len(array.Values))
+					return
+				}
+				for i, element := range array.Values {
+					result[i], err = %s(element)
+					if err != nil {
+						err = fmt.Errorf("element %%d: %%w", i, err)
+						return
+					}
+				}
+				return
+			}`,
+			goType,
+			g.decodeFunction(t.Type),
+		)
+
+	case *sema.DictionaryType:
+		if !g.isComparable(t.KeyType) {
+			break
+		}
+		return fmt.Sprintf(
+			`func(value cadence.Value) (result %s, err error) {
+				dictionary, ok := value.(cadence.Dictionary)
+				if !ok {
+					err = fmt.Errorf("expected dictionary, got %%T", value)
+					return
+				}
+				result = make(%s, len(dictionary.Pairs))
+				for _, pair := range dictionary.Pairs {
+					key, err := %s(pair.Key)
+					if err != nil {
+						return nil, fmt.Errorf("key: %%w", err)
+					}
+					result[key], err = %s(pair.Value)
+					if err != nil {
+						return nil, fmt.Errorf("value of key %%v: %%w", pair.Key, err)
+					}
+				}
+				return
+			}`,
+			goType,
+			goType,
+			g.decodeFunction(t.KeyType),
+			g.decodeFunction(t.ValueType),
+		)
+
+	case *sema.CompositeType:
+		if name, ok := g.typeNames[t.ID()]; ok {
+			return name + "FromCadenceValue"
+		}
+	}
+
+	if primitive, ok := g.primitiveType(t); ok {
+		return fmt.Sprintf(
+			`func(value cadence.Value) (result %s, err error) {
+				v, ok := value.(cadence.%s)
+				if !ok {
+					err = fmt.Errorf("expected %s, got %%T", value)
+					return
+				}
+				return %s, nil
+			}`,
+			goType,
+			primitive.valueType,
+			primitive.valueType,
+			primitive.decode,
+		)
+	}
+
+	return `func(value cadence.Value) (cadence.Value, error) {
+		return value, nil
+	}`
+}
+
+func (g *generator) exportType(t sema.Type) cadence.Type {
+	return runtime.ExportType(t, g.exportedTypes)
+}
+
+// locationExpression returns a Go expression which constructs the given location,
+// or an error if the location type is not supported
+func (g *generator) locationExpression(location common.Location) (string, error) {
+	if g.config.DeployedLocation != nil &&
+		common.LocationsMatch(location, g.checker.Location) {
+
+		location = g.config.DeployedLocation
+	}
+
+	switch location := location.(type) {
+	case nil:
+		return "nil", nil
+
+	case common.AddressLocation:
+		g.use(commonImportPath)
+		return fmt.Sprintf(
+			"common.AddressLocation{Address: %#v, Name: %q}",
+			location.Address,
+			location.Name,
+		), nil
+
+	case common.StringLocation:
+		g.use(commonImportPath)
+		return fmt.Sprintf("common.StringLocation(%q)", string(location)), nil
+
+	case common.IdentifierLocation:
+		g.use(commonImportPath)
+		return fmt.Sprintf("common.IdentifierLocation(%q)", string(location)), nil
+
+	default:
+		return "", fmt.Errorf("cannot generate location of type %T", location)
+	}
+}
+
+// relocateTypeID replaces the location of the checked program in the given type ID
+// with the deployed location, if any
+func (g *generator) relocateTypeID(typeID string) string {
+	if g.config.DeployedLocation == nil {
+		return typeID
+	}
+
+	return strings.ReplaceAll(
+		typeID,
+		string(g.checker.Location.TypeID("")),
+		string(g.config.DeployedLocation.TypeID("")),
+	)
+}
+
+// cadenceTypeExpression returns a Go expression which constructs the given Cadence type
+func (g *generator) cadenceTypeExpression(t cadence.Type) (string, error) {
+	switch t := t.(type) {
+	case nil:
+		return "nil", nil
+
+	case cadence.OptionalType:
+		innerType, err := g.cadenceTypeExpression(t.Type)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("cadence.OptionalType{Type: %s}", innerType), nil
+
+	case cadence.VariableSizedArrayType:
+		elementType, err := g.cadenceTypeExpression(t.ElementType)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"cadence.VariableSizedArrayType{ElementType: %s}",
+			elementType,
+		), nil
+
+	case cadence.ConstantSizedArrayType:
+		elementType, err := g.cadenceTypeExpression(t.ElementType)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"cadence.ConstantSizedArrayType{Size: %d, ElementType: %s}",
+			t.Size,
+			elementType,
+		), nil
+
+	case cadence.DictionaryType:
+		keyType, err := g.cadenceTypeExpression(t.KeyType)
+		if err != nil {
+			return "", err
+		}
+		elementType, err := g.cadenceTypeExpression(t.ElementType)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"cadence.DictionaryType{KeyType: %s, ElementType: %s}",
+			keyType,
+			elementType,
+		), nil
+
+	case cadence.ReferenceType:
+		referencedType, err := g.cadenceTypeExpression(t.Type)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"cadence.ReferenceType{Authorized: %t, Type: %s}.WithID(%q)",
+			t.Authorized,
+			referencedType,
+			g.relocateTypeID(t.ID()),
+		), nil
+
+	case cadence.RestrictedType:
+		restrictions := make([]string, len(t.Restrictions))
+		for i, restriction := range t.Restrictions {
+			var err error
+			restrictions[i], err = g.cadenceTypeExpression(restriction)
+			if err != nil {
+				return "", err
+			}
+		}
+		restrictedType, err := g.cadenceTypeExpression(t.Type)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"cadence.RestrictedType{Type: %s, Restrictions: []cadence.Type{%s}}.WithID(%q)",
+			restrictedType,
+			strings.Join(restrictions, ", "),
+			g.relocateTypeID(t.ID()),
+		), nil
+
+	case cadence.CapabilityType:
+		borrowType, err := g.cadenceTypeExpression(t.BorrowType)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"cadence.CapabilityType{BorrowType: %s}.WithID(%q)",
+			borrowType,
+			g.relocateTypeID(t.ID()),
+		), nil
+
+	case cadence.Function:
+		return fmt.Sprintf("cadence.Function{}.WithID(%q)", g.relocateTypeID(t.ID())), nil
+
+	case *cadence.EnumType:
+		if name, ok := g.typeNames[sema.TypeID(t.ID())]; ok {
+			return cadenceTypeVariableName(name), nil
+		}
+		location, err := g.locationExpression(t.Location)
+		if err != nil {
+			return "", err
+		}
+		rawType, err := g.cadenceTypeExpression(t.RawType)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"&cadence.EnumType{Location: %s, QualifiedIdentifier: %q, RawType: %s}",
+			location,
+			t.QualifiedIdentifier,
+			rawType,
+		), nil
+
+	case cadence.CompositeType:
+		if name, ok := g.typeNames[sema.TypeID(t.ID())]; ok {
+			return cadenceTypeVariableName(name), nil
+		}
+		// Composite types which are not generated, e.g. imported types,
+		// are only referred to by their location and qualified identifier
+		location, err := g.locationExpression(t.CompositeTypeLocation())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"&cadence.%s{Location: %s, QualifiedIdentifier: %q}",
+			reflect.TypeOf(t).Elem().Name(),
+			location,
+			t.CompositeTypeQualifiedIdentifier(),
+		), nil
+
+	case cadence.InterfaceType:
+		location, err := g.locationExpression(t.InterfaceTypeLocation())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"&cadence.%s{Location: %s, QualifiedIdentifier: %q}",
+			reflect.TypeOf(t).Elem().Name(),
+			location,
+			t.InterfaceTypeQualifiedIdentifier(),
+		), nil
+	}
+
+	// All remaining types have no fields, e.g. cadence.IntType{}
+	return fmt.Sprintf("cadence.%s{}", reflect.TypeOf(t).Name()), nil
+}
+
+// Names
+
+func cadenceTypeVariableName(name string) string {
+	return name + "CadenceType"
+}
+
+// goName returns an exported Go identifier for the given Cadence name,
+// e.g. `FungibleToken.Vault` becomes `FungibleTokenVault`,
+// and `transfer_tokens` becomes `TransferTokens`
+func goName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var builder strings.Builder
+	for _, part := range parts {
+		builder.WriteString(goFieldName(part))
+	}
+
+	result := builder.String()
+	if result == "" || !unicode.IsLetter([]rune(result)[0]) {
+		result = "X" + result
+	}
+	return result
+}
+
+// goFieldName returns an exported Go identifier for the given Cadence identifier
+func goFieldName(identifier string) string {
+	if identifier == "" {
+		return identifier
+	}
+	runes := []rune(identifier)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// goParameterName returns an unexported Go identifier for the given Cadence identifier
+func goParameterName(identifier string) string {
+	if token.IsKeyword(identifier) {
+		return identifier + "_"
+	}
+	return identifier
+}
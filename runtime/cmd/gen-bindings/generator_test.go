@@ -0,0 +1,310 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func generateTestBindings(t *testing.T, code string, config generatorConfig) *ast.File {
+
+	location := common.StringLocation("test")
+	codes := map[common.LocationID]string{}
+
+	program, must := cmd.PrepareProgram(code, location, codes)
+	checker, must := cmd.PrepareChecker(program, location, codes, must)
+	must(checker.Check())
+
+	source, err := generate(config, program, checker)
+	require.NoError(t, err)
+
+	file, err := parser.ParseFile(token.NewFileSet(), "", source, 0)
+	require.NoError(t, err, string(source))
+
+	return file
+}
+
+func declaredNames(file *ast.File) map[string]bool {
+	names := map[string]bool{}
+
+	for _, declaration := range file.Decls {
+		switch declaration := declaration.(type) {
+		case *ast.FuncDecl:
+			name := declaration.Name.Name
+			if declaration.Recv != nil {
+				receiverType := declaration.Recv.List[0].Type.(*ast.Ident)
+				name = receiverType.Name + "." + name
+			}
+			names[name] = true
+
+		case *ast.GenDecl:
+			for _, spec := range declaration.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names[spec.Name.Name] = true
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						names[name.Name] = true
+					}
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+func TestGenerateCompositeBindings(t *testing.T) {
+
+	t.Parallel()
+
+	file := generateTestBindings(t,
+		`
+          pub contract Test {
+
+              pub enum Color: UInt8 {
+                  pub case red
+                  pub case green
+              }
+
+              pub struct Info {
+                  pub let name: String
+                  pub let color: Color
+                  pub let tags: {String: UInt64}
+                  pub let owner: Address?
+
+                  init() {
+                      self.name = ""
+                      self.color = Color.red
+                      self.tags = {}
+                      self.owner = nil
+                  }
+              }
+
+              pub resource NFT {
+                  pub let info: Info
+
+                  init() {
+                      self.info = Info()
+                  }
+              }
+
+              pub event Minted(id: UInt64, to: Address?)
+
+              pub event Updated(info: Info, color: Color)
+
+              init() {}
+          }
+        `,
+		generatorConfig{
+			PackageName: "test",
+			DeployedLocation: common.AddressLocation{
+				Address: common.BytesToAddress([]byte{0x1}),
+				Name:    "Test",
+			},
+		},
+	)
+
+	assert.Equal(t, "test", file.Name.Name)
+
+	names := declaredNames(file)
+
+	for _, name := range []string{
+		"TestColor",
+		"TestColorRed",
+		"TestColorGreen",
+		"TestColorCadenceType",
+		"TestColor.ToCadenceValue",
+		"TestColorFromCadenceValue",
+		"TestInfo",
+		"TestInfoCadenceType",
+		"TestInfo.ToCadenceValue",
+		"TestInfoFromCadenceValue",
+		"TestNFT",
+		"TestNFTFromCadenceValue",
+		"TestMinted",
+		"TestMintedFromCadenceValue",
+		"TestUpdated",
+		"TestUpdatedFromCadenceValue",
+		"compositeFields",
+	} {
+		assert.True(t, names[name], name)
+	}
+
+	// The contract itself is not generated
+
+	assert.False(t, names["Test"])
+}
+
+func TestGenerateTransactionBindings(t *testing.T) {
+
+	t.Parallel()
+
+	file := generateTestBindings(t,
+		`
+          transaction(amount: UFix64, to: Address, type: String) {
+              prepare(first: AuthAccount, second: AuthAccount) {}
+          }
+        `,
+		generatorConfig{
+			PackageName:    "test",
+			EntryPointName: "transfer_tokens",
+		},
+	)
+
+	names := declaredNames(file)
+
+	for _, name := range []string{
+		"TransferTokensArguments",
+		"NewTransferTokensArguments",
+		"TransferTokensArguments.Values",
+		"TransferTokensArguments.Encode",
+		"TransferTokensAuthorizerCount",
+	} {
+		assert.True(t, names[name], name)
+	}
+}
+
+func TestGenerateScriptBindings(t *testing.T) {
+
+	t.Parallel()
+
+	file := generateTestBindings(t,
+		`
+          pub fun main(owner: Address): [UInt64] {
+              return []
+          }
+        `,
+		generatorConfig{
+			PackageName:    "test",
+			EntryPointName: "GetIDs",
+		},
+	)
+
+	names := declaredNames(file)
+
+	for _, name := range []string{
+		"GetIDsArguments",
+		"NewGetIDsArguments",
+		"GetIDsArguments.Values",
+		"GetIDsArguments.Encode",
+		"DecodeGetIDsResult",
+	} {
+		assert.True(t, names[name], name)
+	}
+}
+
+func TestGenerateUnsupportedLocation(t *testing.T) {
+
+	t.Parallel()
+
+	location := common.StringLocation("test")
+	codes := map[common.LocationID]string{}
+
+	program, must := cmd.PrepareProgram(
+		`
+          pub struct Token {
+              pub let id: UInt64
+
+              init(id: UInt64) {
+                  self.id = id
+              }
+          }
+        `,
+		location,
+		codes,
+	)
+	checker, must := cmd.PrepareChecker(program, location, codes, must)
+	must(checker.Check())
+
+	_, err := generate(
+		generatorConfig{
+			PackageName:      "test",
+			EntryPointName:   "test",
+			DeployedLocation: common.TransactionLocation{0x1},
+		},
+		program,
+		checker,
+	)
+	require.EqualError(t, err, "cannot generate location of type common.TransactionLocation")
+}
+
+// TestGenerateTestBindings ensures the bindings in the testbindings package,
+// which are compiled and tested there, are the bindings currently generated
+func TestGenerateTestBindings(t *testing.T) {
+
+	t.Parallel()
+
+	directory := filepath.Join("internal", "testbindings")
+
+	code, err := ioutil.ReadFile(filepath.Join(directory, "test.cdc"))
+	require.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(filepath.Join(directory, "bindings.gen.go"))
+	require.NoError(t, err)
+
+	location := common.StringLocation("test.cdc")
+	codes := map[common.LocationID]string{}
+
+	program, must := cmd.PrepareProgram(string(code), location, codes)
+	checker, must := cmd.PrepareChecker(program, location, codes, must)
+	must(checker.Check())
+
+	source, err := generate(
+		generatorConfig{
+			PackageName:    "testbindings",
+			EntryPointName: "test",
+			DeployedLocation: common.AddressLocation{
+				Address: common.BytesToAddress([]byte{0x1}),
+				Name:    "Test",
+			},
+		},
+		program,
+		checker,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		string(expected),
+		string(source),
+		"generated bindings are outdated, run `go generate ./%s`",
+		directory,
+	)
+}
+
+func TestGoName(t *testing.T) {
+
+	t.Parallel()
+
+	assert.Equal(t, "FungibleTokenVault", goName("FungibleToken.Vault"))
+	assert.Equal(t, "TransferTokens", goName("transfer_tokens"))
+	assert.Equal(t, "X1Test", goName("1-test"))
+	assert.Equal(t, "type_", goParameterName("type"))
+}
@@ -0,0 +1,95 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package testbindings
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+)
+
+func TestRoundTrip(t *testing.T) {
+
+	t.Parallel()
+
+	owner := cadence.BytesToAddress([]byte{0x2})
+
+	minted := TestMinted{
+		Id: 42,
+		Info: TestInfo{
+			Id:    big.NewInt(1),
+			Name:  "info",
+			Color: TestColorGreen,
+			Tags: map[string]uint64{
+				"a": 1,
+				"b": 2,
+			},
+			Owner: &owner,
+			Path: cadence.Path{
+				Domain:     "public",
+				Identifier: "info",
+			},
+			Balance: cadence.UFix64(100000000),
+		},
+		Path: cadence.Path{
+			Domain:     "storage",
+			Identifier: "vault",
+		},
+	}
+
+	encoded, err := jsoncdc.Encode(minted.ToCadenceValue())
+	require.NoError(t, err)
+
+	decoded, err := jsoncdc.Decode(encoded)
+	require.NoError(t, err)
+
+	actual, err := TestMintedFromCadenceValue(decoded)
+	require.NoError(t, err)
+
+	assert.Equal(t, minted, actual)
+}
+
+func TestZeroValue(t *testing.T) {
+
+	t.Parallel()
+
+	value := TestInfo{}.ToCadenceValue()
+
+	assert.NotPanics(t, func() {
+		_ = value.String()
+	})
+
+	actual, err := TestInfoFromCadenceValue(value)
+	require.NoError(t, err)
+
+	assert.Equal(t, cadence.Path{}, actual.Path)
+}
+
+func TestFromCadenceValueTypeMismatch(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := TestMintedFromCadenceValue(TestInfo{}.ToCadenceValue())
+	require.Error(t, err)
+}
@@ -0,0 +1,123 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// A utility program that type-checks a contract, transaction or script
+// and generates Go bindings for its declared types and its entry point.
+//
+// Usage: go run ./runtime/cmd/gen-bindings -package tokens -address 0x1 -o tokens.go FungibleToken.cdc
+
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+var packageFlag = flag.String("package", "bindings", "the package name of the generated code")
+var nameFlag = flag.String("name", "", "the name prefix of the entry point bindings (default: derived from the file name)")
+var addressFlag = flag.String("address", "", "the address the contract is deployed to")
+var outputFlag = flag.String("o", "", "the output file (default: standard output)")
+
+func main() {
+	flag.Parse()
+	args := flag.Args()
+
+	if len(args) < 1 {
+		cmd.ExitWithError("no input file")
+	}
+
+	path := args[0]
+
+	location := common.StringLocation(path)
+
+	codes := map[common.LocationID]string{}
+
+	program, must := cmd.PrepareProgramFromFile(location, codes)
+
+	checker, must := cmd.PrepareChecker(program, location, codes, must)
+
+	must(checker.Check())
+
+	name := *nameFlag
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	var deployedLocation common.Location
+	if *addressFlag != "" {
+		address, err := parseAddress(*addressFlag)
+		if err != nil {
+			cmd.ExitWithError(err.Error())
+		}
+
+		contractName := ""
+		if contract := program.SoleContractDeclaration(); contract != nil {
+			contractName = contract.Identifier.Identifier
+		} else if contractInterface := program.SoleContractInterfaceDeclaration(); contractInterface != nil {
+			contractName = contractInterface.Identifier.Identifier
+		}
+
+		deployedLocation = common.AddressLocation{
+			Address: address,
+			Name:    contractName,
+		}
+	}
+
+	code, err := generate(
+		generatorConfig{
+			PackageName:      *packageFlag,
+			EntryPointName:   name,
+			DeployedLocation: deployedLocation,
+		},
+		program,
+		checker,
+	)
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+
+	if *outputFlag == "" {
+		_, err = os.Stdout.Write(code)
+	} else {
+		err = ioutil.WriteFile(*outputFlag, code, 0644)
+	}
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+}
+
+func parseAddress(s string) (common.Address, error) {
+	s = strings.TrimPrefix(s, "0x")
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return common.BytesToAddress(b), nil
+}
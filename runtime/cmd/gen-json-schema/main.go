@@ -0,0 +1,118 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// A utility program that type-checks a transaction or script and generates a JSON Schema
+// for its arguments in JSON-Cadence format.
+//
+// The schema describes the list of arguments, with one item for each parameter of the entry point.
+//
+// Usage: go run ./runtime/cmd/gen-json-schema -o transfer_tokens.schema.json transfer_tokens.cdc
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+var titleFlag = flag.String("title", "", "the title of the schema (default: derived from the file name)")
+var outputFlag = flag.String("o", "", "the output file (default: standard output)")
+
+func main() {
+	flag.Parse()
+	args := flag.Args()
+
+	if len(args) < 1 {
+		cmd.ExitWithError("no input file")
+	}
+
+	path := args[0]
+
+	location := common.StringLocation(path)
+
+	codes := map[common.LocationID]string{}
+
+	program, must := cmd.PrepareProgramFromFile(location, codes)
+
+	checker, must := cmd.PrepareChecker(program, location, codes, must)
+
+	must(checker.Check())
+
+	title := *titleFlag
+	if title == "" {
+		title = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	schema, err := generate(title, checker.Elaboration)
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+
+	encoded, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+	encoded = append(encoded, '\n')
+
+	if *outputFlag == "" {
+		_, err = os.Stdout.Write(encoded)
+	} else {
+		err = ioutil.WriteFile(*outputFlag, encoded, 0644)
+	}
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+}
+
+// generate returns the schema of the arguments of the transaction or script
+//
+func generate(title string, elaboration *sema.Elaboration) (*jsonSchema, error) {
+
+	var parameters []*sema.Parameter
+
+	switch len(elaboration.TransactionTypes) {
+	case 0:
+		if _, ok := elaboration.GlobalValues.Get(sema.FunctionEntryPointName); !ok {
+			return nil, fmt.Errorf("no transaction or script found")
+		}
+
+		functionType, err := elaboration.FunctionEntryPointType()
+		if err != nil {
+			return nil, err
+		}
+
+		parameters = functionType.Parameters
+
+	case 1:
+		parameters = elaboration.TransactionTypes[0].Parameters
+
+	default:
+		return nil, fmt.Errorf("expected at most one transaction, got %d", len(elaboration.TransactionTypes))
+	}
+
+	return newSchemaGenerator().entryPointSchema(title, parameters)
+}
@@ -0,0 +1,565 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/format"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+const schemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchema is a JSON Schema (draft 2020-12).
+//
+// The keywords `cadenceMinimum` and `cadenceMaximum` are annotations for the inclusive bounds
+// of integer and fixed-point values. JSON-Cadence encodes these values as strings,
+// so the standard keywords `minimum` and `maximum` do not apply
+//
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Const                interface{}            `json:"const,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	CadenceMinimum       string                 `json:"cadenceMinimum,omitempty"`
+	CadenceMaximum       string                 `json:"cadenceMaximum,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	PrefixItems          []*jsonSchema          `json:"prefixItems,omitempty"`
+	Items                interface{}            `json:"items,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+	Contains             *jsonSchema            `json:"contains,omitempty"`
+	AllOf                []*jsonSchema          `json:"allOf,omitempty"`
+	OneOf                []*jsonSchema          `json:"oneOf,omitempty"`
+	Defs                 map[string]*jsonSchema `json:"$defs,omitempty"`
+}
+
+func intPointer(i int) *int {
+	return &i
+}
+
+func boolPointer(b bool) *bool {
+	return &b
+}
+
+// object returns the schema of a JSON object with the given required properties,
+// and no other properties
+//
+func object(properties map[string]*jsonSchema, required ...string) *jsonSchema {
+	return &jsonSchema{
+		Type:                 "object",
+		Properties:           properties,
+		Required:             required,
+		AdditionalProperties: boolPointer(false),
+	}
+}
+
+// valueObject returns the schema of a JSON-Cadence value with the given type,
+// e.g. `{"type": "Int8", "value": "1"}`
+//
+func valueObject(typeName string, value *jsonSchema) *jsonSchema {
+	return object(
+		map[string]*jsonSchema{
+			"type":  {Const: typeName},
+			"value": value,
+		},
+		"type",
+		"value",
+	)
+}
+
+// jsonPointerEscaper escapes a reference token of a JSON Pointer (RFC 6901)
+//
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+const identifierPattern = `^[A-Za-z_][A-Za-z0-9_]*$`
+
+const addressPattern = `^0x([0-9a-fA-F]{2}){1,8}$`
+
+const signedIntegerPattern = `^-?[0-9]+$`
+
+const unsignedIntegerPattern = `^[0-9]+$`
+
+const signedFixedPointPattern = `^-?[0-9]+\.[0-9]{1,8}$`
+
+const unsignedFixedPointPattern = `^[0-9]+\.[0-9]{1,8}$`
+
+const signedFixedPoint128Pattern = `^-?[0-9]+\.[0-9]{1,24}$`
+
+const unsignedFixedPoint128Pattern = `^[0-9]+\.[0-9]{1,24}$`
+
+// schemaGenerator generates the JSON Schema of the JSON-Cadence encoding of values of Cadence types.
+//
+// Composite types are generated as definitions, which are referenced by type ID,
+// so recursive composite types are supported
+//
+type schemaGenerator struct {
+	definitions map[string]*jsonSchema
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		definitions: map[string]*jsonSchema{},
+	}
+}
+
+// entryPointSchema returns the schema of the arguments of an entry point with the given parameters,
+// i.e. an array which contains the JSON-Cadence encoding of each argument
+//
+func (g *schemaGenerator) entryPointSchema(title string, parameters []*sema.Parameter) (*jsonSchema, error) {
+	parameterSchemas := make([]*jsonSchema, len(parameters))
+
+	for i, parameter := range parameters {
+		parameterSchema, err := g.parameterSchema(parameter)
+		if err != nil {
+			return nil, err
+		}
+		parameterSchemas[i] = parameterSchema
+	}
+
+	result := &jsonSchema{
+		Schema:      schemaDialect,
+		Title:       title,
+		Type:        "array",
+		PrefixItems: parameterSchemas,
+		Items:       false,
+		MinItems:    intPointer(len(parameters)),
+		MaxItems:    intPointer(len(parameters)),
+	}
+
+	if len(g.definitions) > 0 {
+		result.Defs = g.definitions
+	}
+
+	return result, nil
+}
+
+func (g *schemaGenerator) parameterSchema(parameter *sema.Parameter) (*jsonSchema, error) {
+	parameterType := parameter.TypeAnnotation.Type
+
+	typeSchema, err := g.typeSchema(parameterType)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"parameter `%s` of type `%s`: %w",
+			parameter.Identifier,
+			parameterType.QualifiedString(),
+			err,
+		)
+	}
+
+	// Wrap references to definitions, so the title does not override the definition's title
+
+	if typeSchema.Ref != "" {
+		typeSchema = &jsonSchema{
+			AllOf: []*jsonSchema{typeSchema},
+		}
+	}
+
+	typeSchema.Title = parameter.Identifier
+
+	return typeSchema, nil
+}
+
+// typeSchema returns the schema of the JSON-Cadence encoding of values of the given type
+//
+func (g *schemaGenerator) typeSchema(t sema.Type) (*jsonSchema, error) {
+
+	switch t := t.(type) {
+	case *sema.OptionalType:
+		innerSchema, err := g.typeSchema(t.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		return valueObject(
+			"Optional",
+			&jsonSchema{
+				OneOf: []*jsonSchema{
+					{Type: "null"},
+					innerSchema,
+				},
+			},
+		), nil
+
+	case *sema.VariableSizedType:
+		elementSchema, err := g.typeSchema(t.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		return valueObject(
+			"Array",
+			&jsonSchema{
+				Type:  "array",
+				Items: elementSchema,
+			},
+		), nil
+
+	case *sema.ConstantSizedType:
+		elementSchema, err := g.typeSchema(t.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		size := int(t.Size)
+
+		return valueObject(
+			"Array",
+			&jsonSchema{
+				Type:     "array",
+				Items:    elementSchema,
+				MinItems: intPointer(size),
+				MaxItems: intPointer(size),
+			},
+		), nil
+
+	case *sema.DictionaryType:
+		keySchema, err := g.typeSchema(t.KeyType)
+		if err != nil {
+			return nil, err
+		}
+
+		valueSchema, err := g.typeSchema(t.ValueType)
+		if err != nil {
+			return nil, err
+		}
+
+		return valueObject(
+			"Dictionary",
+			&jsonSchema{
+				Type: "array",
+				Items: object(
+					map[string]*jsonSchema{
+						"key":   keySchema,
+						"value": valueSchema,
+					},
+					"key",
+					"value",
+				),
+			},
+		), nil
+
+	case *sema.CompositeType:
+		return g.compositeSchema(t)
+
+	// NOTE: the address type is also an integer ranged type,
+	// but is encoded as a hex string, so it must be handled before integer types
+
+	case *sema.AddressType:
+		return valueObject(
+			"Address",
+			&jsonSchema{
+				Description: "A hex-encoded address with the prefix 0x, e.g. 0xf8d6e0586b0a20c7",
+				Type:        "string",
+				Pattern:     addressPattern,
+			},
+		), nil
+
+	case sema.FractionalRangedType:
+		return fixedPointSchema(t)
+
+	case sema.IntegerRangedType:
+		return integerSchema(t), nil
+	}
+
+	switch t {
+	case sema.BoolType:
+		return valueObject("Bool", &jsonSchema{Type: "boolean"}), nil
+
+	case sema.StringType:
+		return valueObject("String", &jsonSchema{Type: "string"}), nil
+
+	case sema.VoidType:
+		return object(
+			map[string]*jsonSchema{
+				"type": {Const: "Void"},
+			},
+			"type",
+		), nil
+
+	case sema.AnyStructType:
+		return object(
+			map[string]*jsonSchema{
+				"type":  {Type: "string"},
+				"value": {},
+			},
+			"type",
+		), nil
+
+	case sema.PathType:
+		return pathSchema(
+			common.PathDomainStorage,
+			common.PathDomainPublic,
+			common.PathDomainPrivate,
+		), nil
+
+	case sema.StoragePathType:
+		return pathSchema(common.PathDomainStorage), nil
+
+	case sema.CapabilityPathType:
+		return pathSchema(
+			common.PathDomainPublic,
+			common.PathDomainPrivate,
+		), nil
+
+	case sema.PublicPathType:
+		return pathSchema(common.PathDomainPublic), nil
+
+	case sema.PrivatePathType:
+		return pathSchema(common.PathDomainPrivate), nil
+	}
+
+	return nil, fmt.Errorf("type `%s` is not supported as an argument", t.QualifiedString())
+}
+
+func integerSchema(t sema.IntegerRangedType) *jsonSchema {
+	typeName := string(t.ID())
+
+	minInt := t.MinInt()
+	maxInt := t.MaxInt()
+
+	valueSchema := &jsonSchema{
+		Type:    "string",
+		Pattern: signedIntegerPattern,
+	}
+
+	if minInt != nil {
+		valueSchema.CadenceMinimum = minInt.String()
+		if minInt.Sign() >= 0 {
+			valueSchema.Pattern = unsignedIntegerPattern
+		}
+	}
+
+	if maxInt != nil {
+		valueSchema.CadenceMaximum = maxInt.String()
+	}
+
+	switch {
+	case minInt != nil && maxInt != nil:
+		valueSchema.Description = fmt.Sprintf(
+			"A decimal %s, from %s to %s",
+			typeName,
+			minInt,
+			maxInt,
+		)
+	case minInt != nil:
+		valueSchema.Description = fmt.Sprintf(
+			"A decimal %s, at least %s",
+			typeName,
+			minInt,
+		)
+	default:
+		valueSchema.Description = fmt.Sprintf("A decimal %s", typeName)
+	}
+
+	return valueObject(typeName, valueSchema)
+}
+
+func fixedPointSchema(t sema.FractionalRangedType) (*jsonSchema, error) {
+	typeName := string(t.ID())
+
+	var valueSchema *jsonSchema
+
+	switch t.(type) {
+	case *sema.Fix64Type:
+		valueSchema = &jsonSchema{
+			Type:           "string",
+			Pattern:        signedFixedPointPattern,
+			CadenceMinimum: format.Fix64(math.MinInt64),
+			CadenceMaximum: format.Fix64(math.MaxInt64),
+		}
+
+	case *sema.UFix64Type:
+		valueSchema = &jsonSchema{
+			Type:           "string",
+			Pattern:        unsignedFixedPointPattern,
+			CadenceMinimum: format.UFix64(0),
+			CadenceMaximum: format.UFix64(math.MaxUint64),
+		}
+
+	case *sema.Fix128Type:
+		valueSchema = &jsonSchema{
+			Type:           "string",
+			Pattern:        signedFixedPoint128Pattern,
+			CadenceMinimum: format.Fix128(sema.Fix128TypeMinScaledBig),
+			CadenceMaximum: format.Fix128(sema.Fix128TypeMaxScaledBig),
+		}
+
+	case *sema.UFix128Type:
+		valueSchema = &jsonSchema{
+			Type:           "string",
+			Pattern:        unsignedFixedPoint128Pattern,
+			CadenceMinimum: format.UFix128(sema.UFix128TypeMinScaledBig),
+			CadenceMaximum: format.UFix128(sema.UFix128TypeMaxScaledBig),
+		}
+
+	default:
+		return nil, fmt.Errorf("type `%s` is not supported as an argument", t.QualifiedString())
+	}
+
+	valueSchema.Description = fmt.Sprintf(
+		"A decimal %s with a decimal point and 1 to %d fractional digits, from %s to %s",
+		typeName,
+		t.Scale(),
+		valueSchema.CadenceMinimum,
+		valueSchema.CadenceMaximum,
+	)
+
+	return valueObject(typeName, valueSchema), nil
+}
+
+func pathSchema(domains ...common.PathDomain) *jsonSchema {
+	domainSchema := &jsonSchema{}
+
+	if len(domains) == 1 {
+		domainSchema.Const = domains[0].Identifier()
+	} else {
+		for _, domain := range domains {
+			domainSchema.Enum = append(domainSchema.Enum, domain.Identifier())
+		}
+	}
+
+	return valueObject(
+		"Path",
+		object(
+			map[string]*jsonSchema{
+				"domain": domainSchema,
+				"identifier": {
+					Type:    "string",
+					Pattern: identifierPattern,
+				},
+			},
+			"domain",
+			"identifier",
+		),
+	)
+}
+
+// compositeSchema returns a reference to the definition of the given composite type.
+//
+// The JSON-Cadence encoding of a composite contains the type ID and a list of fields,
+// each with a name and a value. Every field must be given exactly once, in any order
+//
+func (g *schemaGenerator) compositeSchema(t *sema.CompositeType) (*jsonSchema, error) {
+	typeID := string(t.ID())
+	ref := &jsonSchema{Ref: "#/$defs/" + jsonPointerEscaper.Replace(typeID)}
+
+	if _, ok := g.definitions[typeID]; ok {
+		return ref, nil
+	}
+
+	var typeName string
+	switch t.Kind {
+	case common.CompositeKindStructure:
+		typeName = "Struct"
+	case common.CompositeKindEnum:
+		typeName = "Enum"
+	default:
+		return nil, fmt.Errorf(
+			"%s `%s` is not supported as an argument",
+			t.Kind.Name(),
+			t.QualifiedString(),
+		)
+	}
+
+	// Add the definition before generating the field schemas,
+	// so recursive references to the type are not generated again
+
+	definition := &jsonSchema{}
+	g.definitions[typeID] = definition
+
+	fieldSchemas := make([]*jsonSchema, 0, len(t.Fields))
+	requiredFields := make([]*jsonSchema, 0, len(t.Fields))
+
+	for _, identifier := range t.Fields {
+		member, ok := t.Members.Get(identifier)
+		if !ok || member.IgnoreInSerialization {
+			continue
+		}
+
+		fieldType := member.TypeAnnotation.Type
+
+		valueSchema, err := g.typeSchema(fieldType)
+		if err != nil {
+			return nil, fmt.Errorf("field `%s`: %w", identifier, err)
+		}
+
+		fieldSchemas = append(fieldSchemas,
+			object(
+				map[string]*jsonSchema{
+					"name":  {Const: identifier},
+					"value": valueSchema,
+				},
+				"name",
+				"value",
+			),
+		)
+
+		requiredFields = append(requiredFields,
+			&jsonSchema{
+				Contains: &jsonSchema{
+					Type: "object",
+					Properties: map[string]*jsonSchema{
+						"name": {Const: identifier},
+					},
+				},
+			},
+		)
+	}
+
+	fieldCount := len(fieldSchemas)
+
+	fieldsSchema := &jsonSchema{
+		Type:     "array",
+		MinItems: intPointer(fieldCount),
+		MaxItems: intPointer(fieldCount),
+		AllOf:    requiredFields,
+	}
+
+	if fieldCount > 0 {
+		fieldsSchema.Items = &jsonSchema{
+			OneOf: fieldSchemas,
+		}
+	}
+
+	*definition = *valueObject(
+		typeName,
+		object(
+			map[string]*jsonSchema{
+				"id":     {Const: typeID},
+				"fields": fieldsSchema,
+			},
+			"id",
+			"fields",
+		),
+	)
+	definition.Title = t.QualifiedString()
+
+	return ref, nil
+}
@@ -0,0 +1,220 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func generateTestSchema(t *testing.T, code string) (*jsonSchema, error) {
+
+	location := common.StringLocation("test")
+	codes := map[common.LocationID]string{}
+
+	program, must := cmd.PrepareProgram(code, location, codes)
+	checker, must := cmd.PrepareChecker(program, location, codes, must)
+	must(checker.Check())
+
+	return generate("test", checker.Elaboration)
+}
+
+// valueSchema returns the schema of the `value` property of the given JSON-Cadence value schema
+//
+func valueSchema(t *testing.T, schema *jsonSchema) *jsonSchema {
+	require.NotNil(t, schema.Properties)
+	value := schema.Properties["value"]
+	require.NotNil(t, value)
+	return value
+}
+
+// encodedValue returns the `value` property of the JSON-Cadence encoding of the given value
+//
+func encodedValue(t *testing.T, value cadence.Value) string {
+	encoded, err := jsoncdc.Encode(value)
+	require.NoError(t, err)
+
+	var result struct {
+		Value string
+	}
+	err = json.Unmarshal(encoded, &result)
+	require.NoError(t, err)
+
+	return result.Value
+}
+
+func TestGenerateScriptSchema(t *testing.T) {
+
+	t.Parallel()
+
+	schema, err := generateTestSchema(t, `
+      pub fun main(amount: UFix64, delta: Fix64, to: Address, count: Int8, ids: [UInt64; 2]): Int {
+          return 0
+      }
+    `)
+	require.NoError(t, err)
+
+	assert.Equal(t, schemaDialect, schema.Schema)
+	assert.Equal(t, "array", schema.Type)
+	assert.Equal(t, false, schema.Items)
+	assert.Equal(t, 5, *schema.MinItems)
+	assert.Equal(t, 5, *schema.MaxItems)
+	require.Len(t, schema.PrefixItems, 5)
+
+	// UFix64
+
+	amountSchema := schema.PrefixItems[0]
+	assert.Equal(t, "amount", amountSchema.Title)
+	assert.Equal(t, "UFix64", amountSchema.Properties["type"].Const)
+
+	amountValueSchema := valueSchema(t, amountSchema)
+	assert.Equal(t, "0.00000000", amountValueSchema.CadenceMinimum)
+	assert.Equal(t, "184467440737.09551615", amountValueSchema.CadenceMaximum)
+
+	ufix64, err := cadence.NewUFix64("12.5")
+	require.NoError(t, err)
+
+	amountPattern := regexp.MustCompile(amountValueSchema.Pattern)
+	assert.True(t, amountPattern.MatchString(encodedValue(t, ufix64)))
+	assert.True(t, amountPattern.MatchString("1.0"))
+	assert.False(t, amountPattern.MatchString("1"))
+	assert.False(t, amountPattern.MatchString("-1.0"))
+	assert.False(t, amountPattern.MatchString("1.000000001"))
+
+	// Fix64
+
+	fix64, err := cadence.NewFix64("-12.5")
+	require.NoError(t, err)
+
+	deltaValueSchema := valueSchema(t, schema.PrefixItems[1])
+	deltaPattern := regexp.MustCompile(deltaValueSchema.Pattern)
+	assert.True(t, deltaPattern.MatchString(encodedValue(t, fix64)))
+	assert.Equal(t, "-92233720368.54775808", deltaValueSchema.CadenceMinimum)
+
+	// Address
+
+	toValueSchema := valueSchema(t, schema.PrefixItems[2])
+	toPattern := regexp.MustCompile(toValueSchema.Pattern)
+	assert.True(t, toPattern.MatchString(encodedValue(t, cadence.BytesToAddress([]byte{0xf8, 0xd6}))))
+	assert.False(t, toPattern.MatchString("f8d6e0586b0a20c7"))
+
+	// Int8
+
+	countValueSchema := valueSchema(t, schema.PrefixItems[3])
+	assert.Equal(t, "-128", countValueSchema.CadenceMinimum)
+	assert.Equal(t, "127", countValueSchema.CadenceMaximum)
+	assert.True(t, regexp.MustCompile(countValueSchema.Pattern).MatchString(encodedValue(t, cadence.NewInt8(-3))))
+
+	// Constant-sized array
+
+	idsSchema := schema.PrefixItems[4]
+	assert.Equal(t, "Array", idsSchema.Properties["type"].Const)
+	idsValueSchema := valueSchema(t, idsSchema)
+	assert.Equal(t, 2, *idsValueSchema.MinItems)
+	assert.Equal(t, 2, *idsValueSchema.MaxItems)
+}
+
+func TestGenerateTransactionSchema(t *testing.T) {
+
+	t.Parallel()
+
+	schema, err := generateTestSchema(t, `
+      pub struct Info {
+          pub let name: String
+          pub let next: Info?
+
+          init() {
+              self.name = ""
+              self.next = nil
+          }
+      }
+
+      transaction(info: Info, tags: {String: Bool}, path: PublicPath) {
+          prepare(signer: AuthAccount) {}
+      }
+    `)
+	require.NoError(t, err)
+
+	require.Len(t, schema.PrefixItems, 3)
+
+	// Composites are referenced definitions
+
+	infoSchema := schema.PrefixItems[0]
+	assert.Equal(t, "info", infoSchema.Title)
+	require.Len(t, infoSchema.AllOf, 1)
+	assert.Equal(t, "#/$defs/S.test.Info", infoSchema.AllOf[0].Ref)
+
+	definition := schema.Defs["S.test.Info"]
+	require.NotNil(t, definition)
+	assert.Equal(t, "Struct", definition.Properties["type"].Const)
+
+	compositeSchema := valueSchema(t, definition)
+	assert.Equal(t, "S.test.Info", compositeSchema.Properties["id"].Const)
+
+	fieldsSchema := compositeSchema.Properties["fields"]
+	assert.Equal(t, 2, *fieldsSchema.MinItems)
+	assert.Equal(t, 2, *fieldsSchema.MaxItems)
+	require.Len(t, fieldsSchema.AllOf, 2)
+
+	// Dictionaries are lists of key-value pairs
+
+	tagsValueSchema := valueSchema(t, schema.PrefixItems[1])
+	assert.Equal(t, "array", tagsValueSchema.Type)
+	entrySchema := tagsValueSchema.Items.(*jsonSchema)
+	assert.Equal(t, []string{"key", "value"}, entrySchema.Required)
+
+	// Paths have a fixed domain
+
+	pathValueSchema := valueSchema(t, schema.PrefixItems[2])
+	assert.Equal(t, "public", pathValueSchema.Properties["domain"].Const)
+
+	// The schema can be encoded
+
+	_, err = json.Marshal(schema)
+	require.NoError(t, err)
+}
+
+func TestGenerateSchemaUnsupportedType(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := generateTestSchema(t, `
+      pub fun main(value: Capability): Int {
+          return 0
+      }
+    `)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parameter `value`")
+}
+
+func TestJSONPointerEscaper(t *testing.T) {
+
+	t.Parallel()
+
+	assert.Equal(t, "S.~1a~0b.c", jsonPointerEscaper.Replace("S./a~b.c"))
+}
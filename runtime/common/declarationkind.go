@@ -57,6 +57,9 @@ const (
 	DeclarationKindPragma
 	DeclarationKindEnum
 	DeclarationKindEnumCase
+	DeclarationKindTypeAlias
+	DeclarationKindExtension
+	DeclarationKindEntitlement
 )
 
 func DeclarationKindCount() int {
@@ -73,7 +76,10 @@ func (k DeclarationKind) IsTypeDeclaration() bool {
 		DeclarationKindResourceInterface,
 		DeclarationKindContractInterface,
 		DeclarationKindTypeParameter,
-		DeclarationKindEnum:
+		DeclarationKindEnum,
+		DeclarationKindTypeAlias,
+		DeclarationKindExtension,
+		DeclarationKindEntitlement:
 
 		return true
 
@@ -138,6 +144,12 @@ func (k DeclarationKind) Name() string {
 		return "enum"
 	case DeclarationKindEnumCase:
 		return "enum case"
+	case DeclarationKindTypeAlias:
+		return "type alias"
+	case DeclarationKindExtension:
+		return "extension"
+	case DeclarationKindEntitlement:
+		return "entitlement"
 	case DeclarationKindUnknown:
 		return "unknown"
 	}
@@ -187,6 +199,12 @@ func (k DeclarationKind) Keywords() string {
 		return "enum"
 	case DeclarationKindEnumCase:
 		return "case"
+	case DeclarationKindTypeAlias:
+		return "typealias"
+	case DeclarationKindExtension:
+		return "extension"
+	case DeclarationKindEntitlement:
+		return "entitlement"
 	default:
 		return ""
 	}
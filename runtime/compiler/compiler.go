@@ -63,14 +63,14 @@ func (compiler *Compiler) VisitReturnStatement(statement *ast.ReturnStatement) a
 	}
 }
 
-func (compiler *Compiler) VisitBreakStatement(_ *ast.BreakStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitBreakStatement(statement *ast.BreakStatement) ast.Repr {
+	// TODO: support, including labels
+	panic(newUnsupportedLabelableFeatureError("break statements", statement.Label, statement))
 }
 
-func (compiler *Compiler) VisitContinueStatement(_ *ast.ContinueStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitContinueStatement(statement *ast.ContinueStatement) ast.Repr {
+	// TODO: support, including labels
+	panic(newUnsupportedLabelableFeatureError("continue statements", statement.Label, statement))
 }
 
 func (compiler *Compiler) VisitIfStatement(_ *ast.IfStatement) ast.Repr {
@@ -78,14 +78,32 @@ func (compiler *Compiler) VisitIfStatement(_ *ast.IfStatement) ast.Repr {
 	panic(errors.NewUnreachableError())
 }
 
-func (compiler *Compiler) VisitWhileStatement(_ *ast.WhileStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitWhileStatement(statement *ast.WhileStatement) ast.Repr {
+	// TODO: support, including labels
+	panic(newUnsupportedLabelableFeatureError("while loops", statement.Label, statement))
 }
 
-func (compiler *Compiler) VisitForStatement(_ *ast.ForStatement) ast.Repr {
-	// TODO
-	panic(errors.NewUnreachableError())
+func (compiler *Compiler) VisitForStatement(statement *ast.ForStatement) ast.Repr {
+	// TODO: support, including labels
+	panic(newUnsupportedLabelableFeatureError("for loops", statement.Label, statement))
+}
+
+// newUnsupportedLabelableFeatureError returns an error for the given unsupported statement,
+// which may have a label
+//
+func newUnsupportedLabelableFeatureError(
+	feature string,
+	label *ast.Identifier,
+	statement ast.HasPosition,
+) *UnsupportedFeatureError {
+	if label != nil {
+		feature = "labeled " + feature
+	}
+
+	return &UnsupportedFeatureError{
+		Feature: feature,
+		Range:   ast.NewRangeFromPositioned(statement),
+	}
 }
 
 func (compiler *Compiler) VisitEmitStatement(_ *ast.EmitStatement) ast.Repr {
@@ -260,6 +278,11 @@ func (compiler *Compiler) VisitForceExpression(_ *ast.ForceExpression) ast.Repr
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitStringTemplateExpression(_ *ast.StringTemplateExpression) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitPathExpression(_ *ast.PathExpression) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -270,6 +293,23 @@ func (compiler *Compiler) VisitProgram(_ *ast.Program) ast.Repr {
 	panic(errors.NewUnreachableError())
 }
 
+// CompileFunction compiles the given function declaration.
+// It returns an error if the function uses a feature which is not supported yet
+//
+func (compiler *Compiler) CompileFunction(declaration *ast.FunctionDeclaration) (function *ir.Func, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			unsupportedFeatureError, ok := r.(*UnsupportedFeatureError)
+			if !ok {
+				panic(r)
+			}
+			err = unsupportedFeatureError
+		}
+	}()
+
+	return declaration.Accept(compiler).(*ir.Func), nil
+}
+
 func (compiler *Compiler) VisitFunctionDeclaration(declaration *ast.FunctionDeclaration) ast.Repr {
 
 	// TODO: declare function in current scope, use current scope in function
@@ -362,6 +402,21 @@ func (compiler *Compiler) VisitPragmaDeclaration(_ *ast.PragmaDeclaration) ast.R
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
+func (compiler *Compiler) VisitExtensionDeclaration(_ *ast.ExtensionDeclaration) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
+func (compiler *Compiler) VisitEntitlementDeclaration(_ *ast.EntitlementDeclaration) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitImportDeclaration(_ *ast.ImportDeclaration) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -21,8 +21,10 @@ package compiler
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/compiler/ir"
 	"github.com/onflow/cadence/runtime/tests/checker"
 )
@@ -81,3 +83,88 @@ func TestCompilerSimple(t *testing.T) {
 		res,
 	)
 }
+
+func TestCompilerUnsupportedLabeledLoops(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(name string, code string, expectedFeature string) {
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := checker.ParseAndCheck(t, code)
+			require.NoError(t, err)
+
+			compiler := NewCompiler(checker)
+
+			_, err = compiler.CompileFunction(checker.Program.FunctionDeclarations()[0])
+			require.Error(t, err)
+
+			require.IsType(t, &UnsupportedFeatureError{}, err)
+			assert.Equal(t, expectedFeature, err.(*UnsupportedFeatureError).Feature)
+		})
+	}
+
+	test(
+		"labeled while loop",
+		`
+          fun test() {
+              outer: while true {
+                  while true {
+                      break outer
+                  }
+              }
+          }
+        `,
+		"labeled while loops",
+	)
+
+	test(
+		"labeled for loop",
+		`
+          fun test() {
+              outer: for x in [1] {
+                  continue outer
+              }
+          }
+        `,
+		"labeled for loops",
+	)
+
+	test(
+		"while loop",
+		`
+          fun test() {
+              while true {
+                  break
+              }
+          }
+        `,
+		"while loops",
+	)
+
+	t.Run("labeled break and continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		compiler := NewCompiler(nil)
+
+		label := &ast.Identifier{Identifier: "outer"}
+
+		assert.PanicsWithError(t,
+			"compiler does not support labeled break statements yet",
+			func() {
+				compiler.VisitBreakStatement(&ast.BreakStatement{Label: label})
+			},
+		)
+
+		assert.PanicsWithError(t,
+			"compiler does not support labeled continue statements yet",
+			func() {
+				compiler.VisitContinueStatement(&ast.ContinueStatement{Label: label})
+			},
+		)
+	})
+}
@@ -129,6 +129,7 @@ func (validator *ContractUpdateValidator) checkDeclarationUpdatability(
 	if newDecl, ok := newDeclaration.(*ast.CompositeDeclaration); ok {
 		if oldDecl, ok := oldDeclaration.(*ast.CompositeDeclaration); ok {
 			validator.checkConformances(oldDecl, newDecl)
+			validator.checkTypeParameters(oldDecl, newDecl)
 		}
 	}
 }
@@ -214,6 +215,51 @@ func (validator *ContractUpdateValidator) checkNestedDeclarations(
 
 		validator.checkDeclarationUpdatability(oldNestedDecl, newNestedDecl)
 	}
+
+	oldNestedExtensionDecls := map[string]*ast.ExtensionDeclaration{}
+	for _, oldNestedDecl := range oldDeclaration.DeclarationMembers().Extensions() {
+		oldNestedExtensionDecls[oldNestedDecl.Identifier.Identifier] = oldNestedDecl
+	}
+
+	newNestedExtensions := newDeclaration.DeclarationMembers().Extensions()
+	for _, newNestedDecl := range newNestedExtensions {
+		oldNestedDecl := oldNestedExtensionDecls[newNestedDecl.Identifier.Identifier]
+		if oldNestedDecl == nil {
+			// Then this is a new declaration.
+			continue
+		}
+
+		validator.checkExtensionUpdatability(oldNestedDecl, newNestedDecl)
+	}
+}
+
+// checkExtensionUpdatability checks the fields of an updated extension.
+//
+// Unlike composites, extensions may declare additional fields:
+// The fields of an extension are optional and stored separately from the extended value,
+// so existing values simply have no value for a new field.
+// However, the types of existing fields must not change.
+//
+func (validator *ContractUpdateValidator) checkExtensionUpdatability(
+	oldDeclaration *ast.ExtensionDeclaration,
+	newDeclaration *ast.ExtensionDeclaration,
+) {
+	parentDecl := validator.currentDecl
+	validator.currentDecl = newDeclaration
+	defer func() {
+		validator.currentDecl = parentDecl
+	}()
+
+	oldFields := oldDeclaration.Members.FieldsByIdentifier()
+
+	for _, newField := range newDeclaration.Members.Fields() {
+		oldField := oldFields[newField.Identifier.Identifier]
+		if oldField == nil {
+			continue
+		}
+
+		validator.checkField(oldField, newField)
+	}
 }
 
 func (validator *ContractUpdateValidator) CheckNominalTypeEquality(expected *ast.NominalType, found ast.Type) error {
@@ -446,6 +492,60 @@ func (validator *ContractUpdateValidator) checkConformances(
 	}
 }
 
+// checkTypeParameters checks that the type parameters of a generic structure are unchanged,
+// as stored values of the structure have type arguments for them
+//
+func (validator *ContractUpdateValidator) checkTypeParameters(
+	oldDecl *ast.CompositeDeclaration,
+	newDecl *ast.CompositeDeclaration,
+) {
+	var oldTypeParameters, newTypeParameters []*ast.TypeParameter
+
+	if oldDecl.TypeParameterList != nil {
+		oldTypeParameters = oldDecl.TypeParameterList.TypeParameters
+	}
+
+	if newDecl.TypeParameterList != nil {
+		newTypeParameters = newDecl.TypeParameterList.TypeParameters
+	}
+
+	report := func() {
+		validator.report(&TypeParametersMismatchError{
+			declName: newDecl.Identifier.Identifier,
+			Range:    ast.NewRangeFromPositioned(newDecl.Identifier),
+		})
+	}
+
+	if len(oldTypeParameters) != len(newTypeParameters) {
+		report()
+		return
+	}
+
+	for index, oldTypeParameter := range oldTypeParameters {
+		newTypeParameter := newTypeParameters[index]
+
+		if oldTypeParameter.Identifier.Identifier != newTypeParameter.Identifier.Identifier {
+			report()
+			return
+		}
+
+		oldTypeBound := oldTypeParameter.TypeBound
+		newTypeBound := newTypeParameter.TypeBound
+
+		if (oldTypeBound == nil) != (newTypeBound == nil) {
+			report()
+			return
+		}
+
+		if oldTypeBound != nil &&
+			oldTypeBound.Type.CheckEqual(newTypeBound.Type, validator) != nil {
+
+			report()
+			return
+		}
+	}
+}
+
 func (validator *ContractUpdateValidator) report(err error) {
 	if err == nil {
 		return
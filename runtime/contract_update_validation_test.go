@@ -1326,6 +1326,129 @@ func TestContractUpdateValidation(t *testing.T) {
 			"\n  |                ^^^^^^^^^^^^^^^^^^^^^^^^^ "+
 			"incompatible type annotations. expected `{TestInterface}`, found `TestStruct{TestInterface}`")
 	})
+
+	t.Run("add field to nested extension", func(t *testing.T) {
+
+		const oldCode = `
+			pub contract Test28 {
+
+				pub struct TestStruct {}
+
+				pub extension TestExtension for TestStruct {
+					pub var a: Int?
+				}
+			}`
+
+		const newCode = `
+			pub contract Test28 {
+
+				pub struct TestStruct {}
+
+				pub extension TestExtension for TestStruct {
+					pub var a: Int?
+					pub var b: String?
+				}
+			}`
+
+		err := deployAndUpdate("Test28", oldCode, newCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("change nested extension field type", func(t *testing.T) {
+
+		const oldCode = `
+			pub contract Test29 {
+
+				pub struct TestStruct {}
+
+				pub extension TestExtension for TestStruct {
+					pub var a: Int?
+				}
+			}`
+
+		const newCode = `
+			pub contract Test29 {
+
+				pub struct TestStruct {}
+
+				pub extension TestExtension for TestStruct {
+					pub var a: String?
+				}
+			}`
+
+		err := deployAndUpdate("Test29", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getErrorCause(t, err, "Test29")
+		assertFieldTypeMismatchError(t, cause, "TestExtension", "a", "Int", "String")
+	})
+
+	t.Run("change type parameters of nested struct", func(t *testing.T) {
+
+		const oldCode = `
+			pub contract Test30 {
+
+				pub struct Box<T> {
+					pub let value: T
+
+					init(value: T) {
+						self.value = value
+					}
+				}
+			}`
+
+		const newCode = `
+			pub contract Test30 {
+
+				pub struct Box<T, U> {
+					pub let value: T
+
+					init(value: T) {
+						self.value = value
+					}
+				}
+			}`
+
+		err := deployAndUpdate("Test30", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getErrorCause(t, err, "Test30")
+		require.IsType(t, &TypeParametersMismatchError{}, cause)
+		assert.Equal(t, "type parameters do not match in `Box`", cause.Error())
+	})
+
+	t.Run("change type parameter bound of nested struct", func(t *testing.T) {
+
+		const oldCode = `
+			pub contract Test31 {
+
+				pub struct Box<T: Integer> {
+					pub let value: T
+
+					init(value: T) {
+						self.value = value
+					}
+				}
+			}`
+
+		const newCode = `
+			pub contract Test31 {
+
+				pub struct Box<T: Number> {
+					pub let value: T
+
+					init(value: T) {
+						self.value = value
+					}
+				}
+			}`
+
+		err := deployAndUpdate("Test31", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getErrorCause(t, err, "Test31")
+		require.IsType(t, &TypeParametersMismatchError{}, cause)
+	})
 }
 
 func assertDeclTypeChangeError(
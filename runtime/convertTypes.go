@@ -30,6 +30,17 @@ import (
 // ExportType converts a runtime type to its corresponding Go representation.
 func ExportType(t sema.Type, results map[sema.TypeID]cadence.Type) cadence.Type {
 
+	// The type parameters of generic functions and structures are exported as their type bound.
+	// NOTE: handled before the lookup of the results, as type parameters are identified by name only
+
+	if genericType, ok := t.(*sema.GenericType); ok {
+		typeBound := genericType.TypeParameter.TypeBound
+		if typeBound == nil {
+			typeBound = sema.AnyStructType
+		}
+		return ExportType(typeBound, results)
+	}
+
 	typeID := t.ID()
 	if result, ok := results[typeID]; ok {
 		return result
@@ -91,12 +102,18 @@ func ExportType(t sema.Type, results map[sema.TypeID]cadence.Type) cadence.Type
 			return cadence.Fix64Type{}
 		case *sema.UFix64Type:
 			return cadence.UFix64Type{}
+		case *sema.Fix128Type:
+			return cadence.Fix128Type{}
+		case *sema.UFix128Type:
+			return cadence.UFix128Type{}
 		case *sema.VariableSizedType:
 			return exportVariableSizedType(t, results)
 		case *sema.ConstantSizedType:
 			return exportConstantSizedType(t, results)
 		case *sema.CompositeType:
 			return exportCompositeType(t, results)
+		case *sema.InstantiatedType:
+			return exportInstantiatedType(t, results)
 		case *sema.InterfaceType:
 			return exportInterfaceType(t, results)
 		case *sema.DictionaryType:
@@ -187,7 +204,25 @@ func exportConstantSizedType(t *sema.ConstantSizedType, results map[sema.TypeID]
 	}
 }
 
-func exportCompositeType(t *sema.CompositeType, results map[sema.TypeID]cadence.Type) (result cadence.CompositeType) {
+func exportCompositeType(t *sema.CompositeType, results map[sema.TypeID]cadence.Type) cadence.CompositeType {
+	return exportCompositeTypeWithTypeArguments(t, t.ID(), nil, results)
+}
+
+// exportInstantiatedType exports an instantiation of a generic structure
+// as the structure type, with the type arguments substituted in the field types
+//
+func exportInstantiatedType(t *sema.InstantiatedType, results map[sema.TypeID]cadence.Type) cadence.CompositeType {
+	return exportCompositeTypeWithTypeArguments(t.Type, t.ID(), t.TypeParameterTypes(), results)
+}
+
+func exportCompositeTypeWithTypeArguments(
+	t *sema.CompositeType,
+	typeID sema.TypeID,
+	typeArguments *sema.TypeParameterTypeOrderedMap,
+	results map[sema.TypeID]cadence.Type,
+) (
+	result cadence.CompositeType,
+) {
 
 	fieldMembers := make([]*sema.Member, 0, len(t.Fields))
 
@@ -250,10 +285,18 @@ func exportCompositeType(t *sema.CompositeType, results map[sema.TypeID]cadence.
 
 	// NOTE: ensure to set the result before recursively export field types
 
-	results[t.ID()] = result
+	results[typeID] = result
 
 	for i, member := range fieldMembers {
-		convertedFieldType := ExportType(member.TypeAnnotation.Type, results)
+		fieldType := member.TypeAnnotation.Type
+		if typeArguments != nil {
+			resolvedFieldType := fieldType.Resolve(typeArguments)
+			if resolvedFieldType != nil {
+				fieldType = resolvedFieldType
+			}
+		}
+
+		convertedFieldType := ExportType(fieldType, results)
 
 		fields[i] = cadence.Field{
 			Identifier: member.Identifier.Identifier,
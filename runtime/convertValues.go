@@ -20,6 +20,7 @@ package runtime
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/runtime/common"
@@ -126,6 +127,10 @@ func exportValueWithInterpreter(
 			return cadence.Fix64(v)
 		case interpreter.UFix64Value:
 			return cadence.UFix64(v)
+		case interpreter.Fix128Value:
+			return cadence.Fix128{Value: new(big.Int).Set(v.BigInt)}
+		case interpreter.UFix128Value:
+			return cadence.UFix128{Value: new(big.Int).Set(v.BigInt)}
 		case *interpreter.CompositeValue:
 			return exportCompositeValue(v, inter, results)
 		case *interpreter.DictionaryValue:
@@ -181,9 +186,25 @@ func exportArrayValue(v *interpreter.ArrayValue, inter *interpreter.Interpreter,
 func exportCompositeValue(v *interpreter.CompositeValue, inter *interpreter.Interpreter, results exportResults) cadence.Value {
 
 	dynamicType := v.DynamicType(inter).(interpreter.CompositeDynamicType)
-	staticType := dynamicType.StaticType.(*sema.CompositeType)
+
 	// TODO: consider making the results map "global", by moving it up to exportValueWithInterpreter
-	t := exportCompositeType(staticType, map[sema.TypeID]cadence.Type{})
+	typeResults := map[sema.TypeID]cadence.Type{}
+
+	var staticType *sema.CompositeType
+	var t cadence.CompositeType
+
+	switch dynamicStaticType := dynamicType.StaticType.(type) {
+	case *sema.CompositeType:
+		staticType = dynamicStaticType
+		t = exportCompositeType(staticType, typeResults)
+
+	case *sema.InstantiatedType:
+		staticType = dynamicStaticType.Type
+		t = exportInstantiatedType(dynamicStaticType, typeResults)
+
+	default:
+		panic(fmt.Errorf("cannot export composite value of type %s", dynamicType.StaticType))
+	}
 
 	// NOTE: use the exported type's fields to ensure fields in type
 	// and value are in sync
@@ -343,6 +364,10 @@ func importValue(value cadence.Value) interpreter.Value {
 		return interpreter.Fix64Value(v)
 	case cadence.UFix64:
 		return interpreter.UFix64Value(v)
+	case cadence.Fix128:
+		return interpreter.NewFix128ValueFromBigInt(v.Value)
+	case cadence.UFix128:
+		return interpreter.NewUFix128ValueFromBigInt(v.Value)
 	case cadence.Array:
 		return importArrayValue(v)
 	case cadence.Dictionary:
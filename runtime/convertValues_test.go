@@ -20,6 +20,7 @@ package runtime
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -196,6 +197,16 @@ var exportTests = []exportTest{
 		value:    interpreter.UFix64Value(123000000),
 		expected: cadence.UFix64(123000000),
 	},
+	{
+		label:    "Fix128",
+		value:    interpreter.NewFix128ValueFromBigInt(big.NewInt(-123000000)),
+		expected: cadence.Fix128{Value: big.NewInt(-123000000)},
+	},
+	{
+		label:    "UFix128",
+		value:    interpreter.NewUFix128ValueFromBigInt(big.NewInt(123000000)),
+		expected: cadence.UFix128{Value: big.NewInt(123000000)},
+	},
 	{
 		label: "Path",
 		value: interpreter.PathValue{
@@ -385,6 +396,40 @@ func TestExportStructValue(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestExportGenericStructValue(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+        pub struct Box<T> {
+            pub let value: T
+
+            init(value: T) {
+                self.value = value
+            }
+        }
+
+        pub fun main(): Box<String> {
+            return Box(value: "42")
+        }
+    `
+
+	actual := exportValueFromScript(t, script)
+	expected := cadence.NewStruct([]cadence.Value{cadence.NewString("42")}).
+		WithType(&cadence.StructType{
+			Location:            utils.TestLocation,
+			QualifiedIdentifier: "Box",
+			Fields: []cadence.Field{
+				{
+					Identifier: "value",
+					Type:       cadence.StringType{},
+				},
+			},
+		})
+
+	assert.Equal(t, expected, actual)
+}
+
 func TestExportResourceValue(t *testing.T) {
 
 	t.Parallel()
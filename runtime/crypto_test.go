@@ -19,12 +19,16 @@
 package runtime
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/tests/bls"
 	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
@@ -155,10 +159,12 @@ func TestRuntimeCrypto_hash(t *testing.T) {
 	runtimeInterface := &testRuntimeInterface{
 		hash: func(
 			data []byte,
+			tag string,
 			hashAlgorithm HashAlgorithm,
 		) ([]byte, error) {
 			called = true
 			assert.Equal(t, []byte{1, 2, 3, 4}, data)
+			assert.Equal(t, "", tag)
 			assert.Equal(t, HashAlgorithmSHA3_256, hashAlgorithm)
 			return []byte{5, 6, 7, 8}, nil
 		},
@@ -187,3 +193,296 @@ func TestRuntimeCrypto_hash(t *testing.T) {
 
 	assert.True(t, called)
 }
+
+func TestRuntimeCrypto_hashWithTag(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	script := []byte(`
+      import Crypto
+
+      pub fun main() {
+          log(Crypto.hashWithTag("01020304".decodeHex(), tag: "user", algorithm: HashAlgorithm.SHA3_256))
+      }
+    `)
+
+	called := false
+
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		hash: func(
+			data []byte,
+			tag string,
+			hashAlgorithm HashAlgorithm,
+		) ([]byte, error) {
+			called = true
+			assert.Equal(t, []byte{1, 2, 3, 4}, data)
+			assert.Equal(t, "user", tag)
+			assert.Equal(t, HashAlgorithmSHA3_256, hashAlgorithm)
+			return []byte{5, 6, 7, 8}, nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	_, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  utils.TestLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"[5, 6, 7, 8]",
+		},
+		loggedMessages,
+	)
+
+	assert.True(t, called)
+}
+
+func TestRuntimeHashAlgorithm_hashWithTag(t *testing.T) {
+
+	t.Parallel()
+
+	executeScript := func(script []byte) (cadence.Value, error) {
+		runtime := NewInterpreterRuntime()
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: &testRuntimeInterface{},
+				Location:  utils.TestLocation,
+			},
+		)
+	}
+
+	t.Run("digests", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript([]byte(`
+          pub fun main(): [String] {
+              let data = "abc".utf8
+              return [
+                  String.encodeHex(HashAlgorithm.SHA2_256.hashWithTag(data, tag: "")),
+                  String.encodeHex(HashAlgorithm.SHA3_256.hashWithTag(data, tag: "")),
+                  String.encodeHex(HashAlgorithm.KECCAK_256.hashWithTag(data, tag: "")),
+                  String.encodeHex(HashAlgorithm.SHA3_256.hashWithTag(data, tag: "user"))
+              ]
+          }
+        `))
+		require.NoError(t, err)
+
+		// A non-empty tag is right-padded to 32 bytes
+
+		paddedTag := make([]byte, 32)
+		copy(paddedTag, "user")
+
+		taggedDigest := sha3.Sum256(append(paddedTag, "abc"...))
+
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewString("ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"),
+				cadence.NewString("3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532"),
+				cadence.NewString("4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"),
+				cadence.NewString(hex.EncodeToString(taggedDigest[:])),
+			}),
+			result,
+		)
+	})
+
+	t.Run("different tags", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript([]byte(`
+          pub fun main(): [String] {
+              let algorithm = HashAlgorithm.SHA3_256
+              return [
+                  String.encodeHex(algorithm.hashWithTag("abc".utf8, tag: "")),
+                  String.encodeHex(algorithm.hashWithTag("bc".utf8, tag: "a")),
+                  String.encodeHex(algorithm.hashWithTag("c".utf8, tag: "ab")),
+                  String.encodeHex(algorithm.hashWithTag("abc".utf8, tag: "b"))
+              ]
+          }
+        `))
+		require.NoError(t, err)
+
+		// The same bytes, split differently between tag and data,
+		// and the same data with different tags, have different hashes
+
+		digests := map[string]struct{}{}
+		for _, digest := range result.(cadence.Array).Values {
+			digests[string(digest.(cadence.String))] = struct{}{}
+		}
+
+		assert.Len(t, digests, 4)
+	})
+
+	t.Run("tag too long", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := executeScript([]byte(`
+          pub fun main(): [UInt8] {
+              return HashAlgorithm.SHA3_256.hashWithTag(
+                  "abc".utf8,
+                  tag: "0123456789abcdef0123456789abcdef0"
+              )
+          }
+        `))
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "hash tag is longer than 32 bytes")
+	})
+}
+
+func TestRuntimeCrypto_BLS(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	signedData := []byte("hello")
+
+	var publicKeys []cadence.Value
+	var signatures []cadence.Value
+	var proofs []cadence.Value
+
+	for i := 0; i < 3; i++ {
+		privateKey, err := bls.GeneratePrivateKey([]byte{byte(i)})
+		require.NoError(t, err)
+
+		signature, err := privateKey.Sign("user", signedData)
+		require.NoError(t, err)
+
+		proof, err := privateKey.ProofOfPossession()
+		require.NoError(t, err)
+
+		publicKeys = append(publicKeys, cadence.NewString(hex.EncodeToString(privateKey.PublicKey())))
+		signatures = append(signatures, cadence.NewString(hex.EncodeToString(signature)))
+		proofs = append(proofs, cadence.NewString(hex.EncodeToString(proof)))
+	}
+
+	script := []byte(`
+      import Crypto
+
+      pub fun main(
+          publicKeys: [String],
+          signatures: [String],
+          proofs: [String],
+          signedData: String
+      ): [Bool] {
+
+          let keys: [PublicKey] = []
+          var validProofs = true
+
+          var i = 0
+          while i < publicKeys.length {
+              let key = PublicKey(
+                  publicKey: publicKeys[i].decodeHex(),
+                  signatureAlgorithm: SignatureAlgorithm.BLS_BLS12_381
+              )
+              keys.append(key)
+
+              validProofs = validProofs
+                  && Crypto.verifyBLSProofOfPossession(publicKey: key, proof: proofs[i].decodeHex())
+
+              i = i + 1
+          }
+
+          let invalidProof = Crypto.verifyBLSProofOfPossession(
+              publicKey: keys[0],
+              proof: proofs[1].decodeHex()
+          )
+
+          let decodedSignatures: [[UInt8]] = []
+          for signature in signatures {
+              decodedSignatures.append(signature.decodeHex())
+          }
+
+          let aggregatedSignature = Crypto.aggregateBLSSignatures(decodedSignatures)!
+          let aggregatedKey = Crypto.aggregateBLSPublicKeys(keys)!
+
+          let keyList = Crypto.KeyList()
+          keyList.add(
+              aggregatedKey,
+              hashAlgorithm: HashAlgorithm.SHA2_256,
+              weight: 1.0
+          )
+
+          let valid = keyList.isValid(
+              signatureSet: [
+                  Crypto.KeyListSignature(
+                      keyIndex: 0,
+                      signature: aggregatedSignature
+                  )
+              ],
+              signedData: signedData.decodeHex()
+          )
+
+          let ecdsaKey = PublicKey(
+              publicKey: "0102".decodeHex(),
+              signatureAlgorithm: SignatureAlgorithm.ECDSA_P256
+          )
+
+          return [
+              validProofs,
+              invalidProof,
+              valid,
+              Crypto.aggregateBLSSignatures([]) == nil,
+              Crypto.aggregateBLSSignatures(["0102".decodeHex()]) == nil,
+              Crypto.aggregateBLSPublicKeys([]) == nil,
+              Crypto.aggregateBLSPublicKeys([keys[0], ecdsaKey]) == nil
+          ]
+      }
+    `)
+
+	runtimeInterface := &testRuntimeInterface{
+		decodeArgument: func(b []byte, t cadence.Type) (value cadence.Value, err error) {
+			return jsoncdc.Decode(b)
+		},
+	}
+
+	result, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+			Arguments: encodeArgs([]cadence.Value{
+				cadence.NewArray(publicKeys),
+				cadence.NewArray(signatures),
+				cadence.NewArray(proofs),
+				cadence.NewString(hex.EncodeToString(signedData)),
+			}),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  utils.TestLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewBool(true),
+			cadence.NewBool(false),
+			cadence.NewBool(true),
+			cadence.NewBool(true),
+			cadence.NewBool(true),
+			cadence.NewBool(true),
+			cadence.NewBool(true),
+		}),
+		result,
+	)
+}
@@ -249,6 +249,15 @@ func (*InvalidContractDeploymentOriginError) Error() string {
 	return "cannot deploy invalid contract"
 }
 
+// AccountBalanceUnavailableError is reported when the balance of an account is accessed,
+// but the host environment does not provide account balances
+//
+type AccountBalanceUnavailableError struct{}
+
+func (AccountBalanceUnavailableError) Error() string {
+	return "cannot get account balance: unavailable"
+}
+
 // Contract update related errors
 
 // ContractUpdateError is reported upon any invalid update to a contract or contract interface.
@@ -371,3 +380,14 @@ type ConformanceCountMismatchError struct {
 func (e *ConformanceCountMismatchError) Error() string {
 	return fmt.Sprintf("conformances count does not match: expected %d, found %d", e.expected, e.found)
 }
+
+// TypeParametersMismatchError is reported during a contract update, when the type parameters
+// of a generic structure do not match the existing type parameters.
+type TypeParametersMismatchError struct {
+	declName string
+	ast.Range
+}
+
+func (e *TypeParametersMismatchError) Error() string {
+	return fmt.Sprintf("type parameters do not match in `%s`", e.declName)
+}
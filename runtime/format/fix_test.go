@@ -19,6 +19,7 @@
 package format
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -30,3 +31,28 @@ func TestUFix64(t *testing.T) {
 
 	require.Equal(t, "99999999999.70000000", UFix64(9999999999970000000))
 }
+
+func TestFix128(t *testing.T) {
+
+	t.Parallel()
+
+	value, ok := new(big.Int).SetString("-1500000000000000000000001", 10)
+	require.True(t, ok)
+
+	require.Equal(t, "-1.500000000000000000000001", Fix128(value))
+
+	value, ok = new(big.Int).SetString("-500000000000000000000000", 10)
+	require.True(t, ok)
+
+	require.Equal(t, "-0.500000000000000000000000", Fix128(value))
+}
+
+func TestUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	value, ok := new(big.Int).SetString("99999999999700000000000000000000000", 10)
+	require.True(t, ok)
+
+	require.Equal(t, "99999999999.700000000000000000000000", UFix128(value))
+}
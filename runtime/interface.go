@@ -60,6 +60,8 @@ type Interface interface {
 	AddAccountKey(address Address, publicKey *PublicKey, hashAlgo HashAlgorithm, weight int) (*AccountKey, error)
 	// GetAccountKey retrieves a key from an account by index.
 	GetAccountKey(address Address, index int) (*AccountKey, error)
+	// GetAccountKeyCount returns the number of keys of an account, including revoked keys.
+	GetAccountKeyCount(address Address) (count int, err error)
 	// RemoveAccountKey removes a key from an account by index.
 	RevokeAccountKey(address Address, index int) (*AccountKey, error)
 	// UpdateAccountContractCode updates the code associated with an account contract.
@@ -101,8 +103,23 @@ type Interface interface {
 		signatureAlgorithm SignatureAlgorithm,
 		hashAlgorithm HashAlgorithm,
 	) (bool, error)
-	// Hash returns the digest of hashing the given data with using the given hash algorithm
-	Hash(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error)
+	// Hash returns the digest of hashing the given data using the given hash algorithm,
+	// domain-separated by the given tag.
+	//
+	// A non-empty tag is encoded as its UTF-8 bytes, right-padded with zero bytes to 32 bytes,
+	// and prefixed to the data, so hashes with different tags never collide.
+	// Tags longer than 32 bytes must be rejected with an error.
+	// The tag is empty for untagged hashes, which hash the data only.
+	Hash(data []byte, tag string, hashAlgorithm HashAlgorithm) ([]byte, error)
+	// AggregateBLSSignatures returns the aggregation of the given BLS signatures,
+	// or nil if the signatures cannot be aggregated, e.g. because one of them is invalid.
+	AggregateBLSSignatures(signatures [][]byte) ([]byte, error)
+	// AggregateBLSPublicKeys returns the aggregation of the given BLS public keys,
+	// or nil if the public keys cannot be aggregated, e.g. because one of them is invalid.
+	AggregateBLSPublicKeys(publicKeys [][]byte) ([]byte, error)
+	// VerifyBLSProofOfPossession returns true if the given proof of possession
+	// is valid for the given BLS public key.
+	VerifyBLSProofOfPossession(publicKey []byte, proof []byte) (bool, error)
 	// GetStorageUsed gets storage used in bytes by the address at the moment of the function call.
 	GetStorageUsed(address Address) (value uint64, err error)
 	// GetStorageCapacity gets storage capacity in bytes on the address.
@@ -123,6 +140,22 @@ type HighLevelStorage interface {
 	SetCadenceValue(owner Address, key string, value cadence.Value) (err error)
 }
 
+// AccountBalances is an optional extension of Interface,
+// which provides the balances of accounts.
+//
+// If the host environment does not implement it,
+// accessing the balance fields of accounts fails
+//
+type AccountBalances interface {
+	Interface
+
+	// GetAccountBalance gets the balance of the account, in the smallest unit of UFix64.
+	GetAccountBalance(address Address) (value uint64, err error)
+	// GetAccountAvailableBalance gets the balance of the account which is available to be moved,
+	// in the smallest unit of UFix64.
+	GetAccountAvailableBalance(address Address) (value uint64, err error)
+}
+
 type Metrics interface {
 	ProgramParsed(location common.Location, duration time.Duration)
 	ProgramChecked(location common.Location, duration time.Duration)
@@ -203,6 +236,10 @@ func (i *emptyRuntimeInterface) GetAccountKey(_ Address, _ int) (*AccountKey, er
 	return nil, nil
 }
 
+func (i *emptyRuntimeInterface) GetAccountKeyCount(_ Address) (int, error) {
+	return 0, nil
+}
+
 func (i *emptyRuntimeInterface) UpdateAccountContractCode(_ Address, _ string, _ []byte) (err error) {
 	return nil
 }
@@ -272,11 +309,24 @@ func (i *emptyRuntimeInterface) VerifySignature(
 
 func (i *emptyRuntimeInterface) Hash(
 	_ []byte,
+	_ string,
 	_ HashAlgorithm,
 ) ([]byte, error) {
 	return nil, nil
 }
 
+func (i *emptyRuntimeInterface) AggregateBLSSignatures(_ [][]byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (i *emptyRuntimeInterface) AggregateBLSPublicKeys(_ [][]byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (i *emptyRuntimeInterface) VerifyBLSProofOfPossession(_ []byte, _ []byte) (bool, error) {
+	return false, nil
+}
+
 func (i emptyRuntimeInterface) GetStorageUsed(_ Address) (uint64, error) {
 	return 0, nil
 }
@@ -232,11 +232,17 @@ func (d *Decoder) decodeValue(v interface{}, path []string) (Value, error) {
 		case cborTagFix64Value:
 			return d.decodeFix64(v.Content)
 
+		case cborTagFix128Value:
+			return d.decodeFix128(v.Content)
+
 		// UFix*
 
 		case cborTagUFix64Value:
 			return d.decodeUFix64(v.Content)
 
+		case cborTagUFix128Value:
+			return d.decodeUFix128(v.Content)
+
 		// Storage
 
 		case cborTagPathValue:
@@ -727,10 +733,97 @@ func (d *Decoder) decodeComposite(v interface{}, path []string) (*CompositeValue
 	}
 
 	compositeValue := NewCompositeValue(location, qualifiedIdentifier, kind, fields, d.owner)
+
+	// Extensions
+
+	extensionsField, ok := encoded[encodedCompositeValueExtensionsFieldKey]
+	if ok {
+		extensions, err := d.decodeCompositeExtensions(extensionsField, path)
+		if err != nil {
+			return nil, err
+		}
+		compositeValue.Extensions = extensions
+	}
+
+	// Type arguments
+
+	typeArgumentsField, ok := encoded[encodedCompositeValueTypeArgumentsFieldKey]
+	if ok {
+		typeArguments, err := d.decodeStaticTypes(typeArgumentsField)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"invalid composite type arguments encoding (@ %s): %w",
+				strings.Join(path, "."),
+				err,
+			)
+		}
+		compositeValue.TypeArguments = typeArguments
+	}
+
 	compositeValue.modified = false
 	return compositeValue, nil
 }
 
+func (d *Decoder) decodeCompositeExtensions(v interface{}, path []string) (*StringValueOrderedMap, error) {
+	encodedExtensions, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf(
+			"invalid composite extensions encoding (@ %s): %T",
+			strings.Join(path, "."),
+			v,
+		)
+	}
+
+	// Gather all extension type IDs and sort them lexicographically
+
+	extensionTypeIDs := make([]string, 0, len(encodedExtensions))
+
+	for extensionTypeID := range encodedExtensions { //nolint:maprangecheck
+		typeIDString, ok := extensionTypeID.(string)
+		if !ok {
+			return nil, fmt.Errorf(
+				"invalid composite extension type ID encoding (@ %s): %T",
+				strings.Join(path, "."),
+				extensionTypeID,
+			)
+		}
+
+		extensionTypeIDs = append(extensionTypeIDs, typeIDString)
+	}
+
+	sort.Strings(extensionTypeIDs)
+
+	extensions := NewStringValueOrderedMap()
+
+	for _, extensionTypeID := range extensionTypeIDs {
+
+		valuePath := append(path[:], extensionTypeID)
+		decodedValue, err := d.decodeValue(encodedExtensions[extensionTypeID], valuePath)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"invalid composite extension encoding (@ %s, %s): %w",
+				strings.Join(path, "."),
+				extensionTypeID,
+				err,
+			)
+		}
+
+		extensionFields, ok := decodedValue.(*CompositeValue)
+		if !ok {
+			return nil, fmt.Errorf(
+				"invalid composite extension encoding (@ %s, %s): %T",
+				strings.Join(path, "."),
+				extensionTypeID,
+				decodedValue,
+			)
+		}
+
+		extensions.Set(extensionTypeID, extensionFields)
+	}
+
+	return extensions, nil
+}
+
 var bigOne = big.NewInt(1)
 
 func (d *Decoder) decodeBig(v interface{}) (*big.Int, error) {
@@ -1056,6 +1149,43 @@ func (d *Decoder) decodeUFix64(v interface{}) (UFix64Value, error) {
 	return UFix64Value(value), nil
 }
 
+func (d *Decoder) decodeFix128(v interface{}) (Fix128Value, error) {
+	bigInt, err := d.decodeBig(v)
+	if err != nil {
+		return Fix128Value{}, fmt.Errorf("invalid Fix128 encoding: %w", err)
+	}
+
+	min := sema.Fix128TypeMinScaledBig
+	if bigInt.Cmp(min) < 0 {
+		return Fix128Value{}, fmt.Errorf("invalid Fix128: got %s, expected min %s", bigInt, min)
+	}
+
+	max := sema.Fix128TypeMaxScaledBig
+	if bigInt.Cmp(max) > 0 {
+		return Fix128Value{}, fmt.Errorf("invalid Fix128: got %s, expected max %s", bigInt, max)
+	}
+
+	return NewFix128ValueFromBigInt(bigInt), nil
+}
+
+func (d *Decoder) decodeUFix128(v interface{}) (UFix128Value, error) {
+	bigInt, err := d.decodeBig(v)
+	if err != nil {
+		return UFix128Value{}, fmt.Errorf("invalid UFix128 encoding: %w", err)
+	}
+
+	if bigInt.Sign() < 0 {
+		return UFix128Value{}, fmt.Errorf("invalid UFix128: got %s, expected positive", bigInt)
+	}
+
+	max := sema.UFix128TypeMaxScaledBig
+	if bigInt.Cmp(max) > 0 {
+		return UFix128Value{}, fmt.Errorf("invalid UFix128: got %s, expected max %s", bigInt, max)
+	}
+
+	return NewUFix128ValueFromBigInt(bigInt), nil
+}
+
 func (d *Decoder) decodeSome(v interface{}, path []string) (*SomeValue, error) {
 	value, err := d.decodeValue(v, path)
 	if err != nil {
@@ -1281,6 +1411,12 @@ func (d *Decoder) decodeStaticType(v interface{}) (StaticType, error) {
 	case cborTagCapabilityStaticType:
 		return d.decodeCapabilityStaticType(content)
 
+	case cborTagInstantiatedStaticType:
+		return d.decodeInstantiatedStaticType(content)
+
+	case cborTagInclusiveRangeStaticType:
+		return d.decodeInclusiveRangeStaticType(content)
+
 	default:
 		return nil, fmt.Errorf("invalid static type encoding tag: %d", tag.Number)
 	}
@@ -1485,9 +1621,57 @@ func (d *Decoder) decodeReferenceStaticType(v interface{}) (StaticType, error) {
 		return nil, fmt.Errorf("invalid reference static type inner type encoding: %w", err)
 	}
 
+	// The entitlements are optional
+
+	var entitlements []EntitlementStaticType
+
+	field3, ok := encoded[encodedReferenceStaticTypeEntitlementsFieldKey]
+	if ok {
+		encodedEntitlements, ok := field3.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid reference static type entitlements encoding: %T", field3)
+		}
+
+		entitlements = make([]EntitlementStaticType, len(encodedEntitlements))
+		for i, encodedEntitlement := range encodedEntitlements {
+			entitlement, err := d.decodeEntitlementStaticType(encodedEntitlement)
+			if err != nil {
+				return nil, err
+			}
+			entitlements[i] = entitlement
+		}
+	}
+
 	return ReferenceStaticType{
-		Authorized: authorized,
-		Type:       staticType,
+		Authorized:   authorized,
+		Entitlements: entitlements,
+		Type:         staticType,
+	}, nil
+}
+
+func (d *Decoder) decodeEntitlementStaticType(v interface{}) (EntitlementStaticType, error) {
+	encoded, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return EntitlementStaticType{}, fmt.Errorf("invalid entitlement static type encoding: %T", v)
+	}
+
+	location, err := d.decodeLocation(encoded[encodedEntitlementStaticTypeLocationFieldKey])
+	if err != nil {
+		return EntitlementStaticType{}, fmt.Errorf("invalid entitlement static type location encoding: %w", err)
+	}
+
+	field2 := encoded[encodedEntitlementStaticTypeQualifiedIdentifierFieldKey]
+	qualifiedIdentifier, ok := field2.(string)
+	if !ok {
+		return EntitlementStaticType{}, fmt.Errorf(
+			"invalid entitlement static type qualified identifier encoding: %T",
+			field2,
+		)
+	}
+
+	return EntitlementStaticType{
+		Location:            location,
+		QualifiedIdentifier: qualifiedIdentifier,
 	}, nil
 }
 
@@ -1589,3 +1773,64 @@ func (d *Decoder) decodeCapabilityStaticType(v interface{}) (StaticType, error)
 		BorrowType: borrowStaticType,
 	}, nil
 }
+
+func (d *Decoder) decodeInstantiatedStaticType(v interface{}) (StaticType, error) {
+	encoded, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid instantiated static type encoding: %T", v)
+	}
+
+	decodedType, err := d.decodeStaticType(encoded[encodedInstantiatedStaticTypeTypeFieldKey])
+	if err != nil {
+		return nil, fmt.Errorf("invalid instantiated static type type encoding: %w", err)
+	}
+
+	compositeType, ok := decodedType.(CompositeStaticType)
+	if !ok {
+		return nil, fmt.Errorf("invalid instantiated static type type encoding: %T", decodedType)
+	}
+
+	typeArguments, err := d.decodeStaticTypes(encoded[encodedInstantiatedStaticTypeTypeArgumentsFieldKey])
+	if err != nil {
+		return nil, fmt.Errorf("invalid instantiated static type type arguments encoding: %w", err)
+	}
+
+	return InstantiatedStaticType{
+		Type:          compositeType,
+		TypeArguments: typeArguments,
+	}, nil
+}
+
+func (d *Decoder) decodeInclusiveRangeStaticType(v interface{}) (StaticType, error) {
+	var elementStaticType StaticType
+	if v != nil {
+		var err error
+		elementStaticType, err = d.decodeStaticType(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid inclusive range static type element type encoding: %w", err)
+		}
+	}
+	return InclusiveRangeStaticType{
+		ElementType: elementStaticType,
+	}, nil
+}
+
+func (d *Decoder) decodeStaticTypes(v interface{}) ([]StaticType, error) {
+	encodedTypes, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid static types encoding: %T", v)
+	}
+
+	types := make([]StaticType, len(encodedTypes))
+
+	for i, encodedType := range encodedTypes {
+		staticType, err := d.decodeStaticType(encodedType)
+		if err != nil {
+			return nil, err
+		}
+
+		types[i] = staticType
+	}
+
+	return types, nil
+}
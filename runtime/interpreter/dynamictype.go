@@ -30,9 +30,19 @@ type ReferenceDynamicType interface {
 	DynamicType
 	isReferenceType()
 	Authorized() bool
+	Entitlements() []*sema.EntitlementType
 	InnerType() DynamicType
 }
 
+func referenceDynamicTypeHasEntitlement(t ReferenceDynamicType, entitlement *sema.EntitlementType) bool {
+	for _, grantedEntitlement := range t.Entitlements() {
+		if grantedEntitlement.Equal(entitlement) {
+			return true
+		}
+	}
+	return false
+}
+
 // MetaTypeDynamicType
 
 type MetaTypeDynamicType struct{}
@@ -106,8 +116,9 @@ func (SomeDynamicType) IsDynamicType() {}
 // StorageReferenceDynamicType
 
 type StorageReferenceDynamicType struct {
-	authorized bool
-	innerType  DynamicType
+	authorized   bool
+	entitlements []*sema.EntitlementType
+	innerType    DynamicType
 }
 
 func (StorageReferenceDynamicType) IsDynamicType() {}
@@ -118,6 +129,10 @@ func (t StorageReferenceDynamicType) Authorized() bool {
 	return t.authorized
 }
 
+func (t StorageReferenceDynamicType) Entitlements() []*sema.EntitlementType {
+	return t.entitlements
+}
+
 func (t StorageReferenceDynamicType) InnerType() DynamicType {
 	return t.innerType
 }
@@ -125,8 +140,9 @@ func (t StorageReferenceDynamicType) InnerType() DynamicType {
 // EphemeralReferenceDynamicType
 
 type EphemeralReferenceDynamicType struct {
-	authorized bool
-	innerType  DynamicType
+	authorized   bool
+	entitlements []*sema.EntitlementType
+	innerType    DynamicType
 }
 
 func (EphemeralReferenceDynamicType) IsDynamicType() {}
@@ -137,6 +153,10 @@ func (t EphemeralReferenceDynamicType) Authorized() bool {
 	return t.authorized
 }
 
+func (t EphemeralReferenceDynamicType) Entitlements() []*sema.EntitlementType {
+	return t.entitlements
+}
+
 func (t EphemeralReferenceDynamicType) InnerType() DynamicType {
 	return t.innerType
 }
@@ -179,6 +199,14 @@ type CapabilityDynamicType struct {
 
 func (CapabilityDynamicType) IsDynamicType() {}
 
+// InclusiveRangeDynamicType
+
+type InclusiveRangeDynamicType struct {
+	StaticType *sema.InclusiveRangeType
+}
+
+func (InclusiveRangeDynamicType) IsDynamicType() {}
+
 // AuthAccountDynamicType
 
 type AuthAccountDynamicType struct{}
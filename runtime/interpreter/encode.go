@@ -125,7 +125,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	cborTagFix64Value
-	_ // future: Fix128
+	cborTagFix128Value
 	_ // future: Fix256
 	_
 
@@ -135,7 +135,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	cborTagUFix64Value
-	_ // future: UFix128
+	cborTagUFix128Value
 	_ // future: UFix256
 	_
 
@@ -175,6 +175,8 @@ const (
 	cborTagReferenceStaticType
 	cborTagRestrictedStaticType
 	cborTagCapabilityStaticType
+	cborTagInstantiatedStaticType
+	cborTagInclusiveRangeStaticType
 )
 
 type EncodingDeferralMove struct {
@@ -382,11 +384,17 @@ func (e *Encoder) prepare(
 	case Fix64Value:
 		return e.prepareFix64(v), nil
 
+	case Fix128Value:
+		return e.prepareFix128(v), nil
+
 	// UFix*
 
 	case UFix64Value:
 		return e.prepareUFix64(v), nil
 
+	case UFix128Value:
+		return e.prepareUFix128(v), nil
+
 	// String
 
 	case *StringValue:
@@ -614,6 +622,20 @@ func (e *Encoder) prepareUFix64(v UFix64Value) cbor.Tag {
 	}
 }
 
+func (e *Encoder) prepareFix128(v Fix128Value) cbor.Tag {
+	return cbor.Tag{
+		Number:  cborTagFix128Value,
+		Content: prepareBigInt(v.BigInt),
+	}
+}
+
+func (e *Encoder) prepareUFix128(v UFix128Value) cbor.Tag {
+	return cbor.Tag{
+		Number:  cborTagUFix128Value,
+		Content: prepareBigInt(v.BigInt),
+	}
+}
+
 func (e *Encoder) prepareString(v *StringValue) string {
 	return v.Str
 }
@@ -775,6 +797,8 @@ const (
 	encodedCompositeValueKindFieldKey                uint64 = 2
 	encodedCompositeValueFieldsFieldKey              uint64 = 3
 	encodedCompositeValueQualifiedIdentifierFieldKey uint64 = 4
+	encodedCompositeValueExtensionsFieldKey          uint64 = 5
+	encodedCompositeValueTypeArgumentsFieldKey       uint64 = 6
 )
 
 func (e *Encoder) prepareCompositeValue(
@@ -805,14 +829,49 @@ func (e *Encoder) prepareCompositeValue(
 		return nil, err
 	}
 
+	content := cborMap{
+		encodedCompositeValueLocationFieldKey:            location,
+		encodedCompositeValueKindFieldKey:                uint(v.Kind),
+		encodedCompositeValueFieldsFieldKey:              fields,
+		encodedCompositeValueQualifiedIdentifierFieldKey: v.QualifiedIdentifier,
+	}
+
+	// The fields of extensions are only encoded if any were set,
+	// so the encoding of composites without extension fields is unchanged
+
+	if v.Extensions != nil && v.Extensions.Len() > 0 {
+		extensions := make(map[string]interface{}, v.Extensions.Len())
+
+		for pair := v.Extensions.Oldest(); pair != nil; pair = pair.Next() {
+			extensionTypeID := pair.Key
+
+			valuePath := append(path[:], extensionTypeID)
+
+			prepared, err := e.prepare(pair.Value, valuePath, deferrals)
+			if err != nil {
+				return nil, err
+			}
+			extensions[extensionTypeID] = prepared
+		}
+
+		content[encodedCompositeValueExtensionsFieldKey] = extensions
+	}
+
+	// The type arguments are only encoded for instances of generic structures,
+	// so the encoding of all other composites is unchanged
+
+	if len(v.TypeArguments) > 0 {
+		typeArguments, err := e.prepareStaticTypes(v.TypeArguments)
+		if err != nil {
+			return nil, err
+		}
+
+		content[encodedCompositeValueTypeArgumentsFieldKey] = typeArguments
+	}
+
 	return cbor.Tag{
-		Number: cborTagCompositeValue,
-		Content: cborMap{
-			encodedCompositeValueLocationFieldKey:            location,
-			encodedCompositeValueKindFieldKey:                uint(v.Kind),
-			encodedCompositeValueFieldsFieldKey:              fields,
-			encodedCompositeValueQualifiedIdentifierFieldKey: v.QualifiedIdentifier,
-		},
+		Number:  cborTagCompositeValue,
+		Content: content,
 	}, nil
 }
 
@@ -1000,6 +1059,12 @@ func (e *Encoder) prepareStaticType(t StaticType) (interface{}, error) {
 	case CapabilityStaticType:
 		return e.prepareCapabilityStaticType(v)
 
+	case InstantiatedStaticType:
+		return e.prepareInstantiatedStaticType(v)
+
+	case InclusiveRangeStaticType:
+		return e.prepareInclusiveRangeStaticType(v)
+
 	default:
 		return nil, fmt.Errorf("unsupported static type: %T", t)
 	}
@@ -1103,8 +1168,9 @@ func (e *Encoder) prepareConstantSizedStaticType(v ConstantSizedStaticType) (int
 
 // NOTE: NEVER change, only add/increment; ensure uint64
 const (
-	encodedReferenceStaticTypeAuthorizedFieldKey uint64 = 0
-	encodedReferenceStaticTypeTypeFieldKey       uint64 = 1
+	encodedReferenceStaticTypeAuthorizedFieldKey   uint64 = 0
+	encodedReferenceStaticTypeTypeFieldKey         uint64 = 1
+	encodedReferenceStaticTypeEntitlementsFieldKey uint64 = 2
 )
 
 func (e *Encoder) prepareReferenceStaticType(v ReferenceStaticType) (interface{}, error) {
@@ -1113,12 +1179,49 @@ func (e *Encoder) prepareReferenceStaticType(v ReferenceStaticType) (interface{}
 		return nil, err
 	}
 
+	content := cborMap{
+		encodedReferenceStaticTypeAuthorizedFieldKey: v.Authorized,
+		encodedReferenceStaticTypeTypeFieldKey:       staticType,
+	}
+
+	// NOTE: The entitlements are only encoded if there are any,
+	// so the encoding of references without entitlements is unchanged
+
+	if len(v.Entitlements) > 0 {
+		encodedEntitlements := make([]interface{}, len(v.Entitlements))
+		for i, entitlement := range v.Entitlements {
+			encodedEntitlement, err := e.prepareEntitlementStaticType(entitlement)
+			if err != nil {
+				return nil, err
+			}
+
+			encodedEntitlements[i] = encodedEntitlement
+		}
+
+		content[encodedReferenceStaticTypeEntitlementsFieldKey] = encodedEntitlements
+	}
+
 	return cbor.Tag{
-		Number: cborTagReferenceStaticType,
-		Content: cborMap{
-			encodedReferenceStaticTypeAuthorizedFieldKey: v.Authorized,
-			encodedReferenceStaticTypeTypeFieldKey:       staticType,
-		},
+		Number:  cborTagReferenceStaticType,
+		Content: content,
+	}, nil
+}
+
+// NOTE: NEVER change, only add/increment; ensure uint64
+const (
+	encodedEntitlementStaticTypeLocationFieldKey            uint64 = 0
+	encodedEntitlementStaticTypeQualifiedIdentifierFieldKey uint64 = 1
+)
+
+func (e *Encoder) prepareEntitlementStaticType(v EntitlementStaticType) (interface{}, error) {
+	location, err := e.prepareLocation(v.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	return cborMap{
+		encodedEntitlementStaticTypeLocationFieldKey:            location,
+		encodedEntitlementStaticTypeQualifiedIdentifierFieldKey: v.QualifiedIdentifier,
 	}, nil
 }
 
@@ -1219,3 +1322,60 @@ func (e *Encoder) prepareCapabilityStaticType(v CapabilityStaticType) (interface
 		Content: borrowStaticType,
 	}, nil
 }
+
+// NOTE: NEVER change, only add/increment; ensure uint64
+const (
+	encodedInstantiatedStaticTypeTypeFieldKey          uint64 = 0
+	encodedInstantiatedStaticTypeTypeArgumentsFieldKey uint64 = 1
+)
+
+func (e *Encoder) prepareInstantiatedStaticType(v InstantiatedStaticType) (interface{}, error) {
+	compositeType, err := e.prepareCompositeStaticType(v.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	typeArguments, err := e.prepareStaticTypes(v.TypeArguments)
+	if err != nil {
+		return nil, err
+	}
+
+	return cbor.Tag{
+		Number: cborTagInstantiatedStaticType,
+		Content: cborMap{
+			encodedInstantiatedStaticTypeTypeFieldKey:          compositeType,
+			encodedInstantiatedStaticTypeTypeArgumentsFieldKey: typeArguments,
+		},
+	}, nil
+}
+
+func (e *Encoder) prepareInclusiveRangeStaticType(v InclusiveRangeStaticType) (interface{}, error) {
+	var elementStaticType interface{}
+	if v.ElementType != nil {
+		var err error
+		elementStaticType, err = e.prepareStaticType(v.ElementType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cbor.Tag{
+		Number:  cborTagInclusiveRangeStaticType,
+		Content: elementStaticType,
+	}, nil
+}
+
+func (e *Encoder) prepareStaticTypes(types []StaticType) ([]interface{}, error) {
+	preparedTypes := make([]interface{}, len(types))
+
+	for i, staticType := range types {
+		preparedType, err := e.prepareStaticType(staticType)
+		if err != nil {
+			return nil, err
+		}
+
+		preparedTypes[i] = preparedType
+	}
+
+	return preparedTypes, nil
+}
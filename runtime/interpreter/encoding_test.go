@@ -876,6 +876,163 @@ func TestEncodeDecodeComposite(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("structure with extension fields", func(t *testing.T) {
+
+		extensionFields := NewStringValueOrderedMap()
+		extensionFields.Set("a", BoolValue(true))
+
+		extensionValue := NewCompositeValue(
+			utils.TestLocation,
+			"E",
+			common.CompositeKindStructure,
+			extensionFields,
+			nil,
+		)
+		extensionValue.modified = false
+
+		expected := NewCompositeValue(
+			utils.TestLocation,
+			"S",
+			common.CompositeKindStructure,
+			NewStringValueOrderedMap(),
+			nil,
+		)
+		expected.Extensions = NewStringValueOrderedMap()
+		expected.Extensions.Set("S.test.E", extensionValue)
+		expected.modified = false
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: expected,
+				encoded: []byte{
+					// tag
+					0xd8, cborTagCompositeValue,
+					// map, 5 pairs of items follow
+					0xa5,
+					// key 0
+					0x0,
+					// tag
+					0xd8, cborTagStringLocation,
+					// UTF-8 string, length 4
+					0x64,
+					// t, e, s, t
+					0x74, 0x65, 0x73, 0x74,
+					// key 2
+					0x2,
+					// positive integer 1
+					0x1,
+					// key 3
+					0x3,
+					// map, 0 pairs of items follow
+					0xa0,
+					// key 4
+					0x4,
+					// UTF-8 string, length 1
+					0x61,
+					// S
+					0x53,
+					// key 5
+					0x5,
+					// map, 1 pair of items follows
+					0xa1,
+					// UTF-8 string, length 8
+					0x68,
+					// S, ., t, e, s, t, ., E
+					0x53, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45,
+					// tag
+					0xd8, cborTagCompositeValue,
+					// map, 4 pairs of items follow
+					0xa4,
+					// key 0
+					0x0,
+					// tag
+					0xd8, cborTagStringLocation,
+					// UTF-8 string, length 4
+					0x64,
+					// t, e, s, t
+					0x74, 0x65, 0x73, 0x74,
+					// key 2
+					0x2,
+					// positive integer 1
+					0x1,
+					// key 3
+					0x3,
+					// map, 1 pair of items follows
+					0xa1,
+					// UTF-8 string, length 1
+					0x61,
+					// a
+					0x61,
+					// true
+					0xf5,
+					// key 4
+					0x4,
+					// UTF-8 string, length 1
+					0x61,
+					// E
+					0x45,
+				},
+			},
+		)
+	})
+
+	t.Run("generic structure", func(t *testing.T) {
+
+		expected := NewCompositeValue(
+			utils.TestLocation,
+			"Box",
+			common.CompositeKindStructure,
+			NewStringValueOrderedMap(),
+			nil,
+		)
+		expected.TypeArguments = []StaticType{
+			PrimitiveStaticTypeString,
+		}
+		expected.modified = false
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: expected,
+				encoded: []byte{
+					// tag
+					0xd8, cborTagCompositeValue,
+					// map, 5 pairs of items follow
+					0xa5,
+					// key 0
+					0x0,
+					// tag
+					0xd8, cborTagStringLocation,
+					// UTF-8 string, length 4
+					0x64,
+					// t, e, s, t
+					0x74, 0x65, 0x73, 0x74,
+					// key 2
+					0x2,
+					// positive integer 1
+					0x1,
+					// key 3
+					0x3,
+					// map, 0 pairs of items follow
+					0xa0,
+					// key 4
+					0x4,
+					// UTF-8 string, length 3
+					0x63,
+					// B, o, x
+					0x42, 0x6f, 0x78,
+					// key 6
+					0x6,
+					// array, 1 item follows
+					0x81,
+					// tag
+					0xd8, cborTagPrimitiveStaticType,
+					// string
+					0x8,
+				},
+			},
+		)
+	})
 }
 
 func TestEncodeDecodeIntValue(t *testing.T) {
@@ -2969,6 +3126,147 @@ func TestEncodeDecodeUFix64Value(t *testing.T) {
 	})
 }
 
+func TestEncodeDecodeFix128Value(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("zero", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(big.NewInt(0)),
+				encoded: []byte{
+					0xd8, cborTagFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 0
+					0x40,
+				},
+			},
+		)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(big.NewInt(-42)),
+				encoded: []byte{
+					0xd8, cborTagFix128Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 1
+					0x41,
+					0x29,
+				},
+			},
+		)
+	})
+
+	t.Run("max", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(sema.Fix128TypeMaxScaledBig),
+				encoded: []byte{
+					0xd8, cborTagFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 16
+					0x50,
+					0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+			},
+		)
+	})
+
+	t.Run(">max", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, cborTagFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 16
+					0x50,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+				invalid: true,
+			},
+		)
+	})
+}
+
+func TestEncodeDecodeUFix128Value(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("zero", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewUFix128ValueFromBigInt(big.NewInt(0)),
+				encoded: []byte{
+					0xd8, cborTagUFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 0
+					0x40,
+				},
+			},
+		)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, cborTagUFix128Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 1
+					0x41,
+					0x29,
+				},
+				invalid: true,
+			},
+		)
+	})
+
+	t.Run("max", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewUFix128ValueFromBigInt(sema.UFix128TypeMaxScaledBig),
+				encoded: []byte{
+					0xd8, cborTagUFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 16
+					0x50,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+			},
+		)
+	})
+
+	t.Run(">max", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, cborTagUFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 17
+					0x51,
+					0x01,
+					0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+					0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				},
+				invalid: true,
+			},
+		)
+	})
+}
+
 func TestEncodeDecodeStorageReferenceValue(t *testing.T) {
 
 	t.Parallel()
@@ -3884,6 +4182,62 @@ func TestEncodeDecodeLinkValue(t *testing.T) {
 		)
 	})
 
+	t.Run("reference type, entitlements, bool", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: LinkValue{
+					TargetPath: publicPathValue,
+					Type: ReferenceStaticType{
+						Authorized: false,
+						Entitlements: []EntitlementStaticType{
+							{
+								Location:            utils.TestLocation,
+								QualifiedIdentifier: "Withdraw",
+							},
+						},
+						Type: PrimitiveStaticTypeBool,
+					},
+				},
+				encoded: append(
+					expectedLinkEncodingPrefix[:],
+					// tag
+					0xd8, cborTagReferenceStaticType,
+					// map, 3 pairs of items follow
+					0xa3,
+					// key 0
+					0x0,
+					// false
+					0xf4,
+					// key 1
+					0x1,
+					// tag
+					0xd8, cborTagPrimitiveStaticType,
+					0x6,
+					// key 2
+					0x2,
+					// array, 1 item follows
+					0x81,
+					// map, 2 pairs of items follow
+					0xa2,
+					// key 0
+					0x0,
+					// tag
+					0xd8, cborTagStringLocation,
+					// UTF-8 string, length 4
+					0x64,
+					// t, e, s, t
+					0x74, 0x65, 0x73, 0x74,
+					// key 1
+					0x1,
+					// UTF-8 string, length 8
+					0x68,
+					// Withdraw
+					0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+				),
+			},
+		)
+	})
+
 	t.Run("dictionary, bool, string", func(t *testing.T) {
 		testEncodeDecode(t,
 			encodeDecodeTest{
@@ -4240,6 +4594,90 @@ func TestEncodeDecodeTypeValue(t *testing.T) {
 		)
 	})
 
+	t.Run("instantiated", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: TypeValue{
+					Type: InstantiatedStaticType{
+						Type: CompositeStaticType{
+							Location:            utils.TestLocation,
+							QualifiedIdentifier: "Box",
+						},
+						TypeArguments: []StaticType{
+							PrimitiveStaticTypeBool,
+						},
+					},
+				},
+				encoded: []byte{
+					// tag
+					0xd8, cborTagTypeValue,
+					// map, 1 pair of items follow
+					0xa1,
+					// key 0
+					0x0,
+					// tag
+					0xd8, cborTagInstantiatedStaticType,
+					// map, 2 pairs of items follow
+					0xa2,
+					// key 0
+					0x0,
+					// tag
+					0xd8, cborTagCompositeStaticType,
+					// map, 2 pairs of items follow
+					0xa2,
+					// key 0
+					0x0,
+					// tag
+					0xd8, cborTagStringLocation,
+					// UTF-8 string, length 4
+					0x64,
+					// t, e, s, t
+					0x74, 0x65, 0x73, 0x74,
+					// key 2
+					0x2,
+					// UTF-8 string, length 3
+					0x63,
+					// B, o, x
+					0x42, 0x6f, 0x78,
+					// key 1
+					0x1,
+					// array, 1 item follows
+					0x81,
+					// tag
+					0xd8, cborTagPrimitiveStaticType,
+					// bool
+					0x6,
+				},
+			},
+		)
+	})
+
+	t.Run("inclusive range", func(t *testing.T) {
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: TypeValue{
+					Type: InclusiveRangeStaticType{
+						ElementType: ConvertSemaToPrimitiveStaticType(&sema.IntType{}),
+					},
+				},
+				encoded: []byte{
+					// tag
+					0xd8, cborTagTypeValue,
+					// map, 1 pair of items follow
+					0xa1,
+					// key 0
+					0x0,
+					// tag
+					0xd8, cborTagInclusiveRangeStaticType,
+					// tag
+					0xd8, cborTagPrimitiveStaticType,
+					// positive integer 36
+					0x18, 0x24,
+				},
+			},
+		)
+	})
+
 	t.Run("without static type", func(t *testing.T) {
 		testEncodeDecode(t,
 			encodeDecodeTest{
@@ -336,6 +336,24 @@ func (e ArrayIndexOutOfBoundsError) Error() string {
 	)
 }
 
+// ArraySliceIndicesError
+//
+type ArraySliceIndicesError struct {
+	FromIndex int
+	UpToIndex int
+	Length    int
+	LocationRange
+}
+
+func (e ArraySliceIndicesError) Error() string {
+	return fmt.Sprintf(
+		"array slice indices out of bounds: got %d and %d, expected indices within array length %d",
+		e.FromIndex,
+		e.UpToIndex,
+		e.Length,
+	)
+}
+
 // EventEmissionUnavailableError
 //
 type EventEmissionUnavailableError struct {
@@ -356,6 +374,16 @@ func (e UUIDUnavailableError) Error() string {
 	return "cannot get UUID: unavailable"
 }
 
+// HashUnavailableError
+//
+type HashUnavailableError struct {
+	LocationRange
+}
+
+func (e HashUnavailableError) Error() string {
+	return "cannot hash: unavailable"
+}
+
 // TypeLoadingError
 //
 type TypeLoadingError struct {
@@ -380,3 +408,14 @@ func (e EncodingUnsupportedValueError) Error() string {
 		e.Value,
 	)
 }
+
+// InclusiveRangeConstructionError
+//
+type InclusiveRangeConstructionError struct {
+	Message string
+	LocationRange
+}
+
+func (e InclusiveRangeConstructionError) Error() string {
+	return fmt.Sprintf("cannot create range: %s", e.Message)
+}
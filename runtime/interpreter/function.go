@@ -53,6 +53,9 @@ type InterpretedFunctionValue struct {
 	ParameterList    *ast.ParameterList
 	Type             *sema.FunctionType
 	Activation       *VariableActivation
+	// TypeArguments are the type arguments of the enclosing
+	// generic functions and structures, if any
+	TypeArguments    *sema.TypeParameterTypeOrderedMap
 	BeforeStatements []ast.Statement
 	PreConditions    ast.Conditions
 	Statements       []ast.Statement
@@ -0,0 +1,294 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// InclusiveRangeValue is a range of integers,
+// which includes both the start and the end value.
+//
+// All values of the range have the same integer type, the element type.
+// The step is never zero and never moves the range away from the end
+//
+type InclusiveRangeValue struct {
+	Start       IntegerValue
+	End         IntegerValue
+	Step        IntegerValue
+	ElementType sema.Type
+}
+
+// NewInclusiveRangeValue returns a new range from the given start to the given end value.
+// If the step is nil, it defaults to 1.
+//
+// The start, end and step values must have the same integer type,
+// the step must not be zero, and it must not move the range away from the end
+//
+func NewInclusiveRangeValue(
+	start IntegerValue,
+	end IntegerValue,
+	step IntegerValue,
+	getLocationRange func() LocationRange,
+) InclusiveRangeValue {
+
+	if step == nil {
+		step = integerValueOne(start)
+	}
+
+	staticType := start.StaticType()
+
+	if end.StaticType() != staticType || step.StaticType() != staticType {
+		panic(InclusiveRangeConstructionError{
+			Message:       "start, end, and step must have the same type",
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	startBigInt := integerValueToBigInt(start)
+	endBigInt := integerValueToBigInt(end)
+	stepSign := integerValueToBigInt(step).Sign()
+
+	if stepSign == 0 {
+		panic(InclusiveRangeConstructionError{
+			Message:       "step must not be zero",
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	// The step must move from the start towards the end
+
+	if endBigInt.Cmp(startBigInt)*stepSign < 0 {
+		panic(InclusiveRangeConstructionError{
+			Message: fmt.Sprintf(
+				"step %s moves away from end %s",
+				step,
+				end,
+			),
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	return InclusiveRangeValue{
+		Start:       start,
+		End:         end,
+		Step:        step,
+		ElementType: staticType.(PrimitiveStaticType).SemaType(),
+	}
+}
+
+func (InclusiveRangeValue) IsValue() {}
+
+func (v InclusiveRangeValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitValue(interpreter, v)
+}
+
+func (v InclusiveRangeValue) DynamicType(_ *Interpreter) DynamicType {
+	return InclusiveRangeDynamicType{
+		StaticType: &sema.InclusiveRangeType{
+			MemberType: v.ElementType,
+		},
+	}
+}
+
+func (v InclusiveRangeValue) StaticType() StaticType {
+	return InclusiveRangeStaticType{
+		ElementType: ConvertSemaToStaticType(v.ElementType),
+	}
+}
+
+func (v InclusiveRangeValue) Copy() Value {
+	return v
+}
+
+func (InclusiveRangeValue) GetOwner() *common.Address {
+	// value is never owned
+	return nil
+}
+
+func (InclusiveRangeValue) SetOwner(_ *common.Address) {
+	// NO-OP: value cannot be owned
+}
+
+func (InclusiveRangeValue) IsModified() bool {
+	return false
+}
+
+func (InclusiveRangeValue) SetModified(_ bool) {
+	// NO-OP
+}
+
+func (v InclusiveRangeValue) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	switch name {
+	case sema.InclusiveRangeTypeStartFieldName:
+		return v.Start
+
+	case sema.InclusiveRangeTypeEndFieldName:
+		return v.End
+
+	case sema.InclusiveRangeTypeStepFieldName:
+		return v.Step
+
+	case sema.InclusiveRangeTypeContainsFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				element := invocation.Arguments[0].(IntegerValue)
+				return v.Contains(element)
+			},
+		)
+	}
+
+	return nil
+}
+
+func (v InclusiveRangeValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	panic(errors.NewUnreachableError())
+}
+
+// Contains returns true if the given integer is a value of the range,
+// i.e. it is between the start and the end, and it is reached from the start by the step
+//
+func (v InclusiveRangeValue) Contains(element IntegerValue) BoolValue {
+	startBigInt := integerValueToBigInt(v.Start)
+	endBigInt := integerValueToBigInt(v.End)
+	stepBigInt := integerValueToBigInt(v.Step)
+	elementBigInt := integerValueToBigInt(element)
+
+	// Normalize the bounds, so that the lower bound is less than or equal to the upper bound
+
+	lower, upper := startBigInt, endBigInt
+	if stepBigInt.Sign() < 0 {
+		lower, upper = endBigInt, startBigInt
+	}
+
+	if elementBigInt.Cmp(lower) < 0 || elementBigInt.Cmp(upper) > 0 {
+		return false
+	}
+
+	offset := new(big.Int).Sub(elementBigInt, startBigInt)
+	remainder := new(big.Int).Rem(offset, stepBigInt)
+
+	return remainder.Sign() == 0
+}
+
+// ForEach calls the given function for each value of the range, in order,
+// until the function returns false.
+//
+// The next value is only computed if it is still within the range,
+// so iterating a range which ends at the bounds of its integer type never overflows
+//
+func (v InclusiveRangeValue) ForEach(f func(value IntegerValue) (resume bool)) {
+	endBigInt := integerValueToBigInt(v.End)
+	stepBigInt := integerValueToBigInt(v.Step)
+	stepSign := stepBigInt.Sign()
+
+	current := v.Start
+	currentBigInt := integerValueToBigInt(current)
+
+	for {
+		if !f(current) {
+			return
+		}
+
+		nextBigInt := new(big.Int).Add(currentBigInt, stepBigInt)
+		if nextBigInt.Cmp(endBigInt)*stepSign > 0 {
+			return
+		}
+
+		current = current.Plus(v.Step).(IntegerValue)
+		currentBigInt = nextBigInt
+	}
+}
+
+func (v InclusiveRangeValue) String() string {
+	return fmt.Sprintf(
+		"InclusiveRange(start: %s, end: %s, step: %s)",
+		v.Start,
+		v.End,
+		v.Step,
+	)
+}
+
+// integerValueToBigInt returns the given integer value as a big integer
+//
+func integerValueToBigInt(value IntegerValue) *big.Int {
+	switch value := value.(type) {
+	case BigNumberValue:
+		return value.ToBigInt()
+
+	case UInt64Value:
+		return new(big.Int).SetUint64(uint64(value))
+
+	case Word64Value:
+		return new(big.Int).SetUint64(uint64(value))
+
+	default:
+		return big.NewInt(int64(value.ToInt()))
+	}
+}
+
+// integerValueOne returns the integer 1, with the same type as the given integer value
+//
+func integerValueOne(value IntegerValue) IntegerValue {
+	switch value.(type) {
+	case IntValue:
+		return NewIntValueFromInt64(1)
+	case Int8Value:
+		return Int8Value(1)
+	case Int16Value:
+		return Int16Value(1)
+	case Int32Value:
+		return Int32Value(1)
+	case Int64Value:
+		return Int64Value(1)
+	case Int128Value:
+		return NewInt128ValueFromInt64(1)
+	case Int256Value:
+		return NewInt256ValueFromInt64(1)
+	case UIntValue:
+		return NewUIntValueFromUint64(1)
+	case UInt8Value:
+		return UInt8Value(1)
+	case UInt16Value:
+		return UInt16Value(1)
+	case UInt32Value:
+		return UInt32Value(1)
+	case UInt64Value:
+		return UInt64Value(1)
+	case UInt128Value:
+		return NewUInt128ValueFromUint64(1)
+	case UInt256Value:
+		return NewUInt256ValueFromUint64(1)
+	case Word8Value:
+		return Word8Value(1)
+	case Word16Value:
+		return Word16Value(1)
+	case Word32Value:
+		return Word32Value(1)
+	case Word64Value:
+		return Word64Value(1)
+	}
+
+	panic(errors.NewUnreachableError())
+}
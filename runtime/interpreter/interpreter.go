@@ -32,11 +32,21 @@ type controlReturn interface {
 	isControlReturn()
 }
 
-type controlBreak struct{}
+// controlBreak is the result of a `break` statement.
+// If the label is not empty, the break exits the loop with the label
+//
+type controlBreak struct {
+	Label string
+}
 
 func (controlBreak) isControlReturn() {}
 
-type controlContinue struct{}
+// controlContinue is the result of a `continue` statement.
+// If the label is not empty, the continue resumes the loop with the label
+//
+type controlContinue struct {
+	Label string
+}
 
 func (controlContinue) isControlReturn() {}
 
@@ -97,6 +107,16 @@ type OnFunctionInvocationFunc func(
 	line int,
 )
 
+// OnComputationFunc is a function that is triggered when a built-in function performs computation
+// which is proportional to the size of its input, e.g. the length of a string.
+//
+// The intensity is the size of the input, e.g. the number of bytes of a string.
+//
+type OnComputationFunc func(
+	inter *Interpreter,
+	intensity uint,
+)
+
 // StorageExistenceHandlerFunc is a function that handles storage existence checks.
 //
 type StorageExistenceHandlerFunc func(
@@ -159,6 +179,10 @@ type ImportLocationHandlerFunc func(
 // UUIDHandlerFunc is a function that handles the generation of UUIDs.
 type UUIDHandlerFunc func() (uint64, error)
 
+// HashHandlerFunc is a function that hashes the given tag followed by the given data,
+// using the given hash algorithm.
+type HashHandlerFunc func(data []byte, tag string, hashAlgorithm sema.HashAlgorithm) ([]byte, error)
+
 // CompositeTypeCode contains the the "prepared" / "callable" "code"
 // for the functions and the destructor of a composite
 // (contract, struct, resource, event).
@@ -192,6 +216,7 @@ type TypeCodes struct {
 	CompositeCodes       map[sema.TypeID]CompositeTypeCode
 	InterfaceCodes       map[sema.TypeID]WrapperCode
 	TypeRequirementCodes map[sema.TypeID]WrapperCode
+	ExtensionCodes       map[sema.TypeID]ExtensionTypeCode
 }
 
 func (c TypeCodes) Merge(codes TypeCodes) {
@@ -210,6 +235,10 @@ func (c TypeCodes) Merge(codes TypeCodes) {
 	for typeID, code := range codes.TypeRequirementCodes { //nolint:maprangecheck
 		c.TypeRequirementCodes[typeID] = code
 	}
+
+	for typeID, code := range codes.ExtensionCodes { //nolint:maprangecheck
+		c.ExtensionCodes[typeID] = code
+	}
 }
 
 type Interpreter struct {
@@ -226,6 +255,7 @@ type Interpreter struct {
 	onStatement                    OnStatementFunc
 	onLoopIteration                OnLoopIterationFunc
 	onFunctionInvocation           OnFunctionInvocationFunc
+	onComputation                  OnComputationFunc
 	storageExistenceHandler        StorageExistenceHandlerFunc
 	storageReadHandler             StorageReadHandlerFunc
 	storageWriteHandler            StorageWriteHandlerFunc
@@ -234,8 +264,12 @@ type Interpreter struct {
 	contractValueHandler           ContractValueHandlerFunc
 	importLocationHandler          ImportLocationHandlerFunc
 	uuidHandler                    UUIDHandlerFunc
+	hashHandler                    HashHandlerFunc
 	interpreted                    bool
 	statement                      ast.Statement
+	// typeArguments are the type arguments of the generic functions and structures
+	// which are currently being interpreted, if any
+	typeArguments *sema.TypeParameterTypeOrderedMap
 }
 
 type Option func(*Interpreter) error
@@ -280,6 +314,16 @@ func WithOnFunctionInvocationHandler(handler OnFunctionInvocationFunc) Option {
 	}
 }
 
+// WithOnComputationHandler returns an interpreter option which sets
+// the given function as the computation handler.
+//
+func WithOnComputationHandler(handler OnComputationFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnComputationHandler(handler)
+		return nil
+	}
+}
+
 // WithPredeclaredValues returns an interpreter option which declares
 // the given the predeclared values.
 //
@@ -381,6 +425,16 @@ func WithUUIDHandler(handler UUIDHandlerFunc) Option {
 	}
 }
 
+// WithHashHandler returns an interpreter option which sets the given function
+// as the function that is used to hash data.
+//
+func WithHashHandler(handler HashHandlerFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetHashHandler(handler)
+		return nil
+	}
+}
+
 // WithAllInterpreters returns an interpreter option which sets
 // the given map of interpreters as the map of all interpreters.
 //
@@ -413,9 +467,14 @@ func NewInterpreter(program *Program, location common.Location, options ...Optio
 	defaultOptions := []Option{
 		WithAllInterpreters(map[common.LocationID]*Interpreter{}),
 		withTypeCodes(TypeCodes{
-			CompositeCodes:       map[sema.TypeID]CompositeTypeCode{},
+			CompositeCodes: map[sema.TypeID]CompositeTypeCode{
+				sema.HashAlgorithmType.ID(): {
+					CompositeFunctions: hashAlgorithmFunctions,
+				},
+			},
 			InterfaceCodes:       map[sema.TypeID]WrapperCode{},
 			TypeRequirementCodes: map[sema.TypeID]WrapperCode{},
+			ExtensionCodes:       map[sema.TypeID]ExtensionTypeCode{},
 		}),
 	}
 
@@ -455,6 +514,13 @@ func (interpreter *Interpreter) SetOnFunctionInvocationHandler(function OnFuncti
 	interpreter.onFunctionInvocation = function
 }
 
+// SetOnComputationHandler sets the function that is triggered when a built-in function performs computation
+// which is proportional to the size of its input.
+//
+func (interpreter *Interpreter) SetOnComputationHandler(function OnComputationFunc) {
+	interpreter.onComputation = function
+}
+
 // SetStorageExistenceHandler sets the function that is used when a storage key is checked for existence.
 //
 func (interpreter *Interpreter) SetStorageExistenceHandler(function StorageExistenceHandlerFunc) {
@@ -504,6 +570,12 @@ func (interpreter *Interpreter) SetUUIDHandler(function UUIDHandlerFunc) {
 	interpreter.uuidHandler = function
 }
 
+// SetHashHandler sets the function that is used to hash data.
+//
+func (interpreter *Interpreter) SetHashHandler(function HashHandlerFunc) {
+	interpreter.hashHandler = function
+}
+
 // SetAllInterpreters sets the given map of interpreters as the map of all interpreters.
 //
 func (interpreter *Interpreter) SetAllInterpreters(allInterpreters map[common.LocationID]*Interpreter) {
@@ -847,6 +919,7 @@ func (interpreter *Interpreter) functionDeclarationValue(
 		ParameterList:    declaration.ParameterList,
 		Type:             functionType,
 		Activation:       lexicalScope,
+		TypeArguments:    interpreter.typeArguments,
 		BeforeStatements: beforeStatements,
 		PreConditions:    preConditions,
 		Statements:       declaration.FunctionBlock.Block.Statements,
@@ -1109,6 +1182,10 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 			memberIdentifier := nestedCompositeDeclaration.Identifier.Identifier
 			nestedVariables.Set(memberIdentifier, nestedVariable)
 		}
+
+		for _, nestedExtensionDeclaration := range declaration.Members.Extensions() {
+			interpreter.declareExtension(nestedExtensionDeclaration, lexicalScope)
+		}
 	})()
 
 	compositeType := interpreter.Program.Elaboration.CompositeDeclarationTypes[declaration]
@@ -1245,6 +1322,10 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 				modified: true,
 			}
 
+			if typeParameters := compositeType.TypeParameters(); len(typeParameters) > 0 {
+				value.TypeArguments = compositeTypeArguments(typeParameters, invocation.TypeParameterTypes)
+			}
+
 			invocation.Self = value
 
 			if declaration.CompositeKind == common.CompositeKindContract {
@@ -1292,6 +1373,40 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 	return lexicalScope, variable
 }
 
+// compositeTypeArguments returns the static types of the type arguments
+// of an instance of a generic structure.
+//
+// The type arguments are not recorded if any of them has no static type,
+// e.g. a function type
+//
+func compositeTypeArguments(
+	typeParameters []*sema.TypeParameter,
+	typeParameterTypes *sema.TypeParameterTypeOrderedMap,
+) []StaticType {
+
+	if typeParameterTypes == nil {
+		return nil
+	}
+
+	typeArguments := make([]StaticType, len(typeParameters))
+
+	for i, typeParameter := range typeParameters {
+		typeArgument, ok := typeParameterTypes.Get(typeParameter)
+		if !ok {
+			return nil
+		}
+
+		staticType := ConvertSemaToStaticType(typeArgument)
+		if staticType == nil {
+			return nil
+		}
+
+		typeArguments[i] = staticType
+	}
+
+	return typeArguments
+}
+
 func (interpreter *Interpreter) declareEnumConstructor(
 	declaration *ast.CompositeDeclaration,
 	lexicalScope *VariableActivation,
@@ -1578,6 +1693,9 @@ func (interpreter *Interpreter) copyAndConvert(value Value, valueType, targetTyp
 
 // convertAndBox converts a value to a target type, and boxes in optionals and any value, if necessary
 func (interpreter *Interpreter) convertAndBox(value Value, valueType, targetType sema.Type) Value {
+	valueType = interpreter.substituteTypeArguments(valueType)
+	targetType = interpreter.substituteTypeArguments(targetType)
+
 	value = interpreter.convert(value, valueType, targetType)
 	return interpreter.boxOptional(value, valueType, targetType)
 }
@@ -1703,6 +1821,16 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 		if !valueType.Equal(unwrappedTargetType) {
 			return ConvertUFix64(value)
 		}
+
+	case *sema.Fix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertFix128(value)
+		}
+
+	case *sema.UFix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertUFix128(value)
+		}
 	}
 
 	return value
@@ -2017,6 +2145,7 @@ func (interpreter *Interpreter) NewSubInterpreter(
 		WithOnStatementHandler(interpreter.onStatement),
 		WithOnLoopIterationHandler(interpreter.onLoopIteration),
 		WithOnFunctionInvocationHandler(interpreter.onFunctionInvocation),
+		WithOnComputationHandler(interpreter.onComputation),
 		WithStorageExistenceHandler(interpreter.storageExistenceHandler),
 		WithStorageReadHandler(interpreter.storageReadHandler),
 		WithStorageWriteHandler(interpreter.storageWriteHandler),
@@ -2025,6 +2154,7 @@ func (interpreter *Interpreter) NewSubInterpreter(
 		WithContractValueHandler(interpreter.contractValueHandler),
 		WithImportLocationHandler(interpreter.importLocationHandler),
 		WithUUIDHandler(interpreter.uuidHandler),
+		WithHashHandler(interpreter.hashHandler),
 		WithAllInterpreters(interpreter.allInterpreters),
 		withTypeCodes(interpreter.typeCodes),
 	}
@@ -2161,6 +2291,16 @@ var converterDeclarations = []valueConverterDeclaration{
 			return ConvertUFix64(invocation.Arguments[0])
 		},
 	)},
+	{"Fix128", NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			return ConvertFix128(invocation.Arguments[0])
+		},
+	)},
+	{"UFix128", NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			return ConvertUFix128(invocation.Arguments[0])
+		},
+	)},
 	{"Address", NewHostFunctionValue(
 		func(invocation Invocation) Value {
 			return ConvertAddress(invocation.Arguments[0])
@@ -2172,8 +2312,23 @@ func init() {
 
 	converterNames := make(map[string]struct{}, len(converterDeclarations))
 
-	for _, converterDeclaration := range converterDeclarations {
+	for i, converterDeclaration := range converterDeclarations {
 		converterNames[converterDeclaration.name] = struct{}{}
+
+		// All converters have a static function `fromString`,
+		// which parses a literal of the converter's type
+
+		ty := sema.BaseTypeActivation.Find(converterDeclaration.name).Type
+
+		nestedVariables := NewStringVariableOrderedMap()
+		nestedVariables.Set(
+			sema.FromStringFunctionName,
+			NewVariableWithValue(fromStringFunction(ty)),
+		)
+
+		converter := converterDeclaration.value.(HostFunctionValue)
+		converter.NestedVariables = nestedVariables
+		converterDeclarations[i].value = converter
 	}
 
 	for _, numberType := range sema.AllNumberTypes {
@@ -2197,6 +2352,8 @@ func init() {
 func (interpreter *Interpreter) defineBaseFunctions() {
 	interpreter.defineConverterFunctions()
 	interpreter.defineTypeFunction()
+	interpreter.defineInclusiveRangeFunction()
+	interpreter.defineStringFunction()
 }
 
 func (interpreter *Interpreter) defineConverterFunctions() {
@@ -2235,6 +2392,38 @@ func (interpreter *Interpreter) defineTypeFunction() {
 	}
 }
 
+func (interpreter *Interpreter) defineInclusiveRangeFunction() {
+	err := interpreter.ImportValue(
+		sema.InclusiveRangeTypeName,
+		NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				start := invocation.Arguments[0].(IntegerValue)
+				end := invocation.Arguments[1].(IntegerValue)
+
+				var step IntegerValue
+				if len(invocation.Arguments) > 2 {
+					step = invocation.Arguments[2].(IntegerValue)
+				}
+
+				return NewInclusiveRangeValue(start, end, step, invocation.GetLocationRange)
+			},
+		),
+	)
+	if err != nil {
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (interpreter *Interpreter) defineStringFunction() {
+	err := interpreter.ImportValue(
+		sema.StringType.String(),
+		stringFunction,
+	)
+	if err != nil {
+		panic(errors.NewUnreachableError())
+	}
+}
+
 // TODO:
 // - FunctionType
 //
@@ -2280,6 +2469,9 @@ func IsSubType(subType DynamicType, superType sema.Type) bool {
 	case CompositeDynamicType:
 		return sema.IsSubType(typedSubType.StaticType, superType)
 
+	case InclusiveRangeDynamicType:
+		return sema.IsSubType(typedSubType.StaticType, superType)
+
 	case ArrayDynamicType:
 		var superTypeElementType sema.Type
 
@@ -2351,7 +2543,16 @@ func IsSubType(subType DynamicType, superType sema.Type) bool {
 			if typedSubType.Authorized() {
 				return IsSubType(typedSubType.InnerType(), typedSuperType.Type)
 			} else {
-				// NOTE: Allowing all casts for casting unauthorized references is intentional:
+				// An unauthorized reference may not gain entitlements,
+				// e.g. when it is cast from `AnyStruct`
+
+				for _, entitlement := range typedSuperType.Entitlements {
+					if !referenceDynamicTypeHasEntitlement(typedSubType, entitlement) {
+						return false
+					}
+				}
+
+				// NOTE: Allowing all other casts for casting unauthorized references is intentional:
 				// all invalid cases have already been rejected statically
 				return true
 			}
@@ -2573,6 +2774,7 @@ func (interpreter *Interpreter) authAccountBorrowFunction(addressValue AddressVa
 
 			reference := &StorageReferenceValue{
 				Authorized:           referenceType.Authorized,
+				Entitlements:         referenceType.Entitlements,
 				TargetStorageAddress: address,
 				TargetKey:            key,
 			}
@@ -2720,6 +2922,7 @@ func (interpreter *Interpreter) capabilityBorrowFunction(
 
 			reference := &StorageReferenceValue{
 				Authorized:           authorized,
+				Entitlements:         borrowType.Entitlements,
 				TargetStorageAddress: address,
 				TargetKey:            targetStorageKey,
 			}
@@ -2836,6 +3039,9 @@ func (interpreter *Interpreter) ConvertStaticToSemaType(staticType StaticType) s
 		func(location common.Location, qualifiedIdentifier string) *sema.CompositeType {
 			return interpreter.getCompositeType(location, qualifiedIdentifier)
 		},
+		func(location common.Location, qualifiedIdentifier string) *sema.EntitlementType {
+			return interpreter.getEntitlementType(location, qualifiedIdentifier)
+		},
 	)
 }
 
@@ -2911,6 +3117,25 @@ func (interpreter *Interpreter) getInterfaceType(location common.Location, quali
 	return ty
 }
 
+func (interpreter *Interpreter) getEntitlementType(location common.Location, qualifiedIdentifier string) *sema.EntitlementType {
+	typeID := location.TypeID(qualifiedIdentifier)
+
+	elaboration := interpreter.getElaboration(location)
+	if elaboration == nil {
+		panic(TypeLoadingError{
+			TypeID: typeID,
+		})
+	}
+
+	ty := elaboration.EntitlementTypes[typeID]
+	if ty == nil {
+		panic(TypeLoadingError{
+			TypeID: typeID,
+		})
+	}
+	return ty
+}
+
 func (interpreter *Interpreter) reportLoopIteration(pos ast.HasPosition) {
 	if interpreter.onLoopIteration == nil {
 		return
@@ -2929,6 +3154,14 @@ func (interpreter *Interpreter) reportFunctionInvocation(pos ast.HasPosition) {
 	interpreter.onFunctionInvocation(interpreter, line)
 }
 
+func (interpreter *Interpreter) reportComputation(intensity int) {
+	if interpreter.onComputation == nil {
+		return
+	}
+
+	interpreter.onComputation(interpreter, uint(intensity))
+}
+
 // getMember gets the member value by the given identifier from the given Value depending on its type.
 func (interpreter *Interpreter) getMember(self Value, getLocationRange func() LocationRange, identifier string) Value {
 	var result Value
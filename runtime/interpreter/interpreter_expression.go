@@ -19,6 +19,8 @@
 package interpreter
 
 import (
+	"strings"
+
 	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
@@ -83,6 +85,19 @@ func (interpreter *Interpreter) memberExpressionGetterSetter(memberExpression *a
 	target := interpreter.evalExpression(memberExpression.Expression)
 	getLocationRange := locationRangeGetter(interpreter.Location, memberExpression)
 	identifier := memberExpression.Identifier.Identifier
+
+	extensionType := interpreter.memberExtension(memberExpression)
+	if extensionType != nil {
+		return getterSetter{
+			get: func() Value {
+				return interpreter.getExtensionMember(target, getLocationRange, extensionType, identifier)
+			},
+			set: func(value Value) {
+				interpreter.setExtensionMember(target, getLocationRange, extensionType, identifier, value)
+			},
+		}
+	}
+
 	return getterSetter{
 		get: func() Value {
 			return interpreter.getMember(target, getLocationRange, identifier)
@@ -299,20 +314,32 @@ func (interpreter *Interpreter) VisitIntegerExpression(expression *ast.IntegerEx
 }
 
 func (interpreter *Interpreter) VisitFixedPointExpression(expression *ast.FixedPointExpression) ast.Repr {
-	// TODO: adjust once/if we support more fixed point types
+
+	literalType := sema.FixedPointLiteralType(expression).(sema.FractionalRangedType)
 
 	value := fixedpoint.ConvertToFixedPointBigInt(
 		expression.Negative,
 		expression.UnsignedInteger,
 		expression.Fractional,
 		expression.Scale,
-		sema.Fix64Scale,
+		literalType.Scale(),
 	)
 
-	if expression.Negative {
+	switch literalType.(type) {
+	case *sema.Fix64Type:
 		return Fix64Value(value.Int64())
-	} else {
+
+	case *sema.UFix64Type:
 		return UFix64Value(value.Uint64())
+
+	case *sema.Fix128Type:
+		return NewFix128ValueFromBigInt(value)
+
+	case *sema.UFix128Type:
+		return NewUFix128ValueFromBigInt(value)
+
+	default:
+		panic(errors.NewUnreachableError())
 	}
 }
 
@@ -320,6 +347,36 @@ func (interpreter *Interpreter) VisitStringExpression(expression *ast.StringExpr
 	return NewStringValue(expression.Value)
 }
 
+func (interpreter *Interpreter) VisitStringTemplateExpression(expression *ast.StringTemplateExpression) ast.Repr {
+	var builder strings.Builder
+
+	for i, value := range expression.Values {
+		builder.WriteString(value)
+
+		if i < len(expression.Expressions) {
+			interpolatedValue := interpreter.evalExpression(expression.Expressions[i])
+			builder.WriteString(stringTemplateValueString(interpolatedValue))
+		}
+	}
+
+	return NewStringValue(builder.String())
+}
+
+// stringTemplateValueString returns the textual representation of an interpolated value.
+//
+// Strings and characters are inserted as-is.
+// All other values (numbers, addresses, booleans, and paths)
+// are formatted like their `toString` function does
+//
+func stringTemplateValueString(value Value) string {
+	switch value := value.(type) {
+	case *StringValue:
+		return value.Str
+	default:
+		return value.String()
+	}
+}
+
 func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpression) ast.Repr {
 	values := interpreter.visitExpressionsNonCopying(expression.Values)
 
@@ -386,7 +443,15 @@ func (interpreter *Interpreter) VisitMemberExpression(expression *ast.MemberExpr
 	}
 
 	getLocationRange := locationRangeGetter(interpreter.Location, expression)
-	resultValue := interpreter.getMember(result, getLocationRange, expression.Identifier.Identifier)
+	identifier := expression.Identifier.Identifier
+
+	var resultValue Value
+	extensionType := interpreter.memberExtension(expression)
+	if extensionType != nil {
+		resultValue = interpreter.getExtensionMember(result, getLocationRange, extensionType, identifier)
+	} else {
+		resultValue = interpreter.getMember(result, getLocationRange, identifier)
+	}
 
 	// If the member access is optional chaining, only wrap the result value
 	// in an optional, if it is not already an optional value
@@ -408,11 +473,21 @@ func (interpreter *Interpreter) VisitIndexExpression(expression *ast.IndexExpres
 }
 
 func (interpreter *Interpreter) VisitConditionalExpression(expression *ast.ConditionalExpression) ast.Repr {
+	elaboration := interpreter.Program.Elaboration
+	resultType := elaboration.ConditionalExpressionResultTypes[expression]
+
+	// The result type is the common supertype of the branch types,
+	// so the result might have to be boxed, e.g. into an optional
+
 	value := interpreter.evalExpression(expression.Test).(BoolValue)
 	if value {
-		return interpreter.evalExpression(expression.Then)
+		thenValue := interpreter.evalExpression(expression.Then)
+		thenType := elaboration.ConditionalExpressionThenTypes[expression]
+		return interpreter.convertAndBox(thenValue, thenType, resultType)
 	} else {
-		return interpreter.evalExpression(expression.Else)
+		elseValue := interpreter.evalExpression(expression.Else)
+		elseType := elaboration.ConditionalExpressionElseTypes[expression]
+		return interpreter.convertAndBox(elseValue, elseType, resultType)
 	}
 }
 
@@ -454,8 +529,9 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 
 	arguments := interpreter.visitExpressionsNonCopying(argumentExpressions)
 
-	typeParameterTypes :=
-		interpreter.Program.Elaboration.InvocationExpressionTypeArguments[invocationExpression]
+	typeParameterTypes := interpreter.substituteTypeParameterTypes(
+		interpreter.Program.Elaboration.InvocationExpressionTypeArguments[invocationExpression],
+	)
 	argumentTypes :=
 		interpreter.Program.Elaboration.InvocationExpressionArgumentTypes[invocationExpression]
 	parameterTypes :=
@@ -542,6 +618,7 @@ func (interpreter *Interpreter) VisitFunctionExpression(expression *ast.Function
 		ParameterList:    expression.ParameterList,
 		Type:             functionType,
 		Activation:       lexicalScope,
+		TypeArguments:    interpreter.typeArguments,
 		BeforeStatements: beforeStatements,
 		PreConditions:    preConditions,
 		Statements:       statements,
@@ -552,7 +629,9 @@ func (interpreter *Interpreter) VisitFunctionExpression(expression *ast.Function
 func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingExpression) ast.Repr {
 	value := interpreter.evalExpression(expression.Expression)
 
-	expectedType := interpreter.Program.Elaboration.CastingTargetTypes[expression]
+	expectedType := interpreter.substituteTypeArguments(
+		interpreter.Program.Elaboration.CastingTargetTypes[expression],
+	)
 
 	switch expression.Operation {
 	case ast.OperationFailableCast, ast.OperationForceCast:
@@ -609,13 +688,14 @@ func (interpreter *Interpreter) VisitDestroyExpression(expression *ast.DestroyEx
 
 func (interpreter *Interpreter) VisitReferenceExpression(referenceExpression *ast.ReferenceExpression) ast.Repr {
 
-	authorized := referenceExpression.Type.(*ast.ReferenceType).Authorized
+	referenceType := interpreter.Program.Elaboration.ReferenceExpressionReferenceTypes[referenceExpression]
 
 	result := interpreter.evalExpression(referenceExpression.Expression)
 
 	return &EphemeralReferenceValue{
-		Authorized: authorized,
-		Value:      result,
+		Authorized:   referenceType.Authorized,
+		Entitlements: referenceType.Entitlements,
+		Value:        result,
 	}
 }
 
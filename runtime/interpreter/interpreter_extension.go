@@ -0,0 +1,179 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// ExtensionTypeCode contains the "prepared" / "callable" "code"
+// for the functions of an extension.
+//
+// The functions are bound to the extended composite value when they are accessed.
+//
+type ExtensionTypeCode struct {
+	ExtensionFunctions map[string]FunctionValue
+}
+
+func (interpreter *Interpreter) VisitExtensionDeclaration(declaration *ast.ExtensionDeclaration) ast.Repr {
+
+	// lexical scope: variables in functions are bound to what is visible at declaration time
+	lexicalScope := interpreter.activations.CurrentOrNew()
+
+	interpreter.declareExtension(declaration, lexicalScope)
+
+	return nil
+}
+
+func (interpreter *Interpreter) declareExtension(
+	declaration *ast.ExtensionDeclaration,
+	lexicalScope *VariableActivation,
+) {
+	extensionType := interpreter.Program.Elaboration.ExtensionDeclarationTypes[declaration]
+
+	functions := map[string]FunctionValue{}
+
+	for _, functionDeclaration := range declaration.Members.Functions() {
+		name := functionDeclaration.Identifier.Identifier
+		functions[name] =
+			interpreter.compositeFunction(
+				functionDeclaration,
+				lexicalScope,
+			)
+	}
+
+	interpreter.typeCodes.ExtensionCodes[extensionType.ID()] = ExtensionTypeCode{
+		ExtensionFunctions: functions,
+	}
+}
+
+// getExtensionMember returns the value of the given member of the given extension
+// for the given extended value.
+//
+// Fields of extensions which were never set are nil.
+//
+func (interpreter *Interpreter) getExtensionMember(
+	self Value,
+	getLocationRange func() LocationRange,
+	extensionType *sema.ExtensionType,
+	name string,
+) Value {
+	composite := extendedComposite(interpreter, self, getLocationRange)
+
+	member, ok := extensionType.Members.Get(name)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	switch member.DeclarationKind {
+	case common.DeclarationKindField:
+		value := composite.GetExtensionField(getLocationRange, extensionType.ID(), name)
+		if value == nil {
+			return NilValue{}
+		}
+		return value
+
+	case common.DeclarationKindFunction:
+		composite.checkStatus(getLocationRange)
+
+		extensionInterpreter := interpreter
+		if !common.LocationsMatch(interpreter.Location, extensionType.Location) {
+			extensionInterpreter = interpreter.ensureLoaded(
+				extensionType.Location,
+				func() Import {
+					return interpreter.importLocationHandler(interpreter, extensionType.Location)
+				},
+			)
+		}
+
+		code := extensionInterpreter.typeCodes.ExtensionCodes[extensionType.ID()]
+
+		return BoundFunctionValue{
+			Self:     composite,
+			Function: code.ExtensionFunctions[name],
+		}
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+// setExtensionMember sets the given field of the given extension
+// for the given extended value
+//
+func (interpreter *Interpreter) setExtensionMember(
+	self Value,
+	getLocationRange func() LocationRange,
+	extensionType *sema.ExtensionType,
+	name string,
+	value Value,
+) {
+	composite := extendedComposite(interpreter, self, getLocationRange)
+	composite.SetExtensionField(getLocationRange, extensionType, name, value)
+}
+
+// extendedComposite returns the composite value which is extended,
+// dereferencing the given value if it is a reference
+//
+func extendedComposite(
+	interpreter *Interpreter,
+	value Value,
+	getLocationRange func() LocationRange,
+) *CompositeValue {
+
+	var referencedValue *Value
+
+	switch value := value.(type) {
+	case *CompositeValue:
+		return value
+
+	case *EphemeralReferenceValue:
+		referencedValue = value.ReferencedValue()
+
+	case *StorageReferenceValue:
+		referencedValue = value.ReferencedValue(interpreter)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	if referencedValue == nil {
+		panic(DereferenceError{
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	return extendedComposite(interpreter, *referencedValue, getLocationRange)
+}
+
+// memberExtension returns the extension which declares the member
+// accessed in the given member expression, if any
+//
+func (interpreter *Interpreter) memberExtension(expression *ast.MemberExpression) *sema.ExtensionType {
+	memberInfo := interpreter.Program.Elaboration.MemberExpressionMemberInfos[expression]
+	if memberInfo.Member == nil {
+		return nil
+	}
+
+	extensionType, _ := memberInfo.Member.ContainerType.(*sema.ExtensionType)
+	return extensionType
+}
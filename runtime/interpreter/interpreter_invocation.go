@@ -99,9 +99,110 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 		interpreter.declareVariable(sema.SelfIdentifier, invocation.Self)
 	}
 
+	// Bind the type arguments of the function, if any.
+	// Like variables, type arguments are lexically scoped
+
+	previousTypeArguments := interpreter.typeArguments
+	interpreter.typeArguments = interpreter.functionTypeArguments(function, invocation)
+	defer func() {
+		interpreter.typeArguments = previousTypeArguments
+	}()
+
 	return interpreter.invokeInterpretedFunctionActivated(function, invocation.Arguments)
 }
 
+// functionTypeArguments returns the type arguments in effect in the body of the given function:
+// The type arguments of the enclosing generic functions and structures,
+// the type arguments of the invocation, and the type arguments of `self`, if any
+//
+func (interpreter *Interpreter) functionTypeArguments(
+	function InterpretedFunctionValue,
+	invocation Invocation,
+) *sema.TypeParameterTypeOrderedMap {
+
+	var selfTypeArguments []StaticType
+	if invocation.Self != nil {
+		selfTypeArguments = invocation.Self.TypeArguments
+	}
+
+	if function.TypeArguments == nil &&
+		len(function.Type.TypeParameters) == 0 &&
+		len(selfTypeArguments) == 0 {
+
+		return nil
+	}
+
+	typeArguments := sema.NewTypeParameterTypeOrderedMap()
+
+	if function.TypeArguments != nil {
+		function.TypeArguments.Foreach(func(typeParameter *sema.TypeParameter, typeArgument sema.Type) {
+			typeArguments.Set(typeParameter, typeArgument)
+		})
+	}
+
+	if invocation.TypeParameterTypes != nil {
+		for _, typeParameter := range function.Type.TypeParameters {
+			typeArgument, ok := invocation.TypeParameterTypes.Get(typeParameter)
+			if !ok {
+				continue
+			}
+			typeArguments.Set(typeParameter, typeArgument)
+		}
+	}
+
+	if len(selfTypeArguments) > 0 {
+		self := invocation.Self
+		compositeType := interpreter.getCompositeType(self.Location, self.QualifiedIdentifier)
+
+		for i, typeParameter := range compositeType.TypeParameters() {
+			typeArgument := interpreter.ConvertStaticToSemaType(selfTypeArguments[i])
+			typeArguments.Set(typeParameter, typeArgument)
+		}
+	}
+
+	return typeArguments
+}
+
+// substituteTypeArguments replaces the type parameters in the given type
+// with the type arguments currently in effect.
+//
+// The type is returned unchanged if it is not generic,
+// or if not all its type parameters are bound
+//
+func (interpreter *Interpreter) substituteTypeArguments(ty sema.Type) sema.Type {
+	if ty == nil || interpreter.typeArguments == nil {
+		return ty
+	}
+
+	resolvedType := ty.Resolve(interpreter.typeArguments)
+	if resolvedType == nil {
+		return ty
+	}
+
+	return resolvedType
+}
+
+// substituteTypeParameterTypes replaces the type parameters in the given type arguments
+// of an invocation with the type arguments currently in effect,
+// e.g. when a generic function invokes another generic function
+//
+func (interpreter *Interpreter) substituteTypeParameterTypes(
+	typeParameterTypes *sema.TypeParameterTypeOrderedMap,
+) *sema.TypeParameterTypeOrderedMap {
+
+	if typeParameterTypes == nil || interpreter.typeArguments == nil {
+		return typeParameterTypes
+	}
+
+	result := sema.NewTypeParameterTypeOrderedMap()
+
+	typeParameterTypes.Foreach(func(typeParameter *sema.TypeParameter, ty sema.Type) {
+		result.Set(typeParameter, interpreter.substituteTypeArguments(ty))
+	})
+
+	return result
+}
+
 // NOTE: assumes the function's activation (or an extension of it) is pushed!
 //
 func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
@@ -136,3 +237,33 @@ func (interpreter *Interpreter) bindParameterArguments(
 		interpreter.declareVariable(parameter.Identifier.Identifier, argument)
 	}
 }
+
+// ArgumentFunctionInvoker returns a function which invokes the function
+// that was passed as the argument with the given index to a host function,
+// e.g. the predicate passed to the `filter` function of an array.
+//
+// Like in an invocation expression, the arguments are copied
+//
+func ArgumentFunctionInvoker(invocation Invocation, argumentIndex int) func(arguments ...Value) Value {
+	function := invocation.Arguments[argumentIndex].(FunctionValue)
+	functionType := invocation.ArgumentTypes[argumentIndex].(*sema.FunctionType)
+
+	parameterTypes := make([]sema.Type, len(functionType.Parameters))
+	for i, parameter := range functionType.Parameters {
+		parameterTypes[i] = parameter.TypeAnnotation.Type
+	}
+
+	inter := invocation.Interpreter
+	locationRange := invocation.GetLocationRange()
+
+	return func(arguments ...Value) Value {
+		return inter.invokeFunctionValue(
+			function,
+			arguments,
+			parameterTypes,
+			parameterTypes,
+			nil,
+			locationRange,
+		)
+	}
+}
@@ -72,12 +72,34 @@ func (interpreter *Interpreter) VisitReturnStatement(statement *ast.ReturnStatem
 	return functionReturn{value}
 }
 
-func (interpreter *Interpreter) VisitBreakStatement(_ *ast.BreakStatement) ast.Repr {
-	return controlBreak{}
+func (interpreter *Interpreter) VisitBreakStatement(statement *ast.BreakStatement) ast.Repr {
+	return controlBreak{
+		Label: controlStatementLabel(statement.Label),
+	}
+}
+
+func (interpreter *Interpreter) VisitContinueStatement(statement *ast.ContinueStatement) ast.Repr {
+	return controlContinue{
+		Label: controlStatementLabel(statement.Label),
+	}
 }
 
-func (interpreter *Interpreter) VisitContinueStatement(_ *ast.ContinueStatement) ast.Repr {
-	return controlContinue{}
+// controlStatementLabel returns the name of the given optional label,
+// or the empty string if there is no label
+//
+func controlStatementLabel(label *ast.Identifier) string {
+	if label == nil {
+		return ""
+	}
+	return label.Identifier
+}
+
+// isLoopControl returns true if the given label of a `break` or `continue` statement
+// targets the loop with the given label, i.e. it is either unlabeled, or it has the loop's label
+//
+func isLoopControl(controlLabel string, loopLabel *ast.Identifier) bool {
+	return controlLabel == "" ||
+		controlLabel == controlStatementLabel(loopLabel)
 }
 
 func (interpreter *Interpreter) VisitIfStatement(statement *ast.IfStatement) ast.Repr {
@@ -157,7 +179,10 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 
 			result := block.Accept(interpreter)
 
-			if _, ok := result.(controlBreak); ok {
+			// Only an unlabeled break exits the switch statement.
+			// A labeled break exits the enclosing loop with the label
+
+			if result, ok := result.(controlBreak); ok && result.Label == "" {
 				return nil
 			}
 
@@ -205,12 +230,17 @@ func (interpreter *Interpreter) VisitWhileStatement(statement *ast.WhileStatemen
 
 		result := statement.Block.Accept(interpreter)
 
-		switch result.(type) {
+		switch control := result.(type) {
 		case controlBreak:
+			if !isLoopControl(control.Label, statement.Label) {
+				return result
+			}
 			return nil
 
 		case controlContinue:
-			// NO-OP
+			if !isLoopControl(control.Label, statement.Label) {
+				return result
+			}
 
 		case functionReturn:
 			return result
@@ -228,9 +258,10 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 		nil,
 	)
 
-	values := interpreter.evalExpression(statement.Value).(*ArrayValue).Values[:]
+	// executeBody runs the loop body for the given value
+	// and returns the result, and whether to continue with the next value
 
-	for _, value := range values {
+	executeBody := func(value Value) (ast.Repr, bool) {
 
 		interpreter.reportLoopIteration(statement)
 
@@ -238,16 +269,45 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 
 		result := statement.Block.Accept(interpreter)
 
-		switch result.(type) {
+		switch control := result.(type) {
 		case controlBreak:
-			return nil
+			if !isLoopControl(control.Label, statement.Label) {
+				return result, false
+			}
+			return nil, false
 
 		case controlContinue:
-			// NO-OP
+			if !isLoopControl(control.Label, statement.Label) {
+				return result, false
+			}
 
 		case functionReturn:
-			return result
+			return result, false
+		}
+
+		return nil, true
+	}
+
+	switch value := interpreter.evalExpression(statement.Value).(type) {
+	case *ArrayValue:
+		for _, element := range value.Values[:] {
+			result, resume := executeBody(element)
+			if !resume {
+				return result
+			}
 		}
+
+	case InclusiveRangeValue:
+		var result ast.Repr
+		value.ForEach(func(element IntegerValue) bool {
+			var resume bool
+			result, resume = executeBody(element)
+			return resume
+		})
+		return result
+
+	default:
+		panic(errors.NewUnreachableError())
 	}
 
 	return nil
@@ -276,6 +336,21 @@ func (interpreter *Interpreter) VisitPragmaDeclaration(_ *ast.PragmaDeclaration)
 	return nil
 }
 
+// VisitTypeAliasDeclaration is a no-op, as type aliases only exist statically:
+// uses of type aliases were already resolved to the aliased types by the checker
+//
+func (interpreter *Interpreter) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) ast.Repr {
+	return nil
+}
+
+// VisitEntitlementDeclaration is a no-op, as entitlements are only checked statically:
+// the checker ensures members requiring entitlements are only accessed
+// through references which grant them
+//
+func (interpreter *Interpreter) VisitEntitlementDeclaration(_ *ast.EntitlementDeclaration) ast.Repr {
+	return nil
+}
+
 // VisitVariableDeclaration first visits the declaration's value,
 // then declares the variable with the name bound to the value
 func (interpreter *Interpreter) VisitVariableDeclaration(declaration *ast.VariableDeclaration) ast.Repr {
@@ -0,0 +1,239 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/fixedpoint"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+var InvalidLiteralError = fmt.Errorf("invalid literal")
+var UnsupportedLiteralError = fmt.Errorf("unsupported literal")
+var LiteralExpressionTypeError = fmt.Errorf("input is not a literal")
+
+// ParseNumberOrAddressLiteral parses a single number or address literal string,
+// that should have the given number type or the address type.
+//
+// The literal has the same syntax and the same range checks as in programs,
+// but the string must consist of the literal only: whitespace and comments are rejected
+//
+func ParseNumberOrAddressLiteral(literal string, ty sema.Type) (Value, error) {
+	if !isNumberOrAddressLiteral(literal) {
+		return nil, LiteralExpressionTypeError
+	}
+
+	expression, errs := parser2.ParseExpression(literal)
+	if len(errs) > 0 {
+		return nil, parser2.Error{
+			Code:   literal,
+			Errors: errs,
+		}
+	}
+
+	switch {
+	case sema.IsSubType(ty, &sema.AddressType{}):
+		return AddressLiteralValue(expression)
+
+	case sema.IsSubType(ty, &sema.IntegerType{}):
+		return IntegerLiteralValue(expression, ty)
+
+	case sema.IsSubType(ty, &sema.FixedPointType{}):
+		return FixedPointLiteralValue(expression, ty)
+	}
+
+	return nil, UnsupportedLiteralError
+}
+
+// isNumberOrAddressLiteral returns true if the given string only consists of
+// an optional minus sign, followed by a decimal digit,
+// followed by digits, letters (base prefixes and hexadecimal digits),
+// underscores, and decimal points.
+//
+// This rejects any input that is not a single literal token,
+// e.g. input with whitespace, comments, or operators,
+// before it is passed to the parser
+//
+func isNumberOrAddressLiteral(literal string) bool {
+	if len(literal) > 0 && literal[0] == '-' {
+		literal = literal[1:]
+	}
+
+	if len(literal) == 0 || literal[0] < '0' || literal[0] > '9' {
+		return false
+	}
+
+	for _, r := range literal[1:] {
+		switch {
+		case r >= '0' && r <= '9',
+			r >= 'a' && r <= 'z',
+			r >= 'A' && r <= 'Z',
+			r == '_',
+			r == '.':
+			continue
+		}
+		return false
+	}
+
+	return true
+}
+
+// AddressLiteralValue returns the address value of the given address literal expression
+//
+func AddressLiteralValue(expression ast.Expression) (AddressValue, error) {
+	integerExpression, ok := expression.(*ast.IntegerExpression)
+	if !ok {
+		return AddressValue{}, LiteralExpressionTypeError
+	}
+
+	if !sema.CheckAddressLiteral(integerExpression, nil) {
+		return AddressValue{}, InvalidLiteralError
+	}
+
+	return NewAddressValueFromBytes(integerExpression.Value.Bytes()), nil
+}
+
+// IntegerLiteralValue returns the value of the given integer literal expression,
+// which should have the given integer type
+//
+func IntegerLiteralValue(expression ast.Expression, ty sema.Type) (Value, error) {
+	integerExpression, ok := expression.(*ast.IntegerExpression)
+	if !ok {
+		return nil, LiteralExpressionTypeError
+	}
+
+	if !sema.CheckIntegerLiteral(integerExpression, ty, nil) {
+		return nil, InvalidLiteralError
+	}
+
+	intValue := NewIntValueFromBigInt(integerExpression.Value)
+
+	switch ty.(type) {
+	case *sema.IntType, *sema.IntegerType, *sema.SignedIntegerType:
+		return intValue, nil
+	case *sema.Int8Type:
+		return ConvertInt8(intValue), nil
+	case *sema.Int16Type:
+		return ConvertInt16(intValue), nil
+	case *sema.Int32Type:
+		return ConvertInt32(intValue), nil
+	case *sema.Int64Type:
+		return ConvertInt64(intValue), nil
+	case *sema.Int128Type:
+		return ConvertInt128(intValue), nil
+	case *sema.Int256Type:
+		return ConvertInt256(intValue), nil
+
+	case *sema.UIntType:
+		return ConvertUInt(intValue), nil
+	case *sema.UInt8Type:
+		return ConvertUInt8(intValue), nil
+	case *sema.UInt16Type:
+		return ConvertUInt16(intValue), nil
+	case *sema.UInt32Type:
+		return ConvertUInt32(intValue), nil
+	case *sema.UInt64Type:
+		return ConvertUInt64(intValue), nil
+	case *sema.UInt128Type:
+		return ConvertUInt128(intValue), nil
+	case *sema.UInt256Type:
+		return ConvertUInt256(intValue), nil
+
+	case *sema.Word8Type:
+		return ConvertWord8(intValue), nil
+	case *sema.Word16Type:
+		return ConvertWord16(intValue), nil
+	case *sema.Word32Type:
+		return ConvertWord32(intValue), nil
+	case *sema.Word64Type:
+		return ConvertWord64(intValue), nil
+
+	default:
+		return nil, UnsupportedLiteralError
+	}
+}
+
+// FixedPointLiteralValue returns the value of the given fixed-point literal expression,
+// which should have the given fixed-point type
+//
+func FixedPointLiteralValue(expression ast.Expression, ty sema.Type) (Value, error) {
+	fixedPointExpression, ok := expression.(*ast.FixedPointExpression)
+	if !ok {
+		return nil, LiteralExpressionTypeError
+	}
+
+	if !sema.CheckFixedPointLiteral(fixedPointExpression, ty, nil) {
+		return nil, InvalidLiteralError
+	}
+
+	scale := sema.Fix64Scale
+	switch ty.(type) {
+	case *sema.Fix128Type, *sema.UFix128Type:
+		scale = sema.Fix128Scale
+	}
+
+	value := fixedpoint.ConvertToFixedPointBigInt(
+		fixedPointExpression.Negative,
+		fixedPointExpression.UnsignedInteger,
+		fixedPointExpression.Fractional,
+		fixedPointExpression.Scale,
+		scale,
+	)
+
+	switch ty.(type) {
+	case *sema.Fix64Type, *sema.FixedPointType, *sema.SignedFixedPointType:
+		return Fix64Value(value.Int64()), nil
+	case *sema.UFix64Type:
+		return UFix64Value(value.Uint64()), nil
+	case *sema.Fix128Type:
+		return NewFix128ValueFromBigInt(value), nil
+	case *sema.UFix128Type:
+		return NewUFix128ValueFromBigInt(value), nil
+	}
+
+	return nil, UnsupportedLiteralError
+}
+
+// fromStringFunction returns the static function `fromString` of the conversion function
+// of the given number type or the address type.
+//
+// The function parses the string argument as a literal of the type,
+// and returns nil if the string is not a valid literal of the type.
+//
+// Parsing is metered on the length of the string
+//
+func fromStringFunction(ty sema.Type) HostFunctionValue {
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			input := invocation.Arguments[0].(*StringValue)
+
+			invocation.Interpreter.reportComputation(len(input.Str))
+
+			value, err := ParseNumberOrAddressLiteral(input.Str, ty)
+			if err != nil {
+				return NilValue{}
+			}
+
+			return NewSomeValueOwningNonCopying(value)
+		},
+	)
+}
@@ -121,7 +121,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	PrimitiveStaticTypeFix64
-	_ // future: Fix128
+	PrimitiveStaticTypeFix128
 	_ // future: Fix256
 	_
 
@@ -131,7 +131,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	PrimitiveStaticTypeUFix64
-	_ // future: UFix128
+	PrimitiveStaticTypeUFix128
 	_ // future: UFix256
 	_
 
@@ -260,10 +260,14 @@ func (i PrimitiveStaticType) SemaType() sema.Type {
 	// Fix*
 	case PrimitiveStaticTypeFix64:
 		return &sema.Fix64Type{}
+	case PrimitiveStaticTypeFix128:
+		return &sema.Fix128Type{}
 
 	// UFix*
 	case PrimitiveStaticTypeUFix64:
 		return &sema.UFix64Type{}
+	case PrimitiveStaticTypeUFix128:
+		return &sema.UFix128Type{}
 
 	// Storage
 
@@ -366,10 +370,14 @@ func ConvertSemaToPrimitiveStaticType(t sema.Type) PrimitiveStaticType {
 	// Fix*
 	case *sema.Fix64Type:
 		return PrimitiveStaticTypeFix64
+	case *sema.Fix128Type:
+		return PrimitiveStaticTypeFix128
 
 	// UFix*
 	case *sema.UFix64Type:
 		return PrimitiveStaticTypeUFix64
+	case *sema.UFix128Type:
+		return PrimitiveStaticTypeUFix128
 
 	// Storage
 
@@ -56,6 +56,23 @@ func (t CompositeStaticType) String() string {
 	)
 }
 
+// InstantiatedStaticType
+
+type InstantiatedStaticType struct {
+	Type          CompositeStaticType
+	TypeArguments []StaticType
+}
+
+func (InstantiatedStaticType) IsStaticType() {}
+
+func (t InstantiatedStaticType) String() string {
+	return fmt.Sprintf(
+		"InstantiatedStaticType(Type: %s, TypeArguments: %s)",
+		t.Type,
+		t.TypeArguments,
+	)
+}
+
 // InterfaceStaticType
 
 type InterfaceStaticType struct {
@@ -150,8 +167,9 @@ func (t *RestrictedStaticType) String() string {
 // ReferenceStaticType
 
 type ReferenceStaticType struct {
-	Authorized bool
-	Type       StaticType
+	Authorized   bool
+	Entitlements []EntitlementStaticType
+	Type         StaticType
 }
 
 func (ReferenceStaticType) IsStaticType() {}
@@ -160,11 +178,35 @@ func (t ReferenceStaticType) String() string {
 	auth := ""
 	if t.Authorized {
 		auth = "auth "
+	} else if len(t.Entitlements) > 0 {
+		entitlements := make([]string, len(t.Entitlements))
+		for i, entitlement := range t.Entitlements {
+			entitlements[i] = entitlement.String()
+		}
+		auth = fmt.Sprintf("auth(%s) ", strings.Join(entitlements, ", "))
 	}
 
 	return fmt.Sprintf("%s&%s", auth, t.Type)
 }
 
+// EntitlementStaticType
+//
+// Entitlements are not types which values can have,
+// they only occur in reference static types
+//
+type EntitlementStaticType struct {
+	Location            common.Location
+	QualifiedIdentifier string
+}
+
+func (t EntitlementStaticType) String() string {
+	return fmt.Sprintf(
+		"EntitlementStaticType(Location: %s, QualifiedIdentifier: %s)",
+		t.Location,
+		t.QualifiedIdentifier,
+	)
+}
+
 // CapabilityStaticType
 
 type CapabilityStaticType struct {
@@ -180,6 +222,21 @@ func (t CapabilityStaticType) String() string {
 	return "Capability"
 }
 
+// InclusiveRangeStaticType
+
+type InclusiveRangeStaticType struct {
+	ElementType StaticType
+}
+
+func (InclusiveRangeStaticType) IsStaticType() {}
+
+func (t InclusiveRangeStaticType) String() string {
+	if t.ElementType != nil {
+		return fmt.Sprintf("InclusiveRange<%s>", t.ElementType)
+	}
+	return "InclusiveRange"
+}
+
 // Conversion
 
 func ConvertSemaToStaticType(t sema.Type) StaticType {
@@ -190,6 +247,25 @@ func ConvertSemaToStaticType(t sema.Type) StaticType {
 			QualifiedIdentifier: t.QualifiedIdentifier(),
 		}
 
+	case *sema.InstantiatedType:
+		typeArguments := make([]StaticType, len(t.Arguments))
+
+		for i, typeArgument := range t.Arguments {
+			staticTypeArgument := ConvertSemaToStaticType(typeArgument)
+			if staticTypeArgument == nil {
+				return nil
+			}
+			typeArguments[i] = staticTypeArgument
+		}
+
+		return InstantiatedStaticType{
+			Type: CompositeStaticType{
+				Location:            t.Type.Location,
+				QualifiedIdentifier: t.Type.QualifiedIdentifier(),
+			},
+			TypeArguments: typeArguments,
+		}
+
 	case *sema.InterfaceType:
 		return convertToInterfaceStaticType(t)
 
@@ -236,6 +312,13 @@ func ConvertSemaToStaticType(t sema.Type) StaticType {
 			result.BorrowType = ConvertSemaToStaticType(t.BorrowType)
 		}
 		return result
+
+	case *sema.InclusiveRangeType:
+		result := InclusiveRangeStaticType{}
+		if t.MemberType != nil {
+			result.ElementType = ConvertSemaToStaticType(t.MemberType)
+		}
+		return result
 	}
 
 	primitiveStaticType := ConvertSemaToPrimitiveStaticType(t)
@@ -246,9 +329,21 @@ func ConvertSemaToStaticType(t sema.Type) StaticType {
 }
 
 func convertSemaReferenceToStaticReferenceType(t *sema.ReferenceType) ReferenceStaticType {
+	var entitlements []EntitlementStaticType
+	for _, entitlement := range t.Entitlements {
+		entitlements = append(
+			entitlements,
+			EntitlementStaticType{
+				Location:            entitlement.Location,
+				QualifiedIdentifier: entitlement.QualifiedIdentifier(),
+			},
+		)
+	}
+
 	return ReferenceStaticType{
-		Authorized: t.Authorized,
-		Type:       ConvertSemaToStaticType(t.Type),
+		Authorized:   t.Authorized,
+		Entitlements: entitlements,
+		Type:         ConvertSemaToStaticType(t.Type),
 	}
 }
 
@@ -263,34 +358,47 @@ func ConvertStaticToSemaType(
 	typ StaticType,
 	getInterface func(location common.Location, qualifiedIdentifier string) *sema.InterfaceType,
 	getComposite func(location common.Location, qualifiedIdentifier string) *sema.CompositeType,
+	getEntitlement func(location common.Location, qualifiedIdentifier string) *sema.EntitlementType,
 ) sema.Type {
 	switch t := typ.(type) {
 	case CompositeStaticType:
 		return getComposite(t.Location, t.QualifiedIdentifier)
 
+	case InstantiatedStaticType:
+		typeArguments := make([]sema.Type, len(t.TypeArguments))
+
+		for i, typeArgument := range t.TypeArguments {
+			typeArguments[i] = ConvertStaticToSemaType(typeArgument, getInterface, getComposite, getEntitlement)
+		}
+
+		return &sema.InstantiatedType{
+			Type:      getComposite(t.Type.Location, t.Type.QualifiedIdentifier),
+			Arguments: typeArguments,
+		}
+
 	case InterfaceStaticType:
 		return getInterface(t.Location, t.QualifiedIdentifier)
 
 	case VariableSizedStaticType:
 		return &sema.VariableSizedType{
-			Type: ConvertStaticToSemaType(t.Type, getInterface, getComposite),
+			Type: ConvertStaticToSemaType(t.Type, getInterface, getComposite, getEntitlement),
 		}
 
 	case ConstantSizedStaticType:
 		return &sema.ConstantSizedType{
-			Type: ConvertStaticToSemaType(t.Type, getInterface, getComposite),
+			Type: ConvertStaticToSemaType(t.Type, getInterface, getComposite, getEntitlement),
 			Size: t.Size,
 		}
 
 	case DictionaryStaticType:
 		return &sema.DictionaryType{
-			KeyType:   ConvertStaticToSemaType(t.KeyType, getInterface, getComposite),
-			ValueType: ConvertStaticToSemaType(t.ValueType, getInterface, getComposite),
+			KeyType:   ConvertStaticToSemaType(t.KeyType, getInterface, getComposite, getEntitlement),
+			ValueType: ConvertStaticToSemaType(t.ValueType, getInterface, getComposite, getEntitlement),
 		}
 
 	case OptionalStaticType:
 		return &sema.OptionalType{
-			Type: ConvertStaticToSemaType(t.Type, getInterface, getComposite),
+			Type: ConvertStaticToSemaType(t.Type, getInterface, getComposite, getEntitlement),
 		}
 
 	case *RestrictedStaticType:
@@ -301,26 +409,45 @@ func ConvertStaticToSemaType(
 		}
 
 		return &sema.RestrictedType{
-			Type:         ConvertStaticToSemaType(t.Type, getInterface, getComposite),
+			Type:         ConvertStaticToSemaType(t.Type, getInterface, getComposite, getEntitlement),
 			Restrictions: restrictions,
 		}
 
 	case ReferenceStaticType:
+		var entitlements []*sema.EntitlementType
+		for _, entitlement := range t.Entitlements {
+			entitlements = append(
+				entitlements,
+				getEntitlement(entitlement.Location, entitlement.QualifiedIdentifier),
+			)
+		}
+
 		return &sema.ReferenceType{
-			Authorized: t.Authorized,
-			Type:       ConvertStaticToSemaType(t.Type, getInterface, getComposite),
+			Authorized:   t.Authorized,
+			Entitlements: entitlements,
+			Type:         ConvertStaticToSemaType(t.Type, getInterface, getComposite, getEntitlement),
 		}
 
 	case CapabilityStaticType:
 		var borrowType sema.Type
 		if t.BorrowType != nil {
-			borrowType = ConvertStaticToSemaType(t.BorrowType, getInterface, getComposite)
+			borrowType = ConvertStaticToSemaType(t.BorrowType, getInterface, getComposite, getEntitlement)
 		}
 
 		return &sema.CapabilityType{
 			BorrowType: borrowType,
 		}
 
+	case InclusiveRangeStaticType:
+		var memberType sema.Type
+		if t.ElementType != nil {
+			memberType = ConvertStaticToSemaType(t.ElementType, getInterface, getComposite, getEntitlement)
+		}
+
+		return &sema.InclusiveRangeType{
+			MemberType: memberType,
+		}
+
 	case PrimitiveStaticType:
 		return t.SemaType()
 
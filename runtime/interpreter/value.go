@@ -24,8 +24,10 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/rivo/uniseg"
 	"golang.org/x/text/unicode/norm"
@@ -417,13 +419,17 @@ func (v *StringValue) SetIndex(index int, char *StringValue) {
 	v.Str = sb.String()
 }
 
-func (v *StringValue) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+func (v *StringValue) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
-	case "length":
+	case sema.StringTypeLengthFieldName:
 		count := v.Length()
 		return NewIntValueFromInt64(int64(count))
 
-	case "concat":
+	case sema.StringTypeUTF8FieldName:
+		interpreter.reportComputation(len(v.Str))
+		return ByteSliceToByteArrayValue([]byte(v.Str))
+
+	case sema.StringTypeConcatFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
 				otherValue := invocation.Arguments[0].(ConcatenatableValue)
@@ -431,7 +437,7 @@ func (v *StringValue) GetMember(_ *Interpreter, _ func() LocationRange, name str
 			},
 		)
 
-	case "slice":
+	case sema.StringTypeSliceFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
 				from := invocation.Arguments[0].(IntValue)
@@ -440,17 +446,223 @@ func (v *StringValue) GetMember(_ *Interpreter, _ func() LocationRange, name str
 			},
 		)
 
-	case "decodeHex":
+	case sema.StringTypeDecodeHexFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
 				return v.DecodeHex()
 			},
 		)
+
+	case sema.StringTypeSplitFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				separator := invocation.Arguments[0].(*StringValue)
+				invocation.Interpreter.reportComputation(len(v.Str) + len(separator.Str))
+				return v.Split(separator)
+			},
+		)
+
+	case sema.StringTypeContainsFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				other := invocation.Arguments[0].(*StringValue)
+				invocation.Interpreter.reportComputation(len(v.Str) + len(other.Str))
+				return BoolValue(v.IndexOf(other) >= 0)
+			},
+		)
+
+	case sema.StringTypeIndexFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				other := invocation.Arguments[0].(*StringValue)
+				invocation.Interpreter.reportComputation(len(v.Str) + len(other.Str))
+
+				index := v.IndexOf(other)
+				if index < 0 {
+					return NilValue{}
+				}
+
+				return NewSomeValueOwningNonCopying(
+					NewIntValueFromInt64(int64(index)),
+				)
+			},
+		)
+
+	case sema.StringTypeReplaceAllFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				original := invocation.Arguments[0].(*StringValue)
+				replacement := invocation.Arguments[1].(*StringValue)
+
+				// Meter the size of the result, which grows with the number of matches,
+				// before it is built. An empty original string matches at every character boundary
+
+				matches, _ := v.characterMatches(original.Str, -1)
+				invocation.Interpreter.reportComputation(
+					len(v.Str) + len(original.Str) + len(matches)*len(replacement.Str),
+				)
+
+				return v.replaceMatches(matches, replacement)
+			},
+		)
+
+	case sema.StringTypeToLowerFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				invocation.Interpreter.reportComputation(len(v.Str))
+				return NewStringValue(strings.ToLower(v.Str))
+			},
+		)
+
+	case sema.StringTypeToUpperFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				invocation.Interpreter.reportComputation(len(v.Str))
+				return NewStringValue(strings.ToUpper(v.Str))
+			},
+		)
 	}
 
 	return nil
 }
 
+// characterBoundaries returns the byte offsets of the boundaries of the characters
+// (grapheme clusters) of the string, including the start and the end of the string
+//
+func (v *StringValue) characterBoundaries() []int {
+	boundaries := []int{0}
+
+	graphemes := uniseg.NewGraphemes(v.Str)
+	for graphemes.Next() {
+		_, end := graphemes.Positions()
+		boundaries = append(boundaries, end)
+	}
+
+	return boundaries
+}
+
+// characterMatches returns the byte ranges of the non-overlapping occurrences
+// of the given string in the string, and the character boundaries of the string.
+//
+// Only occurrences which start and end at character boundaries match,
+// e.g. "e" does not match the "é" in "café" if it is written using a combining accent.
+//
+// If limit is not negative, at most limit matches are returned
+//
+func (v *StringValue) characterMatches(other string, limit int) (matches [][2]int, boundaries []int) {
+	boundaries = v.characterBoundaries()
+
+	if limit == 0 {
+		return nil, boundaries
+	}
+
+	// An empty string matches at every character boundary
+
+	if len(other) == 0 {
+		for _, boundary := range boundaries {
+			matches = append(matches, [2]int{boundary, boundary})
+			if len(matches) == limit {
+				break
+			}
+		}
+		return matches, boundaries
+	}
+
+	isBoundary := func(offset int) bool {
+		index := sort.SearchInts(boundaries, offset)
+		return index < len(boundaries) && boundaries[index] == offset
+	}
+
+	str := v.Str
+	offset := 0
+
+	for limit < 0 || len(matches) < limit {
+		index := strings.Index(str[offset:], other)
+		if index < 0 {
+			break
+		}
+
+		start := offset + index
+		end := start + len(other)
+
+		if isBoundary(start) && isBoundary(end) {
+			matches = append(matches, [2]int{start, end})
+			offset = end
+		} else {
+			offset = start + 1
+		}
+	}
+
+	return matches, boundaries
+}
+
+// Split returns the substrings of the string which are separated by the given separator.
+// If the separator is empty, the string is split into its characters
+//
+func (v *StringValue) Split(separator *StringValue) *ArrayValue {
+	str := v.Str
+
+	var values []Value
+
+	if len(separator.Str) == 0 {
+		graphemes := uniseg.NewGraphemes(str)
+		for graphemes.Next() {
+			values = append(values, NewStringValue(graphemes.Str()))
+		}
+
+		return NewArrayValueUnownedNonCopying(values...)
+	}
+
+	matches, _ := v.characterMatches(separator.Str, -1)
+
+	start := 0
+	for _, match := range matches {
+		values = append(values, NewStringValue(str[start:match[0]]))
+		start = match[1]
+	}
+	values = append(values, NewStringValue(str[start:]))
+
+	return NewArrayValueUnownedNonCopying(values...)
+}
+
+// IndexOf returns the character index of the first occurrence of the given string,
+// or -1 if the string does not contain the given string
+//
+func (v *StringValue) IndexOf(other *StringValue) int {
+	matches, boundaries := v.characterMatches(other.Str, 1)
+	if len(matches) == 0 {
+		return -1
+	}
+
+	return sort.SearchInts(boundaries, matches[0][0])
+}
+
+// ReplaceAll returns a new string in which all occurrences of the given original string
+// are replaced with the given replacement string
+//
+func (v *StringValue) ReplaceAll(original *StringValue, replacement *StringValue) *StringValue {
+	matches, _ := v.characterMatches(original.Str, -1)
+	return v.replaceMatches(matches, replacement)
+}
+
+// replaceMatches returns a new string where the given matches are replaced with the given replacement
+//
+func (v *StringValue) replaceMatches(matches [][2]int, replacement *StringValue) *StringValue {
+	str := v.Str
+
+	var sb strings.Builder
+
+	start := 0
+	for _, match := range matches {
+		sb.WriteString(str[start:match[0]])
+		sb.WriteString(replacement.Str)
+		start = match[1]
+	}
+	sb.WriteString(str[start:])
+
+	return NewStringValue(sb.String())
+}
+
 // Length returns the number of characters (grapheme clusters)
 //
 func (v *StringValue) Length() int {
@@ -479,6 +691,92 @@ func (*StringValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string,
 	panic(errors.NewUnreachableError())
 }
 
+// stringFunction is the value of the base function `String`,
+// which returns an empty string, and which has the static string functions as members
+//
+var stringFunction = func() HostFunctionValue {
+
+	function := NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			return NewStringValue("")
+		},
+	)
+
+	function.NestedVariables = NewStringVariableOrderedMap()
+
+	function.NestedVariables.Set(
+		sema.StringFunctionJoinFunctionName,
+		NewVariableWithValue(
+			NewHostFunctionValue(
+				func(invocation Invocation) Value {
+					array := invocation.Arguments[0].(*ArrayValue)
+					separator := invocation.Arguments[1].(*StringValue)
+
+					strs := make([]string, len(array.Values))
+					length := 0
+					for i, value := range array.Values {
+						str := value.(*StringValue).Str
+						strs[i] = str
+						length += len(str) + len(separator.Str)
+					}
+
+					invocation.Interpreter.reportComputation(length)
+
+					return NewStringValue(strings.Join(strs, separator.Str))
+				},
+			),
+		),
+	)
+
+	function.NestedVariables.Set(
+		sema.StringFunctionFromUTF8FunctionName,
+		NewVariableWithValue(
+			NewHostFunctionValue(
+				func(invocation Invocation) Value {
+					argument := invocation.Arguments[0]
+
+					invocation.Interpreter.reportComputation(len(argument.(*ArrayValue).Values))
+
+					bytes, err := ByteArrayValueToByteSlice(argument)
+					if err != nil {
+						panic(err)
+					}
+
+					if !utf8.Valid(bytes) {
+						return NilValue{}
+					}
+
+					return NewSomeValueOwningNonCopying(
+						NewStringValue(string(bytes)),
+					)
+				},
+			),
+		),
+	)
+
+	function.NestedVariables.Set(
+		sema.StringFunctionEncodeHexFunctionName,
+		NewVariableWithValue(
+			NewHostFunctionValue(
+				func(invocation Invocation) Value {
+					argument := invocation.Arguments[0]
+
+					invocation.Interpreter.reportComputation(len(argument.(*ArrayValue).Values))
+
+					bytes, err := ByteArrayValueToByteSlice(argument)
+					if err != nil {
+						panic(err)
+					}
+
+					return NewStringValue(hex.EncodeToString(bytes))
+				},
+			),
+		),
+	)
+
+	return function
+}()
+
 // ArrayValue
 
 type ArrayValue struct {
@@ -747,11 +1045,229 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 			},
 		)
 
+	case sema.ArrayTypeFilterFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				predicate := ArgumentFunctionInvoker(invocation, 0)
+				reportIteration := ElementIterationReporter(invocation)
+
+				return v.Filter(func(element Value) bool {
+					reportIteration()
+					return bool(predicate(element).(BoolValue))
+				})
+			},
+		)
+
+	case sema.ArrayTypeMapFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				transform := ArgumentFunctionInvoker(invocation, 0)
+				reportIteration := ElementIterationReporter(invocation)
+
+				return v.Map(func(element Value) Value {
+					reportIteration()
+					return transform(element)
+				})
+			},
+		)
+
+	case sema.ArrayTypeReduceFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				initial := invocation.Arguments[0]
+				combine := ArgumentFunctionInvoker(invocation, 1)
+				reportIteration := ElementIterationReporter(invocation)
+
+				return v.Reduce(initial, func(accumulator Value, element Value) Value {
+					reportIteration()
+					return combine(accumulator, element)
+				})
+			},
+		)
+
+	case sema.ArrayTypeFirstIndexFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				reportIteration := ElementIterationReporter(invocation)
+
+				index := v.FirstIndex(invocation.Arguments[0], reportIteration)
+				if index < 0 {
+					return NilValue{}
+				}
+
+				return NewSomeValueOwningNonCopying(
+					NewIntValueFromInt64(int64(index)),
+				)
+			},
+		)
+
+	case sema.ArrayTypeReverseFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				reportIteration := ElementIterationReporter(invocation)
+				for range v.Values {
+					reportIteration()
+				}
+
+				return v.Reverse()
+			},
+		)
+
+	case sema.ArrayTypeSliceFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				from := invocation.Arguments[0].(IntValue).ToInt()
+				upTo := invocation.Arguments[1].(IntValue).ToInt()
+
+				count := v.Count()
+				if from < 0 || upTo > count || from > upTo {
+					panic(ArraySliceIndicesError{
+						FromIndex:     from,
+						UpToIndex:     upTo,
+						Length:        count,
+						LocationRange: invocation.GetLocationRange(),
+					})
+				}
+
+				reportIteration := ElementIterationReporter(invocation)
+				for i := from; i < upTo; i++ {
+					reportIteration()
+				}
+
+				return v.Slice(from, upTo)
+			},
+		)
+
+	case sema.ArrayTypeSortFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				less := ArgumentFunctionInvoker(invocation, 0)
+				reportIteration := ElementIterationReporter(invocation)
+
+				v.Sort(func(a, b Value) bool {
+					reportIteration()
+					return bool(less(a, b).(BoolValue))
+				})
+
+				return VoidValue{}
+			},
+		)
 	}
 
 	return nil
 }
 
+// ElementIterationReporter returns a function which reports an iteration
+// over an element of a collection in the given host function invocation,
+// so that built-in functions are metered per element, like loops
+//
+func ElementIterationReporter(invocation Invocation) func() {
+	inter := invocation.Interpreter
+	locationRange := invocation.GetLocationRange()
+
+	return func() {
+		inter.reportLoopIteration(locationRange)
+	}
+}
+
+// Filter returns a new array which contains copies of the elements
+// for which the given predicate returns true
+//
+func (v *ArrayValue) Filter(predicate func(element Value) bool) *ArrayValue {
+	var values []Value
+
+	for _, element := range v.Values {
+		if predicate(element) {
+			values = append(values, element.Copy())
+		}
+	}
+
+	return NewArrayValueUnownedNonCopying(values...)
+}
+
+// Map returns a new array which contains the results of calling
+// the given function with each element
+//
+func (v *ArrayValue) Map(transform func(element Value) Value) *ArrayValue {
+	values := make([]Value, len(v.Values))
+
+	for i, element := range v.Values {
+		values[i] = transform(element)
+	}
+
+	return NewArrayValueUnownedNonCopying(values...)
+}
+
+// Reduce returns the result of combining the elements with the given function,
+// starting with the given initial value
+//
+func (v *ArrayValue) Reduce(initial Value, combine func(accumulator Value, element Value) Value) Value {
+	result := initial
+
+	for _, element := range v.Values {
+		result = combine(result, element)
+	}
+
+	return result
+}
+
+// FirstIndex returns the index of the first element which is equal to the given value,
+// or -1 if the array does not contain the value.
+// The given function is called for each compared element
+//
+func (v *ArrayValue) FirstIndex(needleValue Value, onElement func()) int {
+	needleEquatable := needleValue.(EquatableValue)
+
+	for i, arrayValue := range v.Values {
+		onElement()
+
+		if needleEquatable.Equal(nil, arrayValue) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Reverse returns a new array which contains copies of the elements in reverse order
+//
+func (v *ArrayValue) Reverse() *ArrayValue {
+	count := len(v.Values)
+	values := make([]Value, count)
+
+	for i, element := range v.Values {
+		values[count-1-i] = element.Copy()
+	}
+
+	return NewArrayValueUnownedNonCopying(values...)
+}
+
+// Slice returns a new array which contains copies of the elements
+// from the given start index up to, but not including, the given end index.
+//
+// The indices must be valid
+//
+func (v *ArrayValue) Slice(from int, upTo int) *ArrayValue {
+	values := make([]Value, upTo-from)
+
+	for i, element := range v.Values[from:upTo] {
+		values[i] = element.Copy()
+	}
+
+	return NewArrayValueUnownedNonCopying(values...)
+}
+
+// Sort sorts the elements in place, using the given function to compare elements.
+// The sort is stable
+//
+func (v *ArrayValue) Sort(less func(a, b Value) bool) {
+	v.modified = true
+
+	sort.SliceStable(v.Values, func(i, j int) bool {
+		return less(v.Values[i], v.Values[j])
+	})
+}
+
 func (v *ArrayValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	panic(errors.NewUnreachableError())
 }
@@ -787,6 +1303,46 @@ type IntegerValue interface {
 	BitwiseRightShift(other IntegerValue) IntegerValue
 }
 
+// SaturatingArithmeticNumberValue.
+// Implemented by values of fixed-size integer types and fixed-point types,
+// which support arithmetic that saturates at the bounds of the type,
+// instead of aborting on overflow and underflow
+
+type SaturatingArithmeticNumberValue interface {
+	NumberValue
+	SaturatingPlus(other NumberValue) NumberValue
+	SaturatingMinus(other NumberValue) NumberValue
+	SaturatingMul(other NumberValue) NumberValue
+	SaturatingDiv(other NumberValue) NumberValue
+}
+
+// getSaturatingArithmeticMember returns the saturating arithmetic function
+// with the given name of the given value, or nil if there is no such function
+//
+func getSaturatingArithmeticMember(v SaturatingArithmeticNumberValue, name string) Value {
+	var operation func(other NumberValue) NumberValue
+
+	switch name {
+	case sema.NumericTypeSaturatingAddFunctionName:
+		operation = v.SaturatingPlus
+	case sema.NumericTypeSaturatingSubtractFunctionName:
+		operation = v.SaturatingMinus
+	case sema.NumericTypeSaturatingMultiplyFunctionName:
+		operation = v.SaturatingMul
+	case sema.NumericTypeSaturatingDivideFunctionName:
+		operation = v.SaturatingDiv
+	default:
+		return nil
+	}
+
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			other := invocation.Arguments[0].(NumberValue)
+			return operation(other)
+		},
+	)
+}
+
 // BigNumberValue.
 // Implemented by values with an integer value outside the range of int64
 
@@ -1156,12 +1712,73 @@ func (v Int8Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
-func (v Int8Value) Less(other NumberValue) BoolValue {
-	return v < other.(Int8Value)
-}
-
-func (v Int8Value) LessEqual(other NumberValue) BoolValue {
-	return v <= other.(Int8Value)
+func (v Int8Value) SaturatingPlus(other NumberValue) NumberValue {
+	o := other.(Int8Value)
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt8 - o)) {
+		return Int8Value(math.MaxInt8)
+	} else if (o < 0) && (v < (math.MinInt8 - o)) {
+		return Int8Value(math.MinInt8)
+	}
+	return v + o
+}
+
+func (v Int8Value) SaturatingMinus(other NumberValue) NumberValue {
+	o := other.(Int8Value)
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt8 + o)) {
+		return Int8Value(math.MinInt8)
+	} else if (o < 0) && (v > (math.MaxInt8 + o)) {
+		return Int8Value(math.MaxInt8)
+	}
+	return v - o
+}
+
+func (v Int8Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(Int8Value)
+	// INT32-C
+	if v > 0 {
+		if o > 0 {
+			if v > (math.MaxInt8 / o) {
+				return Int8Value(math.MaxInt8)
+			}
+		} else {
+			if o < (math.MinInt8 / v) {
+				return Int8Value(math.MinInt8)
+			}
+		}
+	} else {
+		if o > 0 {
+			if v < (math.MinInt8 / o) {
+				return Int8Value(math.MinInt8)
+			}
+		} else {
+			if (v != 0) && (o < (math.MaxInt8 / v)) {
+				return Int8Value(math.MaxInt8)
+			}
+		}
+	}
+	return v * o
+}
+
+func (v Int8Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(Int8Value)
+	// INT33-C
+	// https://golang.org/ref/spec#Integer_operators
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	} else if (v == math.MinInt8) && (o == -1) {
+		return Int8Value(math.MaxInt8)
+	}
+	return v / o
+}
+
+func (v Int8Value) Less(other NumberValue) BoolValue {
+	return v < other.(Int8Value)
+}
+
+func (v Int8Value) LessEqual(other NumberValue) BoolValue {
+	return v <= other.(Int8Value)
 }
 
 func (v Int8Value) Greater(other NumberValue) BoolValue {
@@ -1252,7 +1869,7 @@ func (v Int8Value) GetMember(_ *Interpreter, _ func() LocationRange, name string
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (Int8Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -1392,6 +2009,67 @@ func (v Int16Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
+func (v Int16Value) SaturatingPlus(other NumberValue) NumberValue {
+	o := other.(Int16Value)
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt16 - o)) {
+		return Int16Value(math.MaxInt16)
+	} else if (o < 0) && (v < (math.MinInt16 - o)) {
+		return Int16Value(math.MinInt16)
+	}
+	return v + o
+}
+
+func (v Int16Value) SaturatingMinus(other NumberValue) NumberValue {
+	o := other.(Int16Value)
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt16 + o)) {
+		return Int16Value(math.MinInt16)
+	} else if (o < 0) && (v > (math.MaxInt16 + o)) {
+		return Int16Value(math.MaxInt16)
+	}
+	return v - o
+}
+
+func (v Int16Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(Int16Value)
+	// INT32-C
+	if v > 0 {
+		if o > 0 {
+			if v > (math.MaxInt16 / o) {
+				return Int16Value(math.MaxInt16)
+			}
+		} else {
+			if o < (math.MinInt16 / v) {
+				return Int16Value(math.MinInt16)
+			}
+		}
+	} else {
+		if o > 0 {
+			if v < (math.MinInt16 / o) {
+				return Int16Value(math.MinInt16)
+			}
+		} else {
+			if (v != 0) && (o < (math.MaxInt16 / v)) {
+				return Int16Value(math.MaxInt16)
+			}
+		}
+	}
+	return v * o
+}
+
+func (v Int16Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(Int16Value)
+	// INT33-C
+	// https://golang.org/ref/spec#Integer_operators
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	} else if (v == math.MinInt16) && (o == -1) {
+		return Int16Value(math.MaxInt16)
+	}
+	return v / o
+}
+
 func (v Int16Value) Less(other NumberValue) BoolValue {
 	return v < other.(Int16Value)
 }
@@ -1488,7 +2166,7 @@ func (v Int16Value) GetMember(_ *Interpreter, _ func() LocationRange, name strin
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (Int16Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -1630,6 +2308,67 @@ func (v Int32Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
+func (v Int32Value) SaturatingPlus(other NumberValue) NumberValue {
+	o := other.(Int32Value)
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt32 - o)) {
+		return Int32Value(math.MaxInt32)
+	} else if (o < 0) && (v < (math.MinInt32 - o)) {
+		return Int32Value(math.MinInt32)
+	}
+	return v + o
+}
+
+func (v Int32Value) SaturatingMinus(other NumberValue) NumberValue {
+	o := other.(Int32Value)
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt32 + o)) {
+		return Int32Value(math.MinInt32)
+	} else if (o < 0) && (v > (math.MaxInt32 + o)) {
+		return Int32Value(math.MaxInt32)
+	}
+	return v - o
+}
+
+func (v Int32Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(Int32Value)
+	// INT32-C
+	if v > 0 {
+		if o > 0 {
+			if v > (math.MaxInt32 / o) {
+				return Int32Value(math.MaxInt32)
+			}
+		} else {
+			if o < (math.MinInt32 / v) {
+				return Int32Value(math.MinInt32)
+			}
+		}
+	} else {
+		if o > 0 {
+			if v < (math.MinInt32 / o) {
+				return Int32Value(math.MinInt32)
+			}
+		} else {
+			if (v != 0) && (o < (math.MaxInt32 / v)) {
+				return Int32Value(math.MaxInt32)
+			}
+		}
+	}
+	return v * o
+}
+
+func (v Int32Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(Int32Value)
+	// INT33-C
+	// https://golang.org/ref/spec#Integer_operators
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	} else if (v == math.MinInt32) && (o == -1) {
+		return Int32Value(math.MaxInt32)
+	}
+	return v / o
+}
+
 func (v Int32Value) Less(other NumberValue) BoolValue {
 	return v < other.(Int32Value)
 }
@@ -1726,7 +2465,7 @@ func (v Int32Value) GetMember(_ *Interpreter, _ func() LocationRange, name strin
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (Int32Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -1872,6 +2611,67 @@ func (v Int64Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
+func (v Int64Value) SaturatingPlus(other NumberValue) NumberValue {
+	o := other.(Int64Value)
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt64 - o)) {
+		return Int64Value(math.MaxInt64)
+	} else if (o < 0) && (v < (math.MinInt64 - o)) {
+		return Int64Value(math.MinInt64)
+	}
+	return v + o
+}
+
+func (v Int64Value) SaturatingMinus(other NumberValue) NumberValue {
+	o := other.(Int64Value)
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt64 + o)) {
+		return Int64Value(math.MinInt64)
+	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+		return Int64Value(math.MaxInt64)
+	}
+	return v - o
+}
+
+func (v Int64Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(Int64Value)
+	// INT32-C
+	if v > 0 {
+		if o > 0 {
+			if v > (math.MaxInt64 / o) {
+				return Int64Value(math.MaxInt64)
+			}
+		} else {
+			if o < (math.MinInt64 / v) {
+				return Int64Value(math.MinInt64)
+			}
+		}
+	} else {
+		if o > 0 {
+			if v < (math.MinInt64 / o) {
+				return Int64Value(math.MinInt64)
+			}
+		} else {
+			if (v != 0) && (o < (math.MaxInt64 / v)) {
+				return Int64Value(math.MaxInt64)
+			}
+		}
+	}
+	return v * o
+}
+
+func (v Int64Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(Int64Value)
+	// INT33-C
+	// https://golang.org/ref/spec#Integer_operators
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	} else if (v == math.MinInt64) && (o == -1) {
+		return Int64Value(math.MaxInt64)
+	}
+	return v / o
+}
+
 func (v Int64Value) Less(other NumberValue) BoolValue {
 	return v < other.(Int64Value)
 }
@@ -1963,7 +2763,7 @@ func (v Int64Value) GetMember(_ *Interpreter, _ func() LocationRange, name strin
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (Int64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -2143,6 +2943,62 @@ func (v Int128Value) Div(other NumberValue) NumberValue {
 	return Int128Value{res}
 }
 
+func (v Int128Value) SaturatingPlus(other NumberValue) NumberValue {
+	o := other.(Int128Value)
+	res := new(big.Int)
+	res.Add(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		return Int128Value{new(big.Int).Set(sema.Int128TypeMinIntBig)}
+	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		return Int128Value{new(big.Int).Set(sema.Int128TypeMaxIntBig)}
+	}
+	return Int128Value{res}
+}
+
+func (v Int128Value) SaturatingMinus(other NumberValue) NumberValue {
+	o := other.(Int128Value)
+	res := new(big.Int)
+	res.Sub(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		return Int128Value{new(big.Int).Set(sema.Int128TypeMinIntBig)}
+	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		return Int128Value{new(big.Int).Set(sema.Int128TypeMaxIntBig)}
+	}
+	return Int128Value{res}
+}
+
+func (v Int128Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(Int128Value)
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		return Int128Value{new(big.Int).Set(sema.Int128TypeMinIntBig)}
+	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		return Int128Value{new(big.Int).Set(sema.Int128TypeMaxIntBig)}
+	}
+	return Int128Value{res}
+}
+
+func (v Int128Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(Int128Value)
+	res := new(big.Int)
+	// INT33-C:
+	//   if o == 0 {
+	//       ...
+	//   } else if (v == Int128TypeMinIntBig) && (o == -1) {
+	//       ...
+	//   }
+	if o.BigInt.Cmp(res) == 0 {
+		panic(DivisionByZeroError{})
+	}
+	res.SetInt64(-1)
+	if (v.BigInt.Cmp(sema.Int128TypeMinIntBig) == 0) && (o.BigInt.Cmp(res) == 0) {
+		return Int128Value{new(big.Int).Set(sema.Int128TypeMaxIntBig)}
+	}
+	res.Div(v.BigInt, o.BigInt)
+	return Int128Value{res}
+}
+
 func (v Int128Value) Less(other NumberValue) BoolValue {
 	cmp := v.BigInt.Cmp(other.(Int128Value).BigInt)
 	return cmp == -1
@@ -2260,7 +3116,7 @@ func (v Int128Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (Int128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -2439,17 +3295,73 @@ func (v Int256Value) Div(other NumberValue) NumberValue {
 	return Int256Value{res}
 }
 
-func (v Int256Value) Less(other NumberValue) BoolValue {
-	cmp := v.BigInt.Cmp(other.(Int256Value).BigInt)
-	return cmp == -1
-}
-
-func (v Int256Value) LessEqual(other NumberValue) BoolValue {
-	cmp := v.BigInt.Cmp(other.(Int256Value).BigInt)
-	return cmp <= 0
+func (v Int256Value) SaturatingPlus(other NumberValue) NumberValue {
+	o := other.(Int256Value)
+	res := new(big.Int)
+	res.Add(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
+		return Int256Value{new(big.Int).Set(sema.Int256TypeMinIntBig)}
+	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+		return Int256Value{new(big.Int).Set(sema.Int256TypeMaxIntBig)}
+	}
+	return Int256Value{res}
 }
 
-func (v Int256Value) Greater(other NumberValue) BoolValue {
+func (v Int256Value) SaturatingMinus(other NumberValue) NumberValue {
+	o := other.(Int256Value)
+	res := new(big.Int)
+	res.Sub(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
+		return Int256Value{new(big.Int).Set(sema.Int256TypeMinIntBig)}
+	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+		return Int256Value{new(big.Int).Set(sema.Int256TypeMaxIntBig)}
+	}
+	return Int256Value{res}
+}
+
+func (v Int256Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(Int256Value)
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
+		return Int256Value{new(big.Int).Set(sema.Int256TypeMinIntBig)}
+	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+		return Int256Value{new(big.Int).Set(sema.Int256TypeMaxIntBig)}
+	}
+	return Int256Value{res}
+}
+
+func (v Int256Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(Int256Value)
+	res := new(big.Int)
+	// INT33-C:
+	//   if o == 0 {
+	//       ...
+	//   } else if (v == Int256TypeMinIntBig) && (o == -1) {
+	//       ...
+	//   }
+	if o.BigInt.Cmp(res) == 0 {
+		panic(DivisionByZeroError{})
+	}
+	res.SetInt64(-1)
+	if (v.BigInt.Cmp(sema.Int256TypeMinIntBig) == 0) && (o.BigInt.Cmp(res) == 0) {
+		return Int256Value{new(big.Int).Set(sema.Int256TypeMaxIntBig)}
+	}
+	res.Div(v.BigInt, o.BigInt)
+	return Int256Value{res}
+}
+
+func (v Int256Value) Less(other NumberValue) BoolValue {
+	cmp := v.BigInt.Cmp(other.(Int256Value).BigInt)
+	return cmp == -1
+}
+
+func (v Int256Value) LessEqual(other NumberValue) BoolValue {
+	cmp := v.BigInt.Cmp(other.(Int256Value).BigInt)
+	return cmp <= 0
+}
+
+func (v Int256Value) Greater(other NumberValue) BoolValue {
 	cmp := v.BigInt.Cmp(other.(Int256Value).BigInt)
 	return cmp == 1
 }
@@ -2556,7 +3468,7 @@ func (v Int256Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (Int256Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -2906,6 +3818,40 @@ func (v UInt8Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
+func (v UInt8Value) SaturatingPlus(other NumberValue) NumberValue {
+	sum := v + other.(UInt8Value)
+	// INT30-C
+	if sum < v {
+		return UInt8Value(math.MaxUint8)
+	}
+	return sum
+}
+
+func (v UInt8Value) SaturatingMinus(other NumberValue) NumberValue {
+	diff := v - other.(UInt8Value)
+	// INT30-C
+	if diff > v {
+		return UInt8Value(0)
+	}
+	return diff
+}
+
+func (v UInt8Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(UInt8Value)
+	if (v > 0) && (o > 0) && (v > (math.MaxUint8 / o)) {
+		return UInt8Value(math.MaxUint8)
+	}
+	return v * o
+}
+
+func (v UInt8Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(UInt8Value)
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	}
+	return v / o
+}
+
 func (v UInt8Value) Less(other NumberValue) BoolValue {
 	return v < other.(UInt8Value)
 }
@@ -3002,7 +3948,7 @@ func (v UInt8Value) GetMember(_ *Interpreter, _ func() LocationRange, name strin
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (UInt8Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -3108,6 +4054,40 @@ func (v UInt16Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
+func (v UInt16Value) SaturatingPlus(other NumberValue) NumberValue {
+	sum := v + other.(UInt16Value)
+	// INT30-C
+	if sum < v {
+		return UInt16Value(math.MaxUint16)
+	}
+	return sum
+}
+
+func (v UInt16Value) SaturatingMinus(other NumberValue) NumberValue {
+	diff := v - other.(UInt16Value)
+	// INT30-C
+	if diff > v {
+		return UInt16Value(0)
+	}
+	return diff
+}
+
+func (v UInt16Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(UInt16Value)
+	if (v > 0) && (o > 0) && (v > (math.MaxUint16 / o)) {
+		return UInt16Value(math.MaxUint16)
+	}
+	return v * o
+}
+
+func (v UInt16Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(UInt16Value)
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	}
+	return v / o
+}
+
 func (v UInt16Value) Less(other NumberValue) BoolValue {
 	return v < other.(UInt16Value)
 }
@@ -3204,7 +4184,7 @@ func (v UInt16Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (UInt16Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -3314,6 +4294,40 @@ func (v UInt32Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
+func (v UInt32Value) SaturatingPlus(other NumberValue) NumberValue {
+	sum := v + other.(UInt32Value)
+	// INT30-C
+	if sum < v {
+		return UInt32Value(math.MaxUint32)
+	}
+	return sum
+}
+
+func (v UInt32Value) SaturatingMinus(other NumberValue) NumberValue {
+	diff := v - other.(UInt32Value)
+	// INT30-C
+	if diff > v {
+		return UInt32Value(0)
+	}
+	return diff
+}
+
+func (v UInt32Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(UInt32Value)
+	if (v > 0) && (o > 0) && (v > (math.MaxUint32 / o)) {
+		return UInt32Value(math.MaxUint32)
+	}
+	return v * o
+}
+
+func (v UInt32Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(UInt32Value)
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	}
+	return v / o
+}
+
 func (v UInt32Value) Less(other NumberValue) BoolValue {
 	return v < other.(UInt32Value)
 }
@@ -3410,7 +4424,7 @@ func (v UInt32Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (UInt32Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -3525,6 +4539,40 @@ func (v UInt64Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
+func (v UInt64Value) SaturatingPlus(other NumberValue) NumberValue {
+	sum := v + other.(UInt64Value)
+	// INT30-C
+	if sum < v {
+		return UInt64Value(math.MaxUint64)
+	}
+	return sum
+}
+
+func (v UInt64Value) SaturatingMinus(other NumberValue) NumberValue {
+	diff := v - other.(UInt64Value)
+	// INT30-C
+	if diff > v {
+		return UInt64Value(0)
+	}
+	return diff
+}
+
+func (v UInt64Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(UInt64Value)
+	if (v > 0) && (o > 0) && (v > (math.MaxUint64 / o)) {
+		return UInt64Value(math.MaxUint64)
+	}
+	return v * o
+}
+
+func (v UInt64Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(UInt64Value)
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	}
+	return v / o
+}
+
 func (v UInt64Value) Less(other NumberValue) BoolValue {
 	return v < other.(UInt64Value)
 }
@@ -3619,7 +4667,7 @@ func (v UInt64Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (UInt64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -3770,6 +4818,44 @@ func (v UInt128Value) Div(other NumberValue) NumberValue {
 	return UInt128Value{res}
 }
 
+func (v UInt128Value) SaturatingPlus(other NumberValue) NumberValue {
+	sum := new(big.Int)
+	sum.Add(v.BigInt, other.(UInt128Value).BigInt)
+	if sum.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
+		return UInt128Value{new(big.Int).Set(sema.UInt128TypeMaxIntBig)}
+	}
+	return UInt128Value{sum}
+}
+
+func (v UInt128Value) SaturatingMinus(other NumberValue) NumberValue {
+	diff := new(big.Int)
+	diff.Sub(v.BigInt, other.(UInt128Value).BigInt)
+	if diff.Cmp(sema.UInt128TypeMinIntBig) < 0 {
+		return UInt128Value{new(big.Int).Set(sema.UInt128TypeMinIntBig)}
+	}
+	return UInt128Value{diff}
+}
+
+func (v UInt128Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(UInt128Value)
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
+		return UInt128Value{new(big.Int).Set(sema.UInt128TypeMaxIntBig)}
+	}
+	return UInt128Value{res}
+}
+
+func (v UInt128Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(UInt128Value)
+	res := new(big.Int)
+	if o.BigInt.Cmp(res) == 0 {
+		panic(DivisionByZeroError{})
+	}
+	res.Div(v.BigInt, o.BigInt)
+	return UInt128Value{res}
+}
+
 func (v UInt128Value) Less(other NumberValue) BoolValue {
 	cmp := v.BigInt.Cmp(other.(UInt128Value).BigInt)
 	return cmp == -1
@@ -3886,7 +4972,7 @@ func (v UInt128Value) GetMember(_ *Interpreter, _ func() LocationRange, name str
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (UInt128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -4035,6 +5121,44 @@ func (v UInt256Value) Div(other NumberValue) NumberValue {
 	return UInt256Value{res}
 }
 
+func (v UInt256Value) SaturatingPlus(other NumberValue) NumberValue {
+	sum := new(big.Int)
+	sum.Add(v.BigInt, other.(UInt256Value).BigInt)
+	if sum.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
+		return UInt256Value{new(big.Int).Set(sema.UInt256TypeMaxIntBig)}
+	}
+	return UInt256Value{sum}
+}
+
+func (v UInt256Value) SaturatingMinus(other NumberValue) NumberValue {
+	diff := new(big.Int)
+	diff.Sub(v.BigInt, other.(UInt256Value).BigInt)
+	if diff.Cmp(sema.UInt256TypeMinIntBig) < 0 {
+		return UInt256Value{new(big.Int).Set(sema.UInt256TypeMinIntBig)}
+	}
+	return UInt256Value{diff}
+}
+
+func (v UInt256Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(UInt256Value)
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
+		return UInt256Value{new(big.Int).Set(sema.UInt256TypeMaxIntBig)}
+	}
+	return UInt256Value{res}
+}
+
+func (v UInt256Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(UInt256Value)
+	res := new(big.Int)
+	if o.BigInt.Cmp(res) == 0 {
+		panic(DivisionByZeroError{})
+	}
+	res.Div(v.BigInt, o.BigInt)
+	return UInt256Value{res}
+}
+
 func (v UInt256Value) Less(other NumberValue) BoolValue {
 	cmp := v.BigInt.Cmp(other.(UInt256Value).BigInt)
 	return cmp == -1
@@ -4152,7 +5276,7 @@ func (v UInt256Value) GetMember(_ *Interpreter, _ func() LocationRange, name str
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (UInt256Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -4949,33 +6073,97 @@ func (v Fix64Value) Div(other NumberValue) NumberValue {
 	return Fix64Value(result.Int64())
 }
 
-func (v Fix64Value) Mod(other NumberValue) NumberValue {
+func (v Fix64Value) SaturatingPlus(other NumberValue) NumberValue {
 	o := other.(Fix64Value)
-	// v - int(v/o) * o
-	quotient := v.Div(o).(Fix64Value)
-	truncatedQuotient := (int64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
-	return v.Minus(Fix64Value(truncatedQuotient).Mul(o))
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt64 - o)) {
+		return Fix64Value(math.MaxInt64)
+	} else if (o < 0) && (v < (math.MinInt64 - o)) {
+		return Fix64Value(math.MinInt64)
+	}
+	return v + o
 }
 
-func (v Fix64Value) Less(other NumberValue) BoolValue {
-	return v < other.(Fix64Value)
+func (v Fix64Value) SaturatingMinus(other NumberValue) NumberValue {
+	o := other.(Fix64Value)
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt64 + o)) {
+		return Fix64Value(math.MinInt64)
+	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+		return Fix64Value(math.MaxInt64)
+	}
+	return v - o
 }
 
-func (v Fix64Value) LessEqual(other NumberValue) BoolValue {
-	return v <= other.(Fix64Value)
-}
+func (v Fix64Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(Fix64Value)
 
-func (v Fix64Value) Greater(other NumberValue) BoolValue {
-	return v > other.(Fix64Value)
-}
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
 
-func (v Fix64Value) GreaterEqual(other NumberValue) BoolValue {
-	return v >= other.(Fix64Value)
-}
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
 
-func (v Fix64Value) Equal(_ *Interpreter, other Value) BoolValue {
-	otherFix64, ok := other.(Fix64Value)
-	if !ok {
+	if !result.IsInt64() {
+		if result.Sign() > 0 {
+			return Fix64Value(math.MaxInt64)
+		}
+		return Fix64Value(math.MinInt64)
+	}
+
+	return Fix64Value(result.Int64())
+}
+
+func (v Fix64Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(Fix64Value)
+
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+	result.Div(result, b)
+
+	if !result.IsInt64() {
+		if result.Sign() > 0 {
+			return Fix64Value(math.MaxInt64)
+		}
+		return Fix64Value(math.MinInt64)
+	}
+
+	return Fix64Value(result.Int64())
+}
+
+func (v Fix64Value) Mod(other NumberValue) NumberValue {
+	o := other.(Fix64Value)
+	// v - int(v/o) * o
+	quotient := v.Div(o).(Fix64Value)
+	truncatedQuotient := (int64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
+	return v.Minus(Fix64Value(truncatedQuotient).Mul(o))
+}
+
+func (v Fix64Value) Less(other NumberValue) BoolValue {
+	return v < other.(Fix64Value)
+}
+
+func (v Fix64Value) LessEqual(other NumberValue) BoolValue {
+	return v <= other.(Fix64Value)
+}
+
+func (v Fix64Value) Greater(other NumberValue) BoolValue {
+	return v > other.(Fix64Value)
+}
+
+func (v Fix64Value) GreaterEqual(other NumberValue) BoolValue {
+	return v >= other.(Fix64Value)
+}
+
+func (v Fix64Value) Equal(_ *Interpreter, other Value) BoolValue {
+	otherFix64, ok := other.(Fix64Value)
+	if !ok {
 		return false
 	}
 	return v == otherFix64
@@ -4992,6 +6180,23 @@ func ConvertFix64(value Value) Fix64Value {
 		}
 		return Fix64Value(value)
 
+	case Fix128Value:
+		v := fix128ToFix64(value.BigInt)
+		if !v.IsInt64() {
+			if v.Sign() < 0 {
+				panic(UnderflowError{})
+			}
+			panic(OverflowError{})
+		}
+		return Fix64Value(v.Int64())
+
+	case UFix128Value:
+		v := fix128ToFix64(value.BigInt)
+		if !v.IsInt64() {
+			panic(OverflowError{})
+		}
+		return Fix64Value(v.Int64())
+
 	case BigNumberValue:
 		v := value.ToBigInt()
 
@@ -5034,7 +6239,7 @@ func (v Fix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name strin
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
 func (Fix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
@@ -5058,181 +6263,821 @@ func NewUFix64ValueWithInteger(integer uint64) UFix64Value {
 		panic(OverflowError{})
 	}
 
-	return UFix64Value(integer * sema.Fix64Factor)
+	return UFix64Value(integer * sema.Fix64Factor)
+}
+
+func (UFix64Value) IsValue() {}
+
+func (v UFix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUFix64Value(interpreter, v)
+}
+
+func (UFix64Value) DynamicType(_ *Interpreter) DynamicType {
+	return NumberDynamicType{&sema.UFix64Type{}}
+}
+
+func (UFix64Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUFix64
+}
+
+func (v UFix64Value) Copy() Value {
+	return v
+}
+
+func (UFix64Value) GetOwner() *common.Address {
+	// value is never owned
+	return nil
+}
+
+func (UFix64Value) SetOwner(_ *common.Address) {
+	// NO-OP: value cannot be owned
+}
+
+func (UFix64Value) IsModified() bool {
+	return false
+}
+
+func (UFix64Value) SetModified(_ bool) {
+	// NO-OP
+}
+
+func (v UFix64Value) String() string {
+	return format.UFix64(uint64(v))
+}
+
+func (v UFix64Value) KeyString() string {
+	return v.String()
+}
+
+func (v UFix64Value) ToInt() int {
+	return int(v / sema.Fix64Factor)
+}
+
+func (v UFix64Value) Negate() NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix64Value) Plus(other NumberValue) NumberValue {
+	o := other.(UFix64Value)
+	return UFix64Value(safeAddUint64(uint64(v), uint64(o)))
+}
+
+func (v UFix64Value) Minus(other NumberValue) NumberValue {
+	diff := v - other.(UFix64Value)
+	// INT30-C
+	if diff > v {
+		panic(UnderflowError{})
+	}
+	return diff
+}
+
+func (v UFix64Value) Mul(other NumberValue) NumberValue {
+	o := other.(UFix64Value)
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
+
+	if !result.IsUint64() {
+		panic(OverflowError{})
+	}
+
+	return UFix64Value(result.Uint64())
+}
+
+func (v UFix64Value) Div(other NumberValue) NumberValue {
+	o := other.(UFix64Value)
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+	result.Div(result, b)
+
+	if !result.IsUint64() {
+		panic(OverflowError{})
+	}
+
+	return UFix64Value(result.Uint64())
+}
+
+func (v UFix64Value) SaturatingPlus(other NumberValue) NumberValue {
+	sum := v + other.(UFix64Value)
+	// INT30-C
+	if sum < v {
+		return UFix64Value(math.MaxUint64)
+	}
+	return sum
+}
+
+func (v UFix64Value) SaturatingMinus(other NumberValue) NumberValue {
+	diff := v - other.(UFix64Value)
+	// INT30-C
+	if diff > v {
+		return UFix64Value(0)
+	}
+	return diff
+}
+
+func (v UFix64Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(UFix64Value)
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
+
+	if !result.IsUint64() {
+		return UFix64Value(math.MaxUint64)
+	}
+
+	return UFix64Value(result.Uint64())
+}
+
+func (v UFix64Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(UFix64Value)
+
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	}
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+	result.Div(result, b)
+
+	if !result.IsUint64() {
+		return UFix64Value(math.MaxUint64)
+	}
+
+	return UFix64Value(result.Uint64())
+}
+
+func (v UFix64Value) Mod(other NumberValue) NumberValue {
+	o := other.(UFix64Value)
+	// v - int(v/o) * o
+	quotient := v.Div(o).(UFix64Value)
+	truncatedQuotient := (uint64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
+	return v.Minus(UFix64Value(truncatedQuotient).Mul(o))
+}
+
+func (v UFix64Value) Less(other NumberValue) BoolValue {
+	return v < other.(UFix64Value)
+}
+
+func (v UFix64Value) LessEqual(other NumberValue) BoolValue {
+	return v <= other.(UFix64Value)
+}
+
+func (v UFix64Value) Greater(other NumberValue) BoolValue {
+	return v > other.(UFix64Value)
+}
+
+func (v UFix64Value) GreaterEqual(other NumberValue) BoolValue {
+	return v >= other.(UFix64Value)
+}
+
+func (v UFix64Value) Equal(_ *Interpreter, other Value) BoolValue {
+	otherUFix64, ok := other.(UFix64Value)
+	if !ok {
+		return false
+	}
+	return v == otherUFix64
+}
+
+func ConvertUFix64(value Value) UFix64Value {
+	switch value := value.(type) {
+	case UFix64Value:
+		return value
+
+	case Fix64Value:
+		if value < 0 {
+			panic(UnderflowError{})
+		}
+		return UFix64Value(value)
+
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+		v := fix128ToFix64(value.BigInt)
+		if !v.IsUint64() {
+			panic(OverflowError{})
+		}
+		return UFix64Value(v.Uint64())
+
+	case UFix128Value:
+		v := fix128ToFix64(value.BigInt)
+		if !v.IsUint64() {
+			panic(OverflowError{})
+		}
+		return UFix64Value(v.Uint64())
+
+	case BigNumberValue:
+		v := value.ToBigInt()
+
+		if v.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+
+		// First, check if the value is at least in the uint64 range.
+		// The integer range for UFix64 is smaller, but this test at least
+		// allows us to call `v.UInt64()` safely.
+
+		if !v.IsUint64() {
+			panic(OverflowError{})
+		}
+
+		// Now check that the integer value fits the range of UFix64
+		return NewUFix64ValueWithInteger(v.Uint64())
+
+	case NumberValue:
+		v := value.ToInt()
+		if v < 0 {
+			panic(UnderflowError{})
+		}
+		// Check that the integer value fits the range of UFix64
+		return NewUFix64ValueWithInteger(uint64(v))
+
+	default:
+		panic(fmt.Sprintf("can't convert to UFix64: %s", value))
+	}
+}
+
+func (v UFix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	switch name {
+
+	case sema.ToStringFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return NewStringValue(v.String())
+			},
+		)
+
+	case sema.ToBigEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
+			},
+		)
+	}
+
+	return getSaturatingArithmeticMember(v, name)
+}
+
+func (UFix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix64Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+// fix64ToFix128FactorBig is the factor between the scaled integer representations
+// of 64-bit and 128-bit fixed-point values
+//
+var fix64ToFix128FactorBig = new(big.Int).Exp(
+	big.NewInt(10),
+	big.NewInt(int64(sema.Fix128Scale-sema.Fix64Scale)),
+	nil,
+)
+
+// fix64ToFix128 returns the scaled integer representation of a 128-bit fixed-point value
+// for the given scaled integer representation of a 64-bit fixed-point value
+//
+func fix64ToFix128(value *big.Int) *big.Int {
+	return value.Mul(value, fix64ToFix128FactorBig)
+}
+
+// fix128ToFix64 returns the scaled integer representation of a 64-bit fixed-point value
+// for the given scaled integer representation of a 128-bit fixed-point value.
+// Decimal places which cannot be represented are truncated
+//
+func fix128ToFix64(value *big.Int) *big.Int {
+	return new(big.Int).Quo(value, fix64ToFix128FactorBig)
+}
+
+// Fix128Value
+//
+type Fix128Value struct {
+	BigInt *big.Int
+}
+
+func NewFix128ValueFromBigInt(value *big.Int) Fix128Value {
+	return Fix128Value{BigInt: value}
+}
+
+func NewFix128ValueWithInteger(integer *big.Int) Fix128Value {
+
+	if integer.Cmp(sema.Fix128TypeMinIntBig) < 0 {
+		panic(UnderflowError{})
+	}
+
+	if integer.Cmp(sema.Fix128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+
+	return NewFix128ValueFromBigInt(
+		new(big.Int).Mul(integer, sema.Fix128FactorBig),
+	)
+}
+
+// checkFix128Range panics if the given scaled integer representation
+// is outside of the range of Fix128 values
+//
+func checkFix128Range(value *big.Int) {
+	if value.Cmp(sema.Fix128TypeMinScaledBig) < 0 {
+		panic(UnderflowError{})
+	} else if value.Cmp(sema.Fix128TypeMaxScaledBig) > 0 {
+		panic(OverflowError{})
+	}
+}
+
+// saturateFix128Range returns the given scaled value clamped to the range of Fix128
+//
+func saturateFix128Range(value *big.Int) *big.Int {
+	if value.Cmp(sema.Fix128TypeMinScaledBig) < 0 {
+		return new(big.Int).Set(sema.Fix128TypeMinScaledBig)
+	} else if value.Cmp(sema.Fix128TypeMaxScaledBig) > 0 {
+		return new(big.Int).Set(sema.Fix128TypeMaxScaledBig)
+	}
+	return value
+}
+
+func (Fix128Value) IsValue() {}
+
+func (v Fix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitFix128Value(interpreter, v)
+}
+
+func (Fix128Value) DynamicType(_ *Interpreter) DynamicType {
+	return NumberDynamicType{&sema.Fix128Type{}}
+}
+
+func (Fix128Value) StaticType() StaticType {
+	return PrimitiveStaticTypeFix128
+}
+
+func (v Fix128Value) Copy() Value {
+	return Fix128Value{BigInt: new(big.Int).Set(v.BigInt)}
+}
+
+func (Fix128Value) GetOwner() *common.Address {
+	// value is never owned
+	return nil
+}
+
+func (Fix128Value) SetOwner(_ *common.Address) {
+	// NO-OP: value cannot be owned
+}
+
+func (Fix128Value) IsModified() bool {
+	return false
+}
+
+func (Fix128Value) SetModified(_ bool) {
+	// NO-OP
+}
+
+func (v Fix128Value) String() string {
+	return format.Fix128(v.BigInt)
+}
+
+func (v Fix128Value) KeyString() string {
+	return v.String()
+}
+
+func (v Fix128Value) ToInt() int {
+	// TODO: handle overflow
+	return int(v.ToBigInt().Int64())
+}
+
+// ToBigInt returns the integer part of the value
+//
+func (v Fix128Value) ToBigInt() *big.Int {
+	return new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig)
+}
+
+func (v Fix128Value) Negate() NumberValue {
+	// INT32-C
+	if v.BigInt.Cmp(sema.Fix128TypeMinScaledBig) == 0 {
+		panic(OverflowError{})
+	}
+	return Fix128Value{new(big.Int).Neg(v.BigInt)}
+}
+
+func (v Fix128Value) Plus(other NumberValue) NumberValue {
+	o := other.(Fix128Value)
+	res := new(big.Int).Add(v.BigInt, o.BigInt)
+	checkFix128Range(res)
+	return Fix128Value{res}
+}
+
+func (v Fix128Value) Minus(other NumberValue) NumberValue {
+	o := other.(Fix128Value)
+	res := new(big.Int).Sub(v.BigInt, o.BigInt)
+	checkFix128Range(res)
+	return Fix128Value{res}
+}
+
+func (v Fix128Value) Mul(other NumberValue) NumberValue {
+	o := other.(Fix128Value)
+	res := new(big.Int).Mul(v.BigInt, o.BigInt)
+	res.Div(res, sema.Fix128FactorBig)
+	checkFix128Range(res)
+	return Fix128Value{res}
+}
+
+func (v Fix128Value) Div(other NumberValue) NumberValue {
+	o := other.(Fix128Value)
+	if o.BigInt.Sign() == 0 {
+		panic(DivisionByZeroError{})
+	}
+	res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+	res.Div(res, o.BigInt)
+	checkFix128Range(res)
+	return Fix128Value{res}
+}
+
+func (v Fix128Value) SaturatingPlus(other NumberValue) NumberValue {
+	o := other.(Fix128Value)
+	res := new(big.Int).Add(v.BigInt, o.BigInt)
+	return Fix128Value{saturateFix128Range(res)}
+}
+
+func (v Fix128Value) SaturatingMinus(other NumberValue) NumberValue {
+	o := other.(Fix128Value)
+	res := new(big.Int).Sub(v.BigInt, o.BigInt)
+	return Fix128Value{saturateFix128Range(res)}
+}
+
+func (v Fix128Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(Fix128Value)
+	res := new(big.Int).Mul(v.BigInt, o.BigInt)
+	res.Div(res, sema.Fix128FactorBig)
+	return Fix128Value{saturateFix128Range(res)}
+}
+
+func (v Fix128Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(Fix128Value)
+	if o.BigInt.Sign() == 0 {
+		panic(DivisionByZeroError{})
+	}
+	res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+	res.Div(res, o.BigInt)
+	return Fix128Value{saturateFix128Range(res)}
+}
+
+func (v Fix128Value) Mod(other NumberValue) NumberValue {
+	o := other.(Fix128Value)
+	// v - int(v/o) * o
+	quotient := v.Div(o).(Fix128Value)
+	truncatedQuotient := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+	truncatedQuotient.Mul(truncatedQuotient, sema.Fix128FactorBig)
+	return v.Minus(Fix128Value{truncatedQuotient}.Mul(o))
+}
+
+func (v Fix128Value) Less(other NumberValue) BoolValue {
+	cmp := v.BigInt.Cmp(other.(Fix128Value).BigInt)
+	return cmp == -1
+}
+
+func (v Fix128Value) LessEqual(other NumberValue) BoolValue {
+	cmp := v.BigInt.Cmp(other.(Fix128Value).BigInt)
+	return cmp <= 0
+}
+
+func (v Fix128Value) Greater(other NumberValue) BoolValue {
+	cmp := v.BigInt.Cmp(other.(Fix128Value).BigInt)
+	return cmp == 1
+}
+
+func (v Fix128Value) GreaterEqual(other NumberValue) BoolValue {
+	cmp := v.BigInt.Cmp(other.(Fix128Value).BigInt)
+	return cmp >= 0
+}
+
+func (v Fix128Value) Equal(_ *Interpreter, other Value) BoolValue {
+	otherFix128, ok := other.(Fix128Value)
+	if !ok {
+		return false
+	}
+	cmp := v.BigInt.Cmp(otherFix128.BigInt)
+	return cmp == 0
+}
+
+func ConvertFix128(value Value) Fix128Value {
+	switch value := value.(type) {
+	case Fix128Value:
+		return value
+
+	case UFix128Value:
+		if value.BigInt.Cmp(sema.Fix128TypeMaxScaledBig) > 0 {
+			panic(OverflowError{})
+		}
+		return NewFix128ValueFromBigInt(new(big.Int).Set(value.BigInt))
+
+	case Fix64Value:
+		return NewFix128ValueFromBigInt(
+			fix64ToFix128(big.NewInt(int64(value))),
+		)
+
+	case UFix64Value:
+		return NewFix128ValueFromBigInt(
+			fix64ToFix128(new(big.Int).SetUint64(uint64(value))),
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(value.ToBigInt())
+
+	case NumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(big.NewInt(int64(value.ToInt())))
+
+	default:
+		panic(fmt.Sprintf("can't convert to Fix128: %s", value))
+	}
+}
+
+func (v Fix128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	switch name {
+
+	case sema.ToStringFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return NewStringValue(v.String())
+			},
+		)
+
+	case sema.ToBigEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(v.ToBigEndianBytes())
+			},
+		)
+	}
+
+	return getSaturatingArithmeticMember(v, name)
+}
+
+func (Fix128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	panic(errors.NewUnreachableError())
+}
+
+func (v Fix128Value) ToBigEndianBytes() []byte {
+	return SignedBigIntToBigEndianBytes(v.BigInt)
+}
+
+// UFix128Value
+//
+type UFix128Value struct {
+	BigInt *big.Int
+}
+
+func NewUFix128ValueFromBigInt(value *big.Int) UFix128Value {
+	return UFix128Value{BigInt: value}
+}
+
+func NewUFix128ValueWithInteger(integer *big.Int) UFix128Value {
+
+	if integer.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+
+	if integer.Cmp(sema.UFix128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+
+	return NewUFix128ValueFromBigInt(
+		new(big.Int).Mul(integer, sema.Fix128FactorBig),
+	)
+}
+
+// checkUFix128Range panics if the given scaled integer representation
+// is outside of the range of UFix128 values
+//
+func checkUFix128Range(value *big.Int) {
+	if value.Sign() < 0 {
+		panic(UnderflowError{})
+	} else if value.Cmp(sema.UFix128TypeMaxScaledBig) > 0 {
+		panic(OverflowError{})
+	}
+}
+
+// saturateUFix128Range returns the given scaled value clamped to the range of UFix128
+//
+func saturateUFix128Range(value *big.Int) *big.Int {
+	if value.Sign() < 0 {
+		return new(big.Int)
+	} else if value.Cmp(sema.UFix128TypeMaxScaledBig) > 0 {
+		return new(big.Int).Set(sema.UFix128TypeMaxScaledBig)
+	}
+	return value
 }
 
-func (UFix64Value) IsValue() {}
+func (UFix128Value) IsValue() {}
 
-func (v UFix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUFix64Value(interpreter, v)
+func (v UFix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUFix128Value(interpreter, v)
 }
 
-func (UFix64Value) DynamicType(_ *Interpreter) DynamicType {
-	return NumberDynamicType{&sema.UFix64Type{}}
+func (UFix128Value) DynamicType(_ *Interpreter) DynamicType {
+	return NumberDynamicType{&sema.UFix128Type{}}
 }
 
-func (UFix64Value) StaticType() StaticType {
-	return PrimitiveStaticTypeUFix64
+func (UFix128Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUFix128
 }
 
-func (v UFix64Value) Copy() Value {
-	return v
+func (v UFix128Value) Copy() Value {
+	return UFix128Value{BigInt: new(big.Int).Set(v.BigInt)}
 }
 
-func (UFix64Value) GetOwner() *common.Address {
+func (UFix128Value) GetOwner() *common.Address {
 	// value is never owned
 	return nil
 }
 
-func (UFix64Value) SetOwner(_ *common.Address) {
+func (UFix128Value) SetOwner(_ *common.Address) {
 	// NO-OP: value cannot be owned
 }
 
-func (UFix64Value) IsModified() bool {
+func (UFix128Value) IsModified() bool {
 	return false
 }
 
-func (UFix64Value) SetModified(_ bool) {
+func (UFix128Value) SetModified(_ bool) {
 	// NO-OP
 }
 
-func (v UFix64Value) String() string {
-	return format.UFix64(uint64(v))
+func (v UFix128Value) String() string {
+	return format.UFix128(v.BigInt)
 }
 
-func (v UFix64Value) KeyString() string {
+func (v UFix128Value) KeyString() string {
 	return v.String()
 }
 
-func (v UFix64Value) ToInt() int {
-	return int(v / sema.Fix64Factor)
+func (v UFix128Value) ToInt() int {
+	// TODO: handle overflow
+	return int(v.ToBigInt().Int64())
 }
 
-func (v UFix64Value) Negate() NumberValue {
-	panic(errors.NewUnreachableError())
+// ToBigInt returns the integer part of the value
+//
+func (v UFix128Value) ToBigInt() *big.Int {
+	return new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig)
 }
 
-func (v UFix64Value) Plus(other NumberValue) NumberValue {
-	o := other.(UFix64Value)
-	return UFix64Value(safeAddUint64(uint64(v), uint64(o)))
+func (v UFix128Value) Negate() NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) Minus(other NumberValue) NumberValue {
-	diff := v - other.(UFix64Value)
-	// INT30-C
-	if diff > v {
-		panic(UnderflowError{})
-	}
-	return diff
+func (v UFix128Value) Plus(other NumberValue) NumberValue {
+	o := other.(UFix128Value)
+	res := new(big.Int).Add(v.BigInt, o.BigInt)
+	checkUFix128Range(res)
+	return UFix128Value{res}
 }
 
-func (v UFix64Value) Mul(other NumberValue) NumberValue {
-	o := other.(UFix64Value)
-
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
+func (v UFix128Value) Minus(other NumberValue) NumberValue {
+	o := other.(UFix128Value)
+	res := new(big.Int).Sub(v.BigInt, o.BigInt)
+	checkUFix128Range(res)
+	return UFix128Value{res}
+}
 
-	result := new(big.Int).Mul(a, b)
-	result.Div(result, sema.Fix64FactorBig)
+func (v UFix128Value) Mul(other NumberValue) NumberValue {
+	o := other.(UFix128Value)
+	res := new(big.Int).Mul(v.BigInt, o.BigInt)
+	res.Div(res, sema.Fix128FactorBig)
+	checkUFix128Range(res)
+	return UFix128Value{res}
+}
 
-	if !result.IsUint64() {
-		panic(OverflowError{})
+func (v UFix128Value) Div(other NumberValue) NumberValue {
+	o := other.(UFix128Value)
+	if o.BigInt.Sign() == 0 {
+		panic(DivisionByZeroError{})
 	}
-
-	return UFix64Value(result.Uint64())
+	res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+	res.Div(res, o.BigInt)
+	checkUFix128Range(res)
+	return UFix128Value{res}
 }
 
-func (v UFix64Value) Div(other NumberValue) NumberValue {
-	o := other.(UFix64Value)
+func (v UFix128Value) SaturatingPlus(other NumberValue) NumberValue {
+	o := other.(UFix128Value)
+	res := new(big.Int).Add(v.BigInt, o.BigInt)
+	return UFix128Value{saturateUFix128Range(res)}
+}
 
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
+func (v UFix128Value) SaturatingMinus(other NumberValue) NumberValue {
+	o := other.(UFix128Value)
+	res := new(big.Int).Sub(v.BigInt, o.BigInt)
+	return UFix128Value{saturateUFix128Range(res)}
+}
 
-	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
-	result.Div(result, b)
+func (v UFix128Value) SaturatingMul(other NumberValue) NumberValue {
+	o := other.(UFix128Value)
+	res := new(big.Int).Mul(v.BigInt, o.BigInt)
+	res.Div(res, sema.Fix128FactorBig)
+	return UFix128Value{saturateUFix128Range(res)}
+}
 
-	if !result.IsUint64() {
-		panic(OverflowError{})
+func (v UFix128Value) SaturatingDiv(other NumberValue) NumberValue {
+	o := other.(UFix128Value)
+	if o.BigInt.Sign() == 0 {
+		panic(DivisionByZeroError{})
 	}
-
-	return UFix64Value(result.Uint64())
+	res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+	res.Div(res, o.BigInt)
+	return UFix128Value{saturateUFix128Range(res)}
 }
 
-func (v UFix64Value) Mod(other NumberValue) NumberValue {
-	o := other.(UFix64Value)
+func (v UFix128Value) Mod(other NumberValue) NumberValue {
+	o := other.(UFix128Value)
 	// v - int(v/o) * o
-	quotient := v.Div(o).(UFix64Value)
-	truncatedQuotient := (uint64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
-	return v.Minus(UFix64Value(truncatedQuotient).Mul(o))
+	quotient := v.Div(o).(UFix128Value)
+	truncatedQuotient := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+	truncatedQuotient.Mul(truncatedQuotient, sema.Fix128FactorBig)
+	return v.Minus(UFix128Value{truncatedQuotient}.Mul(o))
 }
 
-func (v UFix64Value) Less(other NumberValue) BoolValue {
-	return v < other.(UFix64Value)
+func (v UFix128Value) Less(other NumberValue) BoolValue {
+	cmp := v.BigInt.Cmp(other.(UFix128Value).BigInt)
+	return cmp == -1
 }
 
-func (v UFix64Value) LessEqual(other NumberValue) BoolValue {
-	return v <= other.(UFix64Value)
+func (v UFix128Value) LessEqual(other NumberValue) BoolValue {
+	cmp := v.BigInt.Cmp(other.(UFix128Value).BigInt)
+	return cmp <= 0
 }
 
-func (v UFix64Value) Greater(other NumberValue) BoolValue {
-	return v > other.(UFix64Value)
+func (v UFix128Value) Greater(other NumberValue) BoolValue {
+	cmp := v.BigInt.Cmp(other.(UFix128Value).BigInt)
+	return cmp == 1
 }
 
-func (v UFix64Value) GreaterEqual(other NumberValue) BoolValue {
-	return v >= other.(UFix64Value)
+func (v UFix128Value) GreaterEqual(other NumberValue) BoolValue {
+	cmp := v.BigInt.Cmp(other.(UFix128Value).BigInt)
+	return cmp >= 0
 }
 
-func (v UFix64Value) Equal(_ *Interpreter, other Value) BoolValue {
-	otherUFix64, ok := other.(UFix64Value)
+func (v UFix128Value) Equal(_ *Interpreter, other Value) BoolValue {
+	otherUFix128, ok := other.(UFix128Value)
 	if !ok {
 		return false
 	}
-	return v == otherUFix64
+	cmp := v.BigInt.Cmp(otherUFix128.BigInt)
+	return cmp == 0
 }
 
-func ConvertUFix64(value Value) UFix64Value {
+func ConvertUFix128(value Value) UFix128Value {
 	switch value := value.(type) {
-	case UFix64Value:
+	case UFix128Value:
 		return value
 
-	case Fix64Value:
-		if value < 0 {
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
 			panic(UnderflowError{})
 		}
-		return UFix64Value(value)
-
-	case BigNumberValue:
-		v := value.ToBigInt()
+		return NewUFix128ValueFromBigInt(new(big.Int).Set(value.BigInt))
 
-		if v.Sign() < 0 {
+	case Fix64Value:
+		if value < 0 {
 			panic(UnderflowError{})
 		}
+		return NewUFix128ValueFromBigInt(
+			fix64ToFix128(big.NewInt(int64(value))),
+		)
 
-		// First, check if the value is at least in the uint64 range.
-		// The integer range for UFix64 is smaller, but this test at least
-		// allows us to call `v.UInt64()` safely.
-
-		if !v.IsUint64() {
-			panic(OverflowError{})
-		}
+	case UFix64Value:
+		return NewUFix128ValueFromBigInt(
+			fix64ToFix128(new(big.Int).SetUint64(uint64(value))),
+		)
 
-		// Now check that the integer value fits the range of UFix64
-		return NewUFix64ValueWithInteger(v.Uint64())
+	case BigNumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(value.ToBigInt())
 
 	case NumberValue:
-		v := value.ToInt()
-		if v < 0 {
-			panic(UnderflowError{})
-		}
-		// Check that the integer value fits the range of UFix64
-		return NewUFix64ValueWithInteger(uint64(v))
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(big.NewInt(int64(value.ToInt())))
 
 	default:
-		panic(fmt.Sprintf("can't convert to UFix64: %s", value))
+		panic(fmt.Sprintf("can't convert to UFix128: %s", value))
 	}
 }
 
-func (v UFix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+func (v UFix128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
 	switch name {
 
 	case sema.ToStringFunctionName:
@@ -5250,17 +7095,15 @@ func (v UFix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name stri
 		)
 	}
 
-	return nil
+	return getSaturatingArithmeticMember(v, name)
 }
 
-func (UFix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (UFix128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(v))
-	return b
+func (v UFix128Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
 }
 
 // CompositeValue
@@ -5273,11 +7116,14 @@ type CompositeValue struct {
 	InjectedFields      *StringValueOrderedMap
 	ComputedFields      *StringComputedFieldOrderedMap
 	NestedVariables     *StringVariableOrderedMap
-	Functions           map[string]FunctionValue
-	Destructor          FunctionValue
-	Owner               *common.Address
-	destroyed           bool
-	modified            bool
+	Extensions          *StringValueOrderedMap
+	// TypeArguments are the type arguments of an instance of a generic structure
+	TypeArguments []StaticType
+	Functions     map[string]FunctionValue
+	Destructor    FunctionValue
+	Owner         *common.Address
+	destroyed     bool
+	modified      bool
 }
 
 type ComputedField func(*Interpreter) Value
@@ -5341,20 +7187,47 @@ func (v *CompositeValue) Accept(interpreter *Interpreter, visitor Visitor) {
 	v.Fields.Foreach(func(_ string, value Value) {
 		value.Accept(interpreter, visitor)
 	})
+
+	// NOTE: Visit the fields of the extensions, but not the values holding them,
+	// they are not values of the program
+
+	if v.Extensions != nil {
+		v.Extensions.Foreach(func(_ string, extensionFields Value) {
+			extensionFields.(*CompositeValue).Fields.Foreach(func(_ string, value Value) {
+				value.Accept(interpreter, visitor)
+			})
+		})
+	}
 }
 
 func (v *CompositeValue) DynamicType(interpreter *Interpreter) DynamicType {
 	staticType := interpreter.getCompositeType(v.Location, v.QualifiedIdentifier)
+
+	if len(v.TypeArguments) == 0 {
+		return CompositeDynamicType{
+			StaticType: staticType,
+		}
+	}
+
 	return CompositeDynamicType{
-		StaticType: staticType,
+		StaticType: interpreter.ConvertStaticToSemaType(v.StaticType()),
 	}
 }
 
 func (v *CompositeValue) StaticType() StaticType {
-	return CompositeStaticType{
+	staticType := CompositeStaticType{
 		Location:            v.Location,
 		QualifiedIdentifier: v.QualifiedIdentifier,
 	}
+
+	if len(v.TypeArguments) == 0 {
+		return staticType
+	}
+
+	return InstantiatedStaticType{
+		Type:          staticType,
+		TypeArguments: v.TypeArguments,
+	}
 }
 
 func (v *CompositeValue) Copy() Value {
@@ -5372,6 +7245,14 @@ func (v *CompositeValue) Copy() Value {
 		newFields.Set(fieldName, value.Copy())
 	})
 
+	var newExtensions *StringValueOrderedMap
+	if v.Extensions != nil {
+		newExtensions = NewStringValueOrderedMap()
+		v.Extensions.Foreach(func(typeID string, extensionFields Value) {
+			newExtensions.Set(typeID, extensionFields.Copy())
+		})
+	}
+
 	// NOTE: not copying functions or destructor – they are linked in
 
 	return &CompositeValue{
@@ -5382,6 +7263,8 @@ func (v *CompositeValue) Copy() Value {
 		InjectedFields:      v.InjectedFields,
 		ComputedFields:      v.ComputedFields,
 		NestedVariables:     v.NestedVariables,
+		Extensions:          newExtensions,
+		TypeArguments:       v.TypeArguments,
 		Functions:           v.Functions,
 		Destructor:          v.Destructor,
 		destroyed:           v.destroyed,
@@ -5414,6 +7297,12 @@ func (v *CompositeValue) SetOwner(owner *common.Address) {
 	v.Fields.Foreach(func(_ string, value Value) {
 		value.SetOwner(owner)
 	})
+
+	if v.Extensions != nil {
+		v.Extensions.Foreach(func(_ string, extensionFields Value) {
+			extensionFields.SetOwner(owner)
+		})
+	}
 }
 
 func (v *CompositeValue) IsModified() bool {
@@ -5435,6 +7324,14 @@ func (v *CompositeValue) IsModified() bool {
 		}
 	}
 
+	if v.Extensions != nil {
+		for pair := v.Extensions.Oldest(); pair != nil; pair = pair.Next() {
+			if pair.Value.IsModified() {
+				return true
+			}
+		}
+	}
+
 	if v.NestedVariables != nil {
 		for pair := v.NestedVariables.Oldest(); pair != nil; pair = pair.Next() {
 			if pair.Value.GetValue().IsModified() {
@@ -5558,6 +7455,78 @@ func (v *CompositeValue) SetMember(_ *Interpreter, getLocationRange func() Locat
 	v.Fields.Set(name, value)
 }
 
+// GetExtensionField returns the value of the field with the given name,
+// which the extension with the given type ID stores alongside the composite value,
+// or nil if the field was never set.
+//
+func (v *CompositeValue) GetExtensionField(
+	getLocationRange func() LocationRange,
+	extensionTypeID sema.TypeID,
+	name string,
+) Value {
+	v.checkStatus(getLocationRange)
+
+	if v.Extensions == nil {
+		return nil
+	}
+
+	extensionFields, ok := v.Extensions.Get(string(extensionTypeID))
+	if !ok {
+		return nil
+	}
+
+	value, ok := extensionFields.(*CompositeValue).Fields.Get(name)
+	if !ok {
+		return nil
+	}
+
+	return value
+}
+
+// SetExtensionField sets the value of the field with the given name
+// of the given extension, which is stored alongside the composite value.
+//
+// The fields of an extension are held by a structure value
+// which has the type ID of the extension.
+//
+func (v *CompositeValue) SetExtensionField(
+	getLocationRange func() LocationRange,
+	extensionType *sema.ExtensionType,
+	name string,
+	value Value,
+) {
+	v.checkStatus(getLocationRange)
+
+	v.modified = true
+
+	value.SetOwner(v.Owner)
+
+	if v.Extensions == nil {
+		v.Extensions = NewStringValueOrderedMap()
+	}
+
+	extensionTypeID := string(extensionType.ID())
+
+	var extensionFields *CompositeValue
+
+	existing, ok := v.Extensions.Get(extensionTypeID)
+	if ok {
+		extensionFields = existing.(*CompositeValue)
+	} else {
+		extensionFields = NewCompositeValue(
+			extensionType.Location,
+			extensionType.QualifiedIdentifier(),
+			common.CompositeKindStructure,
+			nil,
+			v.Owner,
+		)
+		v.Extensions.Set(extensionTypeID, extensionFields)
+	}
+
+	extensionFields.modified = true
+	extensionFields.Fields.Set(name, value)
+}
+
 func (v *CompositeValue) String() string {
 	return formatComposite(string(v.TypeID()), v.Fields)
 }
@@ -5961,6 +7930,37 @@ func (v *DictionaryValue) GetMember(interpreter *Interpreter, getLocationRange f
 			},
 		)
 
+	case sema.DictionaryTypeForEachKeyFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				f := ArgumentFunctionInvoker(invocation, 0)
+				reportIteration := ElementIterationReporter(invocation)
+
+				v.ForEachKey(func(key Value) bool {
+					reportIteration()
+					return bool(f(key).(BoolValue))
+				})
+
+				return VoidValue{}
+			},
+		)
+
+	case sema.DictionaryTypeFilterFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				predicate := ArgumentFunctionInvoker(invocation, 0)
+				reportIteration := ElementIterationReporter(invocation)
+
+				return v.Filter(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					func(key Value, value Value) bool {
+						reportIteration()
+						return bool(predicate(key, value).(BoolValue))
+					},
+				)
+			},
+		)
 	}
 
 	return nil
@@ -5975,6 +7975,51 @@ func (v *DictionaryValue) Count() int {
 	return v.Keys.Count()
 }
 
+// ForEachKey calls the given function with each key, in insertion order,
+// until the function returns false.
+//
+// The keys are iterated over a copy, so the function may modify the dictionary
+//
+func (v *DictionaryValue) ForEachKey(f func(key Value) bool) {
+	keys := make([]Value, len(v.Keys.Values))
+	copy(keys, v.Keys.Values)
+
+	for _, key := range keys {
+		if !f(key) {
+			return
+		}
+	}
+}
+
+// Filter returns a new dictionary which contains copies of the entries
+// for which the given predicate returns true
+//
+func (v *DictionaryValue) Filter(
+	inter *Interpreter,
+	getLocationRange func() LocationRange,
+	predicate func(key Value, value Value) bool,
+) *DictionaryValue {
+
+	var keysAndValues []Value
+
+	for _, key := range v.Keys.Values {
+		// Don't use `Entries` here: the value might be deferred and needs to be loaded.
+		// The entry might have been removed by the predicate
+		someValue, ok := v.Get(inter, getLocationRange, key).(*SomeValue)
+		if !ok {
+			continue
+		}
+
+		value := someValue.Value
+
+		if predicate(key, value) {
+			keysAndValues = append(keysAndValues, key.Copy(), value.Copy())
+		}
+	}
+
+	return NewDictionaryValueUnownedNonCopying(keysAndValues...)
+}
+
 // TODO: unset owner?
 func (v *DictionaryValue) Remove(inter *Interpreter, getLocationRange func() LocationRange, keyValue Value) OptionalValue {
 	v.modified = true
@@ -6263,6 +8308,7 @@ func (*SomeValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _
 
 type StorageReferenceValue struct {
 	Authorized           bool
+	Entitlements         []*sema.EntitlementType
 	TargetStorageAddress common.Address
 	TargetKey            string
 }
@@ -6286,8 +8332,9 @@ func (v *StorageReferenceValue) DynamicType(interpreter *Interpreter) DynamicTyp
 	innerType := (*referencedValue).DynamicType(interpreter)
 
 	return StorageReferenceDynamicType{
-		authorized: v.Authorized,
-		innerType:  innerType,
+		authorized:   v.Authorized,
+		entitlements: v.Entitlements,
+		innerType:    innerType,
 	}
 }
 
@@ -6299,6 +8346,7 @@ func (v *StorageReferenceValue) StaticType() StaticType {
 func (v *StorageReferenceValue) Copy() Value {
 	return &StorageReferenceValue{
 		Authorized:           v.Authorized,
+		Entitlements:         v.Entitlements,
 		TargetStorageAddress: v.TargetStorageAddress,
 		TargetKey:            v.TargetKey,
 	}
@@ -6392,8 +8440,9 @@ func (v *StorageReferenceValue) Equal(_ *Interpreter, other Value) BoolValue {
 // EphemeralReferenceValue
 
 type EphemeralReferenceValue struct {
-	Authorized bool
-	Value      Value
+	Authorized   bool
+	Entitlements []*sema.EntitlementType
+	Value        Value
 }
 
 func (*EphemeralReferenceValue) IsValue() {}
@@ -6415,8 +8464,9 @@ func (v *EphemeralReferenceValue) DynamicType(interpreter *Interpreter) DynamicT
 	innerType := (*referencedValue).DynamicType(interpreter)
 
 	return EphemeralReferenceDynamicType{
-		authorized: v.Authorized,
-		innerType:  innerType,
+		authorized:   v.Authorized,
+		entitlements: v.Entitlements,
+		innerType:    innerType,
 	}
 }
 
@@ -6645,6 +8695,8 @@ type AuthAccountValue struct {
 	Address                 AddressValue
 	storageUsedGet          func(interpreter *Interpreter) UInt64Value
 	storageCapacityGet      func() UInt64Value
+	balanceGet              func() UFix64Value
+	availableBalanceGet     func() UFix64Value
 	addPublicKeyFunction    FunctionValue
 	removePublicKeyFunction FunctionValue
 	contracts               AuthAccountContractsValue
@@ -6655,6 +8707,8 @@ func NewAuthAccountValue(
 	address AddressValue,
 	storageUsedGet func(interpreter *Interpreter) UInt64Value,
 	storageCapacityGet func() UInt64Value,
+	balanceGet func() UFix64Value,
+	availableBalanceGet func() UFix64Value,
 	addPublicKeyFunction FunctionValue,
 	removePublicKeyFunction FunctionValue,
 	contracts AuthAccountContractsValue,
@@ -6664,6 +8718,8 @@ func NewAuthAccountValue(
 		Address:                 address,
 		storageUsedGet:          storageUsedGet,
 		storageCapacityGet:      storageCapacityGet,
+		balanceGet:              balanceGet,
+		availableBalanceGet:     availableBalanceGet,
 		addPublicKeyFunction:    addPublicKeyFunction,
 		removePublicKeyFunction: removePublicKeyFunction,
 		contracts:               contracts,
@@ -6764,6 +8820,12 @@ func (v AuthAccountValue) GetMember(inter *Interpreter, _ func() LocationRange,
 	case "storageCapacity":
 		return v.storageCapacityGet()
 
+	case "balance":
+		return v.balanceGet()
+
+	case "availableBalance":
+		return v.availableBalanceGet()
+
 	case "addPublicKey":
 		return v.addPublicKeyFunction
 
@@ -6810,24 +8872,30 @@ func (AuthAccountValue) SetMember(_ *Interpreter, _ func() LocationRange, _ stri
 // PublicAccountValue
 
 type PublicAccountValue struct {
-	Address            AddressValue
-	storageUsedGet     func(interpreter *Interpreter) UInt64Value
-	storageCapacityGet func() UInt64Value
-	Identifier         string
-	keys               *CompositeValue
+	Address             AddressValue
+	storageUsedGet      func(interpreter *Interpreter) UInt64Value
+	storageCapacityGet  func() UInt64Value
+	balanceGet          func() UFix64Value
+	availableBalanceGet func() UFix64Value
+	Identifier          string
+	keys                *CompositeValue
 }
 
 func NewPublicAccountValue(
 	address AddressValue,
 	storageUsedGet func(interpreter *Interpreter) UInt64Value,
 	storageCapacityGet func() UInt64Value,
+	balanceGet func() UFix64Value,
+	availableBalanceGet func() UFix64Value,
 	keys *CompositeValue,
 ) PublicAccountValue {
 	return PublicAccountValue{
-		Address:            address,
-		storageUsedGet:     storageUsedGet,
-		storageCapacityGet: storageCapacityGet,
-		keys:               keys,
+		Address:             address,
+		storageUsedGet:      storageUsedGet,
+		storageCapacityGet:  storageCapacityGet,
+		balanceGet:          balanceGet,
+		availableBalanceGet: availableBalanceGet,
+		keys:                keys,
 	}
 }
 
@@ -6891,6 +8959,12 @@ func (v PublicAccountValue) GetMember(inter *Interpreter, _ func() LocationRange
 	case "storageCapacity":
 		return v.storageCapacityGet()
 
+	case "balance":
+		return v.balanceGet()
+
+	case "availableBalance":
+		return v.availableBalanceGet()
+
 	case "getCapability":
 		return accountGetCapabilityFunction(v.Address, false)
 
@@ -7169,29 +9243,96 @@ func NewPublicKeyValue(publicKey *ArrayValue, signAlgo *CompositeValue) *Composi
 }
 
 // NewAuthAccountKeysValue constructs a AuthAccount.Keys value.
-func NewAuthAccountKeysValue(addFunction FunctionValue, getFunction FunctionValue, revokeFunction FunctionValue) *CompositeValue {
+func NewAuthAccountKeysValue(
+	addFunction FunctionValue,
+	getFunction FunctionValue,
+	revokeFunction FunctionValue,
+	countGet ComputedField,
+	forEachFunction FunctionValue,
+	getByPublicKeyFunction FunctionValue,
+) *CompositeValue {
 	fields := NewStringValueOrderedMap()
 	fields.Set(sema.AccountKeysAddFunctionName, addFunction)
 	fields.Set(sema.AccountKeysGetFunctionName, getFunction)
 	fields.Set(sema.AccountKeysRevokeFunctionName, revokeFunction)
+	fields.Set(sema.AccountKeysForEachFunctionName, forEachFunction)
+	fields.Set(sema.AccountKeysGetByPublicKeyFunctionName, getByPublicKeyFunction)
+
+	computedFields := NewStringComputedFieldOrderedMap()
+	computedFields.Set(sema.AccountKeysCountFieldName, countGet)
 
 	return &CompositeValue{
 		QualifiedIdentifier: sema.AuthAccountKeysType.QualifiedIdentifier(),
 		Kind:                sema.AuthAccountKeysType.Kind,
 		Fields:              fields,
+		ComputedFields:      computedFields,
 	}
 }
 
 // NewPublicAccountKeysValue constructs a PublicAccount.Keys value.
-func NewPublicAccountKeysValue(getFunction FunctionValue) *CompositeValue {
+func NewPublicAccountKeysValue(
+	getFunction FunctionValue,
+	countGet ComputedField,
+	forEachFunction FunctionValue,
+	getByPublicKeyFunction FunctionValue,
+) *CompositeValue {
 	fields := NewStringValueOrderedMap()
 	fields.Set(sema.AccountKeysGetFunctionName, getFunction)
+	fields.Set(sema.AccountKeysForEachFunctionName, forEachFunction)
+	fields.Set(sema.AccountKeysGetByPublicKeyFunctionName, getByPublicKeyFunction)
+
+	computedFields := NewStringComputedFieldOrderedMap()
+	computedFields.Set(sema.AccountKeysCountFieldName, countGet)
 
 	return &CompositeValue{
 		QualifiedIdentifier: sema.PublicAccountKeysType.QualifiedIdentifier(),
 		Kind:                sema.PublicAccountKeysType.Kind,
 		Fields:              fields,
+		ComputedFields:      computedFields,
+	}
+}
+
+// hashAlgorithmFunctions are the functions of HashAlgorithm values
+//
+var hashAlgorithmFunctions = map[string]FunctionValue{
+	sema.HashAlgorithmTypeHashWithTagFunctionName: NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			tagValue, ok := invocation.Arguments[1].(*StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return hash(invocation, tagValue.Str)
+		},
+	),
+}
+
+func hash(invocation Invocation, tag string) Value {
+	data, err := ByteArrayValueToByteSlice(invocation.Arguments[0])
+	if err != nil {
+		panic(fmt.Errorf("hash: invalid data argument: %w", err))
+	}
+
+	rawValue, ok := invocation.Self.Fields.Get(sema.EnumRawValueFieldName)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	hashAlgorithm := sema.HashAlgorithm(rawValue.(UInt8Value))
+
+	inter := invocation.Interpreter
+	if inter.hashHandler == nil {
+		panic(HashUnavailableError{
+			LocationRange: invocation.GetLocationRange(),
+		})
+	}
+
+	digest, err := inter.hashHandler(data, tag, hashAlgorithm)
+	if err != nil {
+		panic(err)
 	}
+
+	return ByteSliceToByteArrayValue(digest)
 }
 
 func NewCryptoAlgorithmEnumCaseValue(enumType *sema.CompositeType, rawValue uint8) *CompositeValue {
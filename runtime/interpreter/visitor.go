@@ -45,6 +45,8 @@ type Visitor interface {
 	VisitWord64Value(interpreter *Interpreter, value Word64Value)
 	VisitFix64Value(interpreter *Interpreter, value Fix64Value)
 	VisitUFix64Value(interpreter *Interpreter, value UFix64Value)
+	VisitFix128Value(interpreter *Interpreter, value Fix128Value)
+	VisitUFix128Value(interpreter *Interpreter, value UFix128Value)
 	VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool
 	VisitDictionaryValue(interpreter *Interpreter, value *DictionaryValue) bool
 	VisitNilValue(interpreter *Interpreter, value NilValue)
@@ -91,6 +93,8 @@ type EmptyVisitor struct {
 	Word64ValueVisitor               func(interpreter *Interpreter, value Word64Value)
 	Fix64ValueVisitor                func(interpreter *Interpreter, value Fix64Value)
 	UFix64ValueVisitor               func(interpreter *Interpreter, value UFix64Value)
+	Fix128ValueVisitor               func(interpreter *Interpreter, value Fix128Value)
+	UFix128ValueVisitor              func(interpreter *Interpreter, value UFix128Value)
 	CompositeValueVisitor            func(interpreter *Interpreter, value *CompositeValue) bool
 	DictionaryValueVisitor           func(interpreter *Interpreter, value *DictionaryValue) bool
 	NilValueVisitor                  func(interpreter *Interpreter, value NilValue)
@@ -294,6 +298,20 @@ func (v EmptyVisitor) VisitUFix64Value(interpreter *Interpreter, value UFix64Val
 	v.UFix64ValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitFix128Value(interpreter *Interpreter, value Fix128Value) {
+	if v.Fix128ValueVisitor == nil {
+		return
+	}
+	v.Fix128ValueVisitor(interpreter, value)
+}
+
+func (v EmptyVisitor) VisitUFix128Value(interpreter *Interpreter, value UFix128Value) {
+	if v.UFix128ValueVisitor == nil {
+		return
+	}
+	v.UFix128ValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool {
 	if v.CompositeValueVisitor == nil {
 		return true
@@ -22,16 +22,15 @@ import (
 	"fmt"
 
 	"github.com/onflow/cadence"
-	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/parser2"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
-var InvalidLiteralError = fmt.Errorf("invalid literal")
-var UnsupportedLiteralError = fmt.Errorf("unsupported literal")
-var LiteralExpressionTypeError = fmt.Errorf("input is not a literal")
+var InvalidLiteralError = interpreter.InvalidLiteralError
+var UnsupportedLiteralError = interpreter.UnsupportedLiteralError
+var LiteralExpressionTypeError = interpreter.LiteralExpressionTypeError
 
 // ParseLiteral parses a single literal string, that should have the given type.
 //
@@ -126,102 +125,21 @@ func pathLiteralValue(expression ast.Expression, ty sema.Type) (cadence.Value, e
 }
 
 func integerLiteralValue(expression ast.Expression, ty sema.Type) (cadence.Value, error) {
-	integerExpression, ok := expression.(*ast.IntegerExpression)
-	if !ok {
-		return nil, LiteralExpressionTypeError
-	}
-
-	if !sema.CheckIntegerLiteral(integerExpression, ty, nil) {
-		return nil, InvalidLiteralError
-	}
-
-	intValue := interpreter.NewIntValueFromBigInt(integerExpression.Value)
-
-	convertedValue, err := convertIntValue(intValue, ty)
+	value, err := interpreter.IntegerLiteralValue(expression, ty)
 	if err != nil {
 		return nil, err
 	}
 
-	result := ExportValue(convertedValue, nil)
-
-	return result, nil
-}
-
-func convertIntValue(intValue interpreter.IntValue, ty sema.Type) (interpreter.Value, error) {
-
-	switch ty.(type) {
-	case *sema.IntType, *sema.IntegerType, *sema.SignedIntegerType:
-		return intValue, nil
-	case *sema.Int8Type:
-		return interpreter.ConvertInt8(intValue), nil
-	case *sema.Int16Type:
-		return interpreter.ConvertInt16(intValue), nil
-	case *sema.Int32Type:
-		return interpreter.ConvertInt32(intValue), nil
-	case *sema.Int64Type:
-		return interpreter.ConvertInt64(intValue), nil
-	case *sema.Int128Type:
-		return interpreter.ConvertInt128(intValue), nil
-	case *sema.Int256Type:
-		return interpreter.ConvertInt256(intValue), nil
-
-	case *sema.UIntType:
-		return interpreter.ConvertUInt(intValue), nil
-	case *sema.UInt8Type:
-		return interpreter.ConvertUInt8(intValue), nil
-	case *sema.UInt16Type:
-		return interpreter.ConvertUInt16(intValue), nil
-	case *sema.UInt32Type:
-		return interpreter.ConvertUInt32(intValue), nil
-	case *sema.UInt64Type:
-		return interpreter.ConvertUInt64(intValue), nil
-	case *sema.UInt128Type:
-		return interpreter.ConvertUInt128(intValue), nil
-	case *sema.UInt256Type:
-		return interpreter.ConvertUInt256(intValue), nil
-
-	case *sema.Word8Type:
-		return interpreter.ConvertWord8(intValue), nil
-	case *sema.Word16Type:
-		return interpreter.ConvertWord16(intValue), nil
-	case *sema.Word32Type:
-		return interpreter.ConvertWord32(intValue), nil
-	case *sema.Word64Type:
-		return interpreter.ConvertWord64(intValue), nil
-
-	default:
-		return nil, UnsupportedLiteralError
-	}
+	return ExportValue(value, nil), nil
 }
 
 func fixedPointLiteralValue(expression ast.Expression, ty sema.Type) (cadence.Value, error) {
-	fixedPointExpression, ok := expression.(*ast.FixedPointExpression)
-	if !ok {
-		return nil, LiteralExpressionTypeError
-	}
-
-	if !sema.CheckFixedPointLiteral(fixedPointExpression, ty, nil) {
-		return nil, InvalidLiteralError
-	}
-
-	// TODO: adjust once/if we support more fixed point types
-
-	value := fixedpoint.ConvertToFixedPointBigInt(
-		fixedPointExpression.Negative,
-		fixedPointExpression.UnsignedInteger,
-		fixedPointExpression.Fractional,
-		fixedPointExpression.Scale,
-		sema.Fix64Scale,
-	)
-
-	switch ty.(type) {
-	case *sema.Fix64Type, *sema.FixedPointType, *sema.SignedFixedPointType:
-		return cadence.Fix64(value.Int64()), nil
-	case *sema.UFix64Type:
-		return cadence.UFix64(value.Uint64()), nil
+	value, err := interpreter.FixedPointLiteralValue(expression, ty)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, UnsupportedLiteralError
+	return ExportValue(value, nil), nil
 }
 
 func LiteralValue(expression ast.Expression, ty sema.Type) (cadence.Value, error) {
@@ -280,16 +198,12 @@ func LiteralValue(expression ast.Expression, ty sema.Type) (cadence.Value, error
 		return cadence.NewDictionary(pairs), nil
 
 	case *sema.AddressType:
-		expression, ok := expression.(*ast.IntegerExpression)
-		if !ok {
-			return nil, LiteralExpressionTypeError
-		}
-
-		if !sema.CheckAddressLiteral(expression, nil) {
-			return nil, InvalidLiteralError
+		value, err := interpreter.AddressLiteralValue(expression)
+		if err != nil {
+			return nil, err
 		}
 
-		return cadence.BytesToAddress(expression.Value.Bytes()), nil
+		return ExportValue(value, nil), nil
 	}
 
 	switch ty {
@@ -403,6 +403,30 @@ func TestLiteralValue(t *testing.T) {
 		require.Nil(t, value)
 	})
 
+	t.Run("Fix128, valid literal, negative", func(t *testing.T) {
+		expected, err := cadence.NewFix128("-1.000000000000000000000001")
+		require.NoError(t, err)
+
+		value, err := ParseLiteral(`-1.000000000000000000000001`, &sema.Fix128Type{})
+		require.NoError(t, err)
+		require.Equal(t, expected, value)
+	})
+
+	t.Run("UFix128, valid literal, positive", func(t *testing.T) {
+		expected, err := cadence.NewUFix128("1.5")
+		require.NoError(t, err)
+
+		value, err := ParseLiteral(`1.5`, &sema.UFix128Type{})
+		require.NoError(t, err)
+		require.Equal(t, expected, value)
+	})
+
+	t.Run("UFix128, invalid literal, negative", func(t *testing.T) {
+		value, err := ParseLiteral(`-1.0`, &sema.UFix128Type{})
+		require.Error(t, err)
+		require.Nil(t, value)
+	})
+
 	t.Run("FixedPoint, valid literal, positive", func(t *testing.T) {
 		expected, err := cadence.NewFix64FromParts(false, 1, 0)
 		require.NoError(t, err)
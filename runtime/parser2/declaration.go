@@ -74,7 +74,23 @@ func parseDeclaration(p *parser, docString string) ast.Declaration {
 				return parseVariableDeclaration(p, access, accessPos, docString)
 
 			case keywordFun:
-				return parseFunctionDeclaration(p, false, access, accessPos, docString)
+				return parseFunctionDeclaration(p, false, access, accessPos, ast.FunctionPurityUnspecified, nil, docString)
+
+			case keywordView:
+				// The `view` keyword is only a modifier if it is followed by the `fun` keyword,
+				// otherwise it is an identifier, e.g. in a statement
+
+				if !isNextTokenKeywordFun(p) {
+					return nil
+				}
+
+				purityPos := p.current.StartPos
+
+				// Skip the `view` keyword
+				p.next()
+				p.skipSpaceAndComments(true)
+
+				return parseFunctionDeclaration(p, false, access, accessPos, ast.FunctionPurityView, &purityPos, docString)
 
 			case keywordImport:
 				return parseImportDeclaration(p)
@@ -85,6 +101,15 @@ func parseDeclaration(p *parser, docString string) ast.Declaration {
 			case keywordStruct, keywordResource, keywordContract, keywordEnum:
 				return parseCompositeOrInterfaceDeclaration(p, access, accessPos, docString)
 
+			case keywordTypeAlias:
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
+			case keywordExtension:
+				return parseExtensionDeclaration(p, access, accessPos, docString)
+
+			case keywordEntitlement:
+				return parseEntitlementDeclaration(p, access, accessPos, docString)
+
 			case KeywordTransaction:
 				if access != ast.AccessNotSpecified {
 					panic(fmt.Errorf("invalid access modifier for transaction"))
@@ -97,7 +122,11 @@ func parseDeclaration(p *parser, docString string) ast.Declaration {
 				}
 				pos := p.current.StartPos
 				accessPos = &pos
-				access = parseAccess(p)
+				var entitlements *ast.EntitlementSet
+				access, entitlements = parseAccess(p)
+				if entitlements != nil {
+					panic(fmt.Errorf("entitlement access is only allowed for fields and functions of composites"))
+				}
 				continue
 			}
 		}
@@ -106,27 +135,28 @@ func parseDeclaration(p *parser, docString string) ast.Declaration {
 	}
 }
 
-// parseAccess parses an access modifier
+// parseAccess parses an access modifier.
+// If the access modifier requires entitlements, the entitlement set is returned, too.
 //
 //     access
 //         : 'priv'
 //         | 'pub' ( '(' 'set' ')' )?
-//         | 'access' '(' ( 'self' | 'contract' | 'account' | 'all' ) ')'
+//         | 'access' '(' ( 'self' | 'contract' | 'account' | 'all' | entitlementSet ) ')'
 //
-func parseAccess(p *parser) ast.Access {
+func parseAccess(p *parser) (ast.Access, *ast.EntitlementSet) {
 
 	switch p.current.Value {
 	case keywordPriv:
 		// Skip the `priv` keyword
 		p.next()
-		return ast.AccessPrivate
+		return ast.AccessPrivate, nil
 
 	case keywordPub:
 		// Skip the `pub` keyword
 		p.next()
 		p.skipSpaceAndComments(true)
 		if !p.current.Is(lexer.TokenParenOpen) {
-			return ast.AccessPublic
+			return ast.AccessPublic, nil
 		}
 
 		// Skip the opening paren
@@ -154,7 +184,7 @@ func parseAccess(p *parser) ast.Access {
 
 		p.mustOne(lexer.TokenParenClose)
 
-		return ast.AccessPublicSettable
+		return ast.AccessPublicSettable, nil
 
 	case keywordAccess:
 		// Skip the `access` keyword
@@ -197,19 +227,13 @@ func parseAccess(p *parser) ast.Access {
 			access = ast.AccessPrivate
 
 		default:
-			panic(fmt.Errorf(
-				"expected keyword %s, got %q",
-				common.EnumerateWords(
-					[]string{
-						strconv.Quote(keywordAll),
-						strconv.Quote(keywordAccount),
-						strconv.Quote(keywordContract),
-						strconv.Quote(keywordSelf),
-					},
-					"or",
-				),
-				p.current.Value,
-			))
+			// Any other identifier is the start of an entitlement set
+
+			entitlements := parseEntitlementSet(p)
+
+			p.mustOne(lexer.TokenParenClose)
+
+			return ast.AccessEntitlements, entitlements
 		}
 
 		// Skip the keyword
@@ -218,13 +242,77 @@ func parseAccess(p *parser) ast.Access {
 
 		p.mustOne(lexer.TokenParenClose)
 
-		return access
+		return access, nil
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
 }
 
+// parseEntitlementSet parses the entitlements of an entitlement access modifier.
+// The entitlements are either separated by commas, i.e. all entitlements are required,
+// or by vertical bars, i.e. one of the entitlements is required.
+//
+//     entitlementSet
+//         : nominalType ( ',' nominalType )*
+//         | nominalType ( '|' nominalType )*
+//
+func parseEntitlementSet(p *parser) *ast.EntitlementSet {
+
+	entitlementSet := &ast.EntitlementSet{}
+
+	for {
+		p.skipSpaceAndComments(true)
+
+		if !p.current.Is(lexer.TokenIdentifier) {
+			panic(fmt.Errorf(
+				"expected entitlement, got %s",
+				p.current.Type,
+			))
+		}
+
+		identifierToken := p.current
+
+		// Skip the identifier
+		p.next()
+
+		entitlement := parseNominalTypeRemainder(p, identifierToken)
+		entitlementSet.Entitlements = append(entitlementSet.Entitlements, entitlement)
+
+		p.skipSpaceAndComments(true)
+
+		switch p.current.Type {
+		case lexer.TokenComma, lexer.TokenVerticalBar:
+			isDisjunction := p.current.Is(lexer.TokenVerticalBar)
+
+			if len(entitlementSet.Entitlements) > 1 &&
+				isDisjunction != entitlementSet.IsDisjunction {
+
+				panic(fmt.Errorf(
+					"unexpected %s, entitlements must either all be separated by %s or by %s",
+					p.current.Type,
+					lexer.TokenComma,
+					lexer.TokenVerticalBar,
+				))
+			}
+
+			entitlementSet.IsDisjunction = isDisjunction
+
+			// Skip the separator
+			p.next()
+
+		default:
+			entitlements := entitlementSet.Entitlements
+			entitlementSet.Range = ast.Range{
+				StartPos: entitlements[0].StartPosition(),
+				EndPos:   entitlements[len(entitlements)-1].EndPosition(),
+			}
+
+			return entitlementSet
+		}
+	}
+}
+
 // parseVariableDeclaration parses a variable declaration.
 //
 //     variableKind : 'var' | 'let'
@@ -344,6 +432,174 @@ func parseTransfer(p *parser) *ast.Transfer {
 	}
 }
 
+// parseTypeAliasDeclaration parses a type alias declaration.
+//
+//     typeAliasDeclaration : 'typealias' identifier '=' type
+//
+func parseTypeAliasDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) *ast.TypeAliasDeclaration {
+
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `typealias` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	if !p.current.Is(lexer.TokenIdentifier) {
+		panic(fmt.Errorf(
+			"expected identifier after start of type alias declaration, got %s",
+			p.current.Type,
+		))
+	}
+
+	identifier := tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	p.mustOne(lexer.TokenEqual)
+	p.skipSpaceAndComments(true)
+
+	aliasedType := parseType(p, lowestBindingPower)
+
+	return &ast.TypeAliasDeclaration{
+		Access:      access,
+		Identifier:  identifier,
+		AliasedType: aliasedType,
+		DocString:   docString,
+		Range: ast.Range{
+			StartPos: startPos,
+			EndPos:   aliasedType.EndPosition(),
+		},
+	}
+}
+
+// parseEntitlementDeclaration parses an entitlement declaration.
+//
+//     entitlementDeclaration : 'entitlement' identifier
+//
+func parseEntitlementDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) *ast.EntitlementDeclaration {
+
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `entitlement` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	if !p.current.Is(lexer.TokenIdentifier) {
+		panic(fmt.Errorf(
+			"expected identifier after start of entitlement declaration, got %s",
+			p.current.Type,
+		))
+	}
+
+	identifier := tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+
+	return &ast.EntitlementDeclaration{
+		Access:     access,
+		Identifier: identifier,
+		DocString:  docString,
+		Range: ast.Range{
+			StartPos: startPos,
+			EndPos:   identifier.EndPosition(),
+		},
+	}
+}
+
+// parseExtensionDeclaration parses an extension declaration.
+//
+//     extensionDeclaration : 'extension' identifier 'for' nominalType
+//                            '{' membersAndNestedDeclarations '}'
+//
+func parseExtensionDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) *ast.ExtensionDeclaration {
+
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `extension` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	if !p.current.Is(lexer.TokenIdentifier) {
+		panic(fmt.Errorf(
+			"expected identifier after start of extension declaration, got %s",
+			p.current.Type,
+		))
+	}
+
+	identifier := tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	if !p.current.IsString(lexer.TokenIdentifier, keywordFor) {
+		panic(fmt.Errorf(
+			"expected keyword %q, got %s",
+			keywordFor,
+			p.current.Type,
+		))
+	}
+
+	// Skip the `for` keyword
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	// NOTE: the base type is parsed as a nominal type, and not using `parseType`,
+	// as the opening brace of the members would otherwise be parsed as a restriction
+
+	baseTypeToken := p.mustOne(lexer.TokenIdentifier)
+	baseType := parseNominalTypeRemainder(p, baseTypeToken)
+
+	p.skipSpaceAndComments(true)
+
+	p.mustOne(lexer.TokenBraceOpen)
+
+	members := parseMembersAndNestedDeclarations(p, lexer.TokenBraceClose)
+
+	p.skipSpaceAndComments(true)
+
+	endToken := p.mustOne(lexer.TokenBraceClose)
+
+	return &ast.ExtensionDeclaration{
+		Access:     access,
+		Identifier: identifier,
+		BaseType:   baseType,
+		Members:    members,
+		DocString:  docString,
+		Range: ast.Range{
+			StartPos: startPos,
+			EndPos:   endToken.EndPos,
+		},
+	}
+}
+
 func parsePragmaDeclaration(p *parser) *ast.PragmaDeclaration {
 	startPos := p.current.StartPosition()
 	p.next()
@@ -567,6 +823,19 @@ func parseImportDeclaration(p *parser) *ast.ImportDeclaration {
 	}
 }
 
+// isNextTokenKeywordFun checks whether the token to follow is the `fun` keyword.
+func isNextTokenKeywordFun(p *parser) bool {
+	p.startBuffering()
+	defer p.replayBuffered()
+
+	// skip the current token
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	// Lookahead the next token
+	return p.current.IsString(lexer.TokenIdentifier, keywordFun)
+}
+
 // isNextTokenCommaOrFrom check whether the token to follow is a comma or a from token.
 func isNextTokenCommaOrFrom(p *parser) bool {
 	p.startBuffering()
@@ -758,7 +1027,11 @@ func parseFieldWithVariableKind(
 //
 //     conformances : ':' nominalType ( ',' nominalType )*
 //
-//     compositeDeclaration : compositeKind identifier conformances?
+//     typeParameterList : '<' typeParameter ( ',' typeParameter )* '>'
+//
+//     typeParameter : identifier ( ':' typeAnnotation )?
+//
+//     compositeDeclaration : compositeKind identifier typeParameterList? conformances?
 //                            '{' membersAndNestedDeclarations '}'
 //
 //     interfaceDeclaration : compositeKind 'interface' identifier conformances?
@@ -817,6 +1090,13 @@ func parseCompositeOrInterfaceDeclaration(
 
 	p.skipSpaceAndComments(true)
 
+	var typeParameterList *ast.TypeParameterList
+
+	if !isInterface && p.current.Is(lexer.TokenLess) {
+		typeParameterList = parseTypeParameterList(p)
+		p.skipSpaceAndComments(true)
+	}
+
 	var conformances []*ast.NominalType
 
 	if p.current.Is(lexer.TokenColon) {
@@ -865,13 +1145,14 @@ func parseCompositeOrInterfaceDeclaration(
 		}
 	} else {
 		return &ast.CompositeDeclaration{
-			Access:        access,
-			CompositeKind: compositeKind,
-			Identifier:    identifier,
-			Conformances:  conformances,
-			Members:       members,
-			DocString:     docString,
-			Range:         declarationRange,
+			Access:            access,
+			CompositeKind:     compositeKind,
+			Identifier:        identifier,
+			TypeParameterList: typeParameterList,
+			Conformances:      conformances,
+			Members:           members,
+			DocString:         docString,
+			Range:             declarationRange,
 		}
 	}
 }
@@ -921,6 +1202,8 @@ func parseMembersAndNestedDeclarations(p *parser, endTokenType lexer.TokenType)
 //                               | compositeDeclaration
 //                               | eventDeclaration
 //                               | enumCase
+//                               | typeAliasDeclaration
+//                               | extensionDeclaration
 //
 func parseMemberOrNestedDeclaration(p *parser, docString string) ast.Declaration {
 
@@ -929,6 +1212,16 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) ast.Declaration
 	access := ast.AccessNotSpecified
 	var accessPos *ast.Position
 
+	// Only fields and functions may have an entitlement access modifier
+
+	var entitlements *ast.EntitlementSet
+
+	rejectEntitlements := func() {
+		if entitlements != nil {
+			panic(fmt.Errorf("entitlement access is only allowed for fields and functions"))
+		}
+	}
+
 	var previousIdentifierToken *lexer.Token
 
 	for {
@@ -938,27 +1231,61 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) ast.Declaration
 		case lexer.TokenIdentifier:
 			switch p.current.Value {
 			case keywordLet, keywordVar:
-				return parseFieldWithVariableKind(p, access, accessPos, docString)
+				field := parseFieldWithVariableKind(p, access, accessPos, docString)
+				field.Entitlements = entitlements
+				return field
 
 			case keywordCase:
+				rejectEntitlements()
 				return parseEnumCase(p, access, accessPos, docString)
 
 			case keywordFun:
-				return parseFunctionDeclaration(p, functionBlockIsOptional, access, accessPos, docString)
+				// The function may be preceded by the `view` modifier,
+				// which was parsed as an identifier, as it might also be the name of a field
+
+				purity := ast.FunctionPurityUnspecified
+				var purityPos *ast.Position
+
+				if previousIdentifierToken != nil {
+					if !previousIdentifierToken.IsString(lexer.TokenIdentifier, keywordView) {
+						panic(fmt.Errorf("unexpected %s", p.current.Type))
+					}
+
+					purity = ast.FunctionPurityView
+					purityPos = &previousIdentifierToken.StartPos
+				}
+
+				function := parseFunctionDeclaration(p, functionBlockIsOptional, access, accessPos, purity, purityPos, docString)
+				function.Entitlements = entitlements
+				return function
 
 			case keywordEvent:
+				rejectEntitlements()
 				return parseEventDeclaration(p, access, accessPos, docString)
 
 			case keywordStruct, keywordResource, keywordContract, keywordEnum:
+				rejectEntitlements()
 				return parseCompositeOrInterfaceDeclaration(p, access, accessPos, docString)
 
+			case keywordTypeAlias:
+				rejectEntitlements()
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
+			case keywordExtension:
+				rejectEntitlements()
+				return parseExtensionDeclaration(p, access, accessPos, docString)
+
+			case keywordEntitlement:
+				rejectEntitlements()
+				return parseEntitlementDeclaration(p, access, accessPos, docString)
+
 			case keywordPriv, keywordPub, keywordAccess:
 				if access != ast.AccessNotSpecified {
 					panic(fmt.Errorf("unexpected access modifier"))
 				}
 				pos := p.current.StartPos
 				accessPos = &pos
-				access = parseAccess(p)
+				access, entitlements = parseAccess(p)
 				continue
 
 			default:
@@ -979,13 +1306,17 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) ast.Declaration
 			}
 
 			identifier := tokenToIdentifier(*previousIdentifierToken)
-			return parseFieldDeclarationWithoutVariableKind(p, access, accessPos, identifier, docString)
+			field := parseFieldDeclarationWithoutVariableKind(p, access, accessPos, identifier, docString)
+			field.Entitlements = entitlements
+			return field
 
 		case lexer.TokenParenOpen:
 			if previousIdentifierToken == nil {
 				panic(fmt.Errorf("unexpected %s", p.current.Type))
 			}
 
+			rejectEntitlements()
+
 			identifier := tokenToIdentifier(*previousIdentifierToken)
 			return parseSpecialFunctionDeclaration(p, functionBlockIsOptional, access, accessPos, identifier)
 		}
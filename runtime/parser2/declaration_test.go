@@ -932,6 +932,233 @@ func TestParseFunctionDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("with type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("fun f<T, U: @R>() {}")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.FunctionDeclaration{
+					Identifier: ast.Identifier{
+						Identifier: "f",
+						Pos:        ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+					TypeParameterList: &ast.TypeParameterList{
+						TypeParameters: []*ast.TypeParameter{
+							{
+								Identifier: ast.Identifier{
+									Identifier: "T",
+									Pos:        ast.Position{Line: 1, Column: 6, Offset: 6},
+								},
+							},
+							{
+								Identifier: ast.Identifier{
+									Identifier: "U",
+									Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+								},
+								TypeBound: &ast.TypeAnnotation{
+									IsResource: true,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "R",
+											Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+							EndPos:   ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+					ParameterList: &ast.ParameterList{
+						Parameters: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 15, Offset: 15},
+							EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					ReturnTypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "",
+								Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+					},
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 18, Offset: 18},
+								EndPos:   ast.Position{Line: 1, Column: 19, Offset: 19},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with empty type parameter list", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("fun f<>() {}")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected at least one type parameter",
+					Pos:     ast.Position{Offset: 7, Line: 1, Column: 7},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("view", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("view fun foo () { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.FunctionDeclaration{
+					Purity: ast.FunctionPurityView,
+					Identifier: ast.Identifier{
+						Identifier: "foo",
+						Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+					},
+					ParameterList: &ast.ParameterList{
+						Parameters: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+							EndPos:   ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+					ReturnTypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "",
+								Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+					},
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+								EndPos:   ast.Position{Line: 1, Column: 18, Offset: 18},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("pub view", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("pub view fun foo () { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.FunctionDeclaration{
+					Access: ast.AccessPublic,
+					Purity: ast.FunctionPurityView,
+					Identifier: ast.Identifier{
+						Identifier: "foo",
+						Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+					},
+					ParameterList: &ast.ParameterList{
+						Parameters: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 17, Offset: 17},
+							EndPos:   ast.Position{Line: 1, Column: 18, Offset: 18},
+						},
+					},
+					ReturnTypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "",
+								Pos:        ast.Position{Line: 1, Column: 18, Offset: 18},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 18, Offset: 18},
+					},
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 20, Offset: 20},
+								EndPos:   ast.Position{Line: 1, Column: 22, Offset: 22},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("view, not followed by fun", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("pub view let x = 1")
+		require.NotEmpty(t, errs)
+	})
+}
+
+func TestParseViewFunctionMembers(t *testing.T) {
+
+	t.Parallel()
+
+	result, errs := ParseDeclarations(`
+      struct interface I {
+          view fun foo(): Int
+          view: Int
+      }
+    `)
+	require.Empty(t, errs)
+
+	require.Len(t, result, 1)
+	require.IsType(t, &ast.InterfaceDeclaration{}, result[0])
+
+	members := result[0].(*ast.InterfaceDeclaration).Members
+
+	functions := members.Functions()
+	require.Len(t, functions, 1)
+
+	function := functions[0]
+	require.Equal(t, "foo", function.Identifier.Identifier)
+	require.Equal(t, ast.FunctionPurityView, function.Purity)
+	require.Equal(t, ast.Position{Offset: 38, Line: 3, Column: 10}, function.StartPos)
+	require.Nil(t, function.FunctionBlock)
+
+	fields := members.Fields()
+	require.Len(t, fields, 1)
+	require.Equal(t, "view", fields[0].Identifier.Identifier)
 }
 
 func TestParseAccess(t *testing.T) {
@@ -942,7 +1169,18 @@ func TestParseAccess(t *testing.T) {
 		return Parse(
 			input,
 			func(p *parser) interface{} {
-				return parseAccess(p)
+				access, _ := parseAccess(p)
+				return access
+			},
+		)
+	}
+
+	parseEntitlements := func(input string) (interface{}, []error) {
+		return Parse(
+			input,
+			func(p *parser) interface{} {
+				_, entitlements := parseAccess(p)
+				return entitlements
 			},
 		)
 	}
@@ -1143,26 +1381,98 @@ func TestParseAccess(t *testing.T) {
 		)
 	})
 
-	t.Run("access, invalid inner keyword", func(t *testing.T) {
+	t.Run("access(E)", func(t *testing.T) {
 
 		t.Parallel()
 
-		result, errs := parse("access ( foo )")
+		result, errs := parse("access ( E )")
+		require.Empty(t, errs)
+
 		utils.AssertEqualWithDiff(t,
-			[]error{
-				&SyntaxError{
-					Message: "expected keyword \"all\", \"account\", \"contract\", or \"self\", got \"foo\"",
-					Pos:     ast.Position{Offset: 9, Line: 1, Column: 9},
+			ast.AccessEntitlements,
+			result,
+		)
+	})
+
+	t.Run("access(E1, C.E2)", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := parseEntitlements("access ( E1 , C.E2 )")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.EntitlementSet{
+				Entitlements: []*ast.NominalType{
+					{
+						Identifier: ast.Identifier{
+							Identifier: "E1",
+							Pos:        ast.Position{Offset: 9, Line: 1, Column: 9},
+						},
+					},
+					{
+						Identifier: ast.Identifier{
+							Identifier: "C",
+							Pos:        ast.Position{Offset: 14, Line: 1, Column: 14},
+						},
+						NestedIdentifiers: []ast.Identifier{
+							{
+								Identifier: "E2",
+								Pos:        ast.Position{Offset: 16, Line: 1, Column: 16},
+							},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Offset: 9, Line: 1, Column: 9},
+					EndPos:   ast.Position{Offset: 17, Line: 1, Column: 17},
 				},
 			},
-			errs,
+			result,
 		)
+	})
+
+	t.Run("access(E1 | E2)", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := parseEntitlements("access ( E1 | E2 )")
+		require.Empty(t, errs)
 
 		utils.AssertEqualWithDiff(t,
-			nil,
+			&ast.EntitlementSet{
+				Entitlements: []*ast.NominalType{
+					{
+						Identifier: ast.Identifier{
+							Identifier: "E1",
+							Pos:        ast.Position{Offset: 9, Line: 1, Column: 9},
+						},
+					},
+					{
+						Identifier: ast.Identifier{
+							Identifier: "E2",
+							Pos:        ast.Position{Offset: 14, Line: 1, Column: 14},
+						},
+					},
+				},
+				IsDisjunction: true,
+				Range: ast.Range{
+					StartPos: ast.Position{Offset: 9, Line: 1, Column: 9},
+					EndPos:   ast.Position{Offset: 15, Line: 1, Column: 15},
+				},
+			},
 			result,
 		)
 	})
+
+	t.Run("access, mixed entitlement separators", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := parse("access ( E1 , E2 | E3 )")
+		require.Len(t, errs, 1)
+		require.IsType(t, &SyntaxError{}, errs[0])
+	})
 }
 
 func TestParseImportDeclaration(t *testing.T) {
@@ -1934,6 +2244,55 @@ func TestParseCompositeDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("struct, type parameters, one conformance", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("struct Box<T>: I { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessNotSpecified,
+					CompositeKind: common.CompositeKindStructure,
+					Identifier: ast.Identifier{
+						Identifier: "Box",
+						Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+					TypeParameterList: &ast.TypeParameterList{
+						TypeParameters: []*ast.TypeParameter{
+							{
+								Identifier: ast.Identifier{
+									Identifier: "T",
+									Pos:        ast.Position{Line: 1, Column: 11, Offset: 11},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 10, Offset: 10},
+							EndPos:   ast.Position{Line: 1, Column: 12, Offset: 12},
+						},
+					},
+					Conformances: []*ast.NominalType{
+						{
+							Identifier: ast.Identifier{
+								Identifier: "I",
+								Pos:        ast.Position{Line: 1, Column: 15, Offset: 15},
+							},
+						},
+					},
+					Members: &ast.Members{},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 19, Offset: 19},
+					},
+				},
+			},
+			result,
+		)
+	})
 }
 
 func TestParseInterfaceDeclaration(t *testing.T) {
@@ -4849,3 +5208,366 @@ func TestParsePreconditionWithUnaryNegation(t *testing.T) {
 		result.Declarations(),
 	)
 }
+
+func TestParseTypeAliasDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("top-level", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("pub typealias Balances = {Address: UFix64}")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.TypeAliasDeclaration{
+					Access: ast.AccessPublic,
+					Identifier: ast.Identifier{
+						Identifier: "Balances",
+						Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+					},
+					AliasedType: &ast.DictionaryType{
+						KeyType: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "Address",
+								Pos:        ast.Position{Line: 1, Column: 26, Offset: 26},
+							},
+						},
+						ValueType: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "UFix64",
+								Pos:        ast.Position{Line: 1, Column: 35, Offset: 35},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 25, Offset: 25},
+							EndPos:   ast.Position{Line: 1, Column: 41, Offset: 41},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 41, Offset: 41},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("struct S { typealias T = Int }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessNotSpecified,
+					CompositeKind: common.CompositeKindStructure,
+					Identifier: ast.Identifier{
+						Identifier: "S",
+						Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+					Members: ast.NewMembers(
+						[]ast.Declaration{
+							&ast.TypeAliasDeclaration{
+								Access: ast.AccessNotSpecified,
+								Identifier: ast.Identifier{
+									Identifier: "T",
+									Pos:        ast.Position{Line: 1, Column: 21, Offset: 21},
+								},
+								AliasedType: &ast.NominalType{
+									Identifier: ast.Identifier{
+										Identifier: "Int",
+										Pos:        ast.Position{Line: 1, Column: 25, Offset: 25},
+									},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+									EndPos:   ast.Position{Line: 1, Column: 27, Offset: 27},
+								},
+							},
+						},
+					),
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 29, Offset: 29},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid, missing equal sign", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("typealias T Int")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token '='",
+					Pos:     ast.Position{Offset: 12, Line: 1, Column: 12},
+				},
+			},
+			errs,
+		)
+	})
+}
+
+func TestParseExtensionDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("top-level", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("pub extension Rarity for NFTs.NFT { pub var tier: String? }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ExtensionDeclaration{
+					Access: ast.AccessPublic,
+					Identifier: ast.Identifier{
+						Identifier: "Rarity",
+						Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+					},
+					BaseType: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "NFTs",
+							Pos:        ast.Position{Line: 1, Column: 25, Offset: 25},
+						},
+						NestedIdentifiers: []ast.Identifier{
+							{
+								Identifier: "NFT",
+								Pos:        ast.Position{Line: 1, Column: 30, Offset: 30},
+							},
+						},
+					},
+					Members: ast.NewMembers(
+						[]ast.Declaration{
+							&ast.FieldDeclaration{
+								Access:       ast.AccessPublic,
+								VariableKind: ast.VariableKindVariable,
+								Identifier: ast.Identifier{
+									Identifier: "tier",
+									Pos:        ast.Position{Line: 1, Column: 44, Offset: 44},
+								},
+								TypeAnnotation: &ast.TypeAnnotation{
+									IsResource: false,
+									Type: &ast.OptionalType{
+										Type: &ast.NominalType{
+											Identifier: ast.Identifier{
+												Identifier: "String",
+												Pos:        ast.Position{Line: 1, Column: 50, Offset: 50},
+											},
+										},
+										EndPos: ast.Position{Line: 1, Column: 56, Offset: 56},
+									},
+									StartPos: ast.Position{Line: 1, Column: 50, Offset: 50},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 36, Offset: 36},
+									EndPos:   ast.Position{Line: 1, Column: 56, Offset: 56},
+								},
+							},
+						},
+					),
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 58, Offset: 58},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("contract C { extension E for S {} }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessNotSpecified,
+					CompositeKind: common.CompositeKindContract,
+					Identifier: ast.Identifier{
+						Identifier: "C",
+						Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+					},
+					Members: ast.NewMembers(
+						[]ast.Declaration{
+							&ast.ExtensionDeclaration{
+								Access: ast.AccessNotSpecified,
+								Identifier: ast.Identifier{
+									Identifier: "E",
+									Pos:        ast.Position{Line: 1, Column: 23, Offset: 23},
+								},
+								BaseType: &ast.NominalType{
+									Identifier: ast.Identifier{
+										Identifier: "S",
+										Pos:        ast.Position{Line: 1, Column: 29, Offset: 29},
+									},
+								},
+								Members: ast.NewMembers(nil),
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+									EndPos:   ast.Position{Line: 1, Column: 32, Offset: 32},
+								},
+							},
+						},
+					),
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 34, Offset: 34},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid, missing for", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("extension E S {}")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected keyword \"for\", got identifier",
+					Pos:     ast.Position{Offset: 12, Line: 1, Column: 12},
+				},
+			},
+			errs,
+		)
+	})
+}
+
+func TestParseEntitlementDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("top-level", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("pub entitlement Withdraw")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.EntitlementDeclaration{
+					Access: ast.AccessPublic,
+					Identifier: ast.Identifier{
+						Identifier: "Withdraw",
+						Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 23, Offset: 23},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("members with entitlement access", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(`
+          resource R {
+              access(E) let x: Int
+              access(E | F) fun f() {}
+          }
+        `)
+		require.Empty(t, errs)
+		require.Len(t, result, 1)
+
+		members := result[0].(*ast.CompositeDeclaration).Members
+
+		fields := members.Fields()
+		require.Len(t, fields, 1)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FieldDeclaration{
+				Access: ast.AccessEntitlements,
+				Entitlements: &ast.EntitlementSet{
+					Entitlements: []*ast.NominalType{
+						{
+							Identifier: ast.Identifier{
+								Identifier: "E",
+								Pos:        ast.Position{Offset: 45, Line: 3, Column: 21},
+							},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 45, Line: 3, Column: 21},
+						EndPos:   ast.Position{Offset: 45, Line: 3, Column: 21},
+					},
+				},
+				VariableKind: ast.VariableKindConstant,
+				Identifier: ast.Identifier{
+					Identifier: "x",
+					Pos:        ast.Position{Offset: 52, Line: 3, Column: 28},
+				},
+				TypeAnnotation: &ast.TypeAnnotation{
+					Type: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "Int",
+							Pos:        ast.Position{Offset: 55, Line: 3, Column: 31},
+						},
+					},
+					StartPos: ast.Position{Offset: 55, Line: 3, Column: 31},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Offset: 38, Line: 3, Column: 14},
+					EndPos:   ast.Position{Offset: 57, Line: 3, Column: 33},
+				},
+			},
+			fields[0],
+		)
+
+		functions := members.Functions()
+		require.Len(t, functions, 1)
+
+		utils.AssertEqualWithDiff(t,
+			ast.AccessEntitlements,
+			functions[0].Access,
+		)
+
+		utils.AssertEqualWithDiff(t,
+			"E | F",
+			functions[0].Entitlements.String(),
+		)
+	})
+
+	t.Run("invalid, entitlement access for composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("access(E) struct S {}")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "entitlement access is only allowed for fields and functions of composites",
+					Pos:     ast.Position{Offset: 9, Line: 1, Column: 9},
+				},
+			},
+			errs,
+		)
+	})
+}
@@ -486,6 +486,7 @@ func init() {
 	defineNestedExpression()
 	defineInvocationExpression()
 	defineArrayExpression()
+	defineStringTemplateExpression()
 	defineDictionaryExpression()
 	defineIndexExpression()
 	definePathExpression()
@@ -760,7 +761,29 @@ func defineIdentifierExpression() {
 				}
 
 			case keywordFun:
-				return parseFunctionExpression(p, token)
+				return parseFunctionExpression(p, token, ast.FunctionPurityUnspecified)
+
+			case keywordView:
+				// The `view` keyword is only a modifier if it is followed by the `fun` keyword,
+				// otherwise it is an identifier
+
+				p.startBuffering()
+				p.skipSpaceAndComments(true)
+
+				if !p.current.IsString(lexer.TokenIdentifier, keywordFun) {
+					p.replayBuffered()
+
+					return &ast.IdentifierExpression{
+						Identifier: tokenToIdentifier(token),
+					}
+				}
+
+				p.acceptBuffered()
+
+				// Skip the `fun` keyword
+				p.next()
+
+				return parseFunctionExpression(p, token, ast.FunctionPurityView)
 
 			default:
 				return &ast.IdentifierExpression{
@@ -771,12 +794,13 @@ func defineIdentifierExpression() {
 	})
 }
 
-func parseFunctionExpression(p *parser, token lexer.Token) *ast.FunctionExpression {
+func parseFunctionExpression(p *parser, token lexer.Token, purity ast.FunctionPurity) *ast.FunctionExpression {
 
 	parameterList, returnTypeAnnotation, functionBlock :=
 		parseFunctionParameterListAndRest(p, false)
 
 	return &ast.FunctionExpression{
+		Purity:               purity,
 		ParameterList:        parameterList,
 		ReturnTypeAnnotation: returnTypeAnnotation,
 		FunctionBlock:        functionBlock,
@@ -985,6 +1009,83 @@ func defineArrayExpression() {
 	)
 }
 
+func defineStringTemplateExpression() {
+	setExprNullDenotation(
+		lexer.TokenStringTemplateStart,
+		func(p *parser, startToken lexer.Token) ast.Expression {
+
+			// The start token includes the opening quote
+			// and the start of the interpolation, `\(`
+
+			startLiteral := startToken.Value.(string)
+			startValue, errs := parseStringLiteralContent(startLiteral[1 : len(startLiteral)-2])
+			p.report(errs...)
+
+			values := []string{startValue}
+			var expressions []ast.Expression
+
+			for {
+				expression := parseExpression(p, lowestBindingPower)
+				expressions = append(expressions, expression)
+
+				p.skipSpaceAndComments(true)
+
+				token := p.current
+				literal, ok := token.Value.(string)
+
+				switch {
+				case ok && token.Is(lexer.TokenStringTemplateMiddle):
+
+					// The middle token includes the end of the previous interpolation, `)`,
+					// and the start of the next interpolation, `\(`
+
+					value, errs := parseStringLiteralContent(literal[1 : len(literal)-2])
+					p.report(errs...)
+					values = append(values, value)
+
+					p.next()
+
+				case ok && token.Is(lexer.TokenStringTemplateEnd):
+
+					// The end token includes the end of the previous interpolation, `)`,
+					// and the closing quote
+
+					endOffset := len(literal)
+					missingEnd := endOffset < 2 || literal[endOffset-1] != '"'
+					if !missingEnd {
+						endOffset--
+					}
+
+					value, errs := parseStringLiteralContent(literal[1:endOffset])
+					p.report(errs...)
+					values = append(values, value)
+
+					p.next()
+
+					if missingEnd {
+						p.report(fmt.Errorf("invalid end of string literal: missing '\"'"))
+					}
+
+					return &ast.StringTemplateExpression{
+						Values:      values,
+						Expressions: expressions,
+						Range: ast.Range{
+							StartPos: startToken.StartPos,
+							EndPos:   token.EndPos,
+						},
+					}
+
+				default:
+					panic(fmt.Errorf(
+						"expected token ')' to end string interpolation, got %s",
+						token.Type,
+					))
+				}
+			}
+		},
+	)
+}
+
 func defineDictionaryExpression() {
 	setExprNullDenotation(
 		lexer.TokenBraceOpen,
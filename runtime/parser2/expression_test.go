@@ -1092,6 +1092,123 @@ func TestParseString(t *testing.T) {
 	})
 }
 
+func TestParseStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`"Minted \(id) for \(x + 1)!\n"`)
+		assert.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"Minted ", " for ", "!\n"},
+				Expressions: []ast.Expression{
+					&ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "id",
+							Pos:        ast.Position{Line: 1, Column: 10, Offset: 10},
+						},
+					},
+					&ast.BinaryExpression{
+						Operation: ast.OperationPlus,
+						Left: &ast.IdentifierExpression{
+							Identifier: ast.Identifier{
+								Identifier: "x",
+								Pos:        ast.Position{Line: 1, Column: 20, Offset: 20},
+							},
+						},
+						Right: &ast.IntegerExpression{
+							Value: big.NewInt(1),
+							Base:  10,
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 24, Offset: 24},
+								EndPos:   ast.Position{Line: 1, Column: 24, Offset: 24},
+							},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 29, Offset: 29},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("valid, nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression(`"\("b\(c)")"`)
+		assert.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"", ""},
+				Expressions: []ast.Expression{
+					&ast.StringTemplateExpression{
+						Values: []string{"b", ""},
+						Expressions: []ast.Expression{
+							&ast.IdentifierExpression{
+								Identifier: ast.Identifier{
+									Identifier: "c",
+									Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+							EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 11, Offset: 11},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid, missing end of interpolation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression(`"\(a b)"`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token ')' to end string interpolation, got identifier",
+					Pos:     ast.Position{Offset: 5, Line: 1, Column: 5},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("invalid, missing end", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseExpression(`"\(a)`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid end of string literal: missing '\"'",
+					Pos:     ast.Position{Offset: 5, Line: 1, Column: 5},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestInvocation(t *testing.T) {
 
 	t.Parallel()
@@ -2294,6 +2411,76 @@ func TestParseFunctionExpression(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("view", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("view fun () { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FunctionExpression{
+				Purity: ast.FunctionPurityView,
+				ParameterList: &ast.ParameterList{
+					Parameters: nil,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+						EndPos:   ast.Position{Line: 1, Column: 10, Offset: 10},
+					},
+				},
+				ReturnTypeAnnotation: &ast.TypeAnnotation{
+					IsResource: false,
+					Type: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "",
+							Pos:        ast.Position{Line: 1, Column: 10, Offset: 10},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 10, Offset: 10},
+				},
+				FunctionBlock: &ast.FunctionBlock{
+					Block: &ast.Block{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+							EndPos:   ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+				},
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+			},
+			result,
+		)
+	})
+
+	t.Run("view as identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseExpression("view + 1")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.BinaryExpression{
+				Operation: ast.OperationPlus,
+				Left: &ast.IdentifierExpression{
+					Identifier: ast.Identifier{
+						Identifier: "view",
+						Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+				},
+				Right: &ast.IntegerExpression{
+					Value: big.NewInt(1),
+					Base:  10,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+						EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+				},
+			},
+			result,
+		)
+	})
 }
 
 func TestParseIntegerLiterals(t *testing.T) {
@@ -162,17 +162,125 @@ func parseParameter(p *parser) *ast.Parameter {
 	}
 }
 
+// parseTypeParameterList parses a list of type parameters, e.g. `<T, U: AnyResource>`.
+//
+// NOTE: assumes the current token is the opening less-than sign
+//
+func parseTypeParameterList(p *parser) *ast.TypeParameterList {
+	var typeParameters []*ast.TypeParameter
+
+	startPos := p.current.StartPos
+	// Skip the opening less-than sign
+	p.next()
+
+	var endPos ast.Position
+
+	expectTypeParameter := true
+
+	atEnd := false
+	for !atEnd {
+		p.skipSpaceAndComments(true)
+		switch p.current.Type {
+		case lexer.TokenIdentifier:
+			if !expectTypeParameter {
+				panic(fmt.Errorf(
+					"expected comma or end of type parameter list, got %s",
+					p.current.Type,
+				))
+			}
+			typeParameter := parseTypeParameter(p)
+			typeParameters = append(typeParameters, typeParameter)
+			expectTypeParameter = false
+
+		case lexer.TokenComma:
+			if expectTypeParameter {
+				panic(fmt.Errorf(
+					"expected type parameter or end of type parameter list, got %s",
+					p.current.Type,
+				))
+			}
+			// Skip the comma
+			p.next()
+			expectTypeParameter = true
+
+		case lexer.TokenGreater:
+			endPos = p.current.EndPos
+			// Skip the closing greater-than sign
+			p.next()
+			atEnd = true
+
+		case lexer.TokenEOF:
+			panic(fmt.Errorf(
+				"missing %s at end of type parameter list",
+				lexer.TokenGreater,
+			))
+
+		default:
+			if expectTypeParameter {
+				panic(fmt.Errorf(
+					"expected type parameter or end of type parameter list, got %s",
+					p.current.Type,
+				))
+			} else {
+				panic(fmt.Errorf(
+					"expected comma or end of type parameter list, got %s",
+					p.current.Type,
+				))
+			}
+		}
+	}
+
+	if len(typeParameters) == 0 {
+		panic(fmt.Errorf("expected at least one type parameter"))
+	}
+
+	return &ast.TypeParameterList{
+		TypeParameters: typeParameters,
+		Range: ast.Range{
+			StartPos: startPos,
+			EndPos:   endPos,
+		},
+	}
+}
+
+func parseTypeParameter(p *parser) *ast.TypeParameter {
+
+	identifier := tokenToIdentifier(p.current)
+	// Skip the identifier
+	p.next()
+
+	var typeBound *ast.TypeAnnotation
+
+	p.skipSpaceAndComments(true)
+	if p.current.Is(lexer.TokenColon) {
+		// Skip the colon
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		typeBound = parseTypeAnnotation(p)
+	}
+
+	return &ast.TypeParameter{
+		Identifier: identifier,
+		TypeBound:  typeBound,
+	}
+}
+
 func parseFunctionDeclaration(
 	p *parser,
 	functionBlockIsOptional bool,
 	access ast.Access,
 	accessPos *ast.Position,
+	purity ast.FunctionPurity,
+	purityPos *ast.Position,
 	docString string,
 ) *ast.FunctionDeclaration {
 
 	startPos := p.current.StartPos
 	if accessPos != nil {
 		startPos = *accessPos
+	} else if purityPos != nil {
+		startPos = *purityPos
 	}
 
 	// Skip the `fun` keyword
@@ -191,12 +299,21 @@ func parseFunctionDeclaration(
 	// Skip the identifier
 	p.next()
 
+	var typeParameterList *ast.TypeParameterList
+
+	p.skipSpaceAndComments(true)
+	if p.current.Is(lexer.TokenLess) {
+		typeParameterList = parseTypeParameterList(p)
+	}
+
 	parameterList, returnTypeAnnotation, functionBlock :=
 		parseFunctionParameterListAndRest(p, functionBlockIsOptional)
 
 	return &ast.FunctionDeclaration{
 		Access:               access,
+		Purity:               purity,
 		Identifier:           identifier,
+		TypeParameterList:    typeParameterList,
 		ParameterList:        parameterList,
 		ReturnTypeAnnotation: returnTypeAnnotation,
 		FunctionBlock:        functionBlock,
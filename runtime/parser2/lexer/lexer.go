@@ -72,6 +72,9 @@ type lexer struct {
 	canBackup bool
 	// the start position of the current word
 	startPos position
+	// the parenthesis nesting depths of the string template interpolations
+	// which are currently being scanned, innermost last
+	stringTemplateParenDepths []int
 }
 
 func Lex(ctx context.Context, input string) chan Token {
@@ -319,25 +322,32 @@ func (l *lexer) acceptWhile(f func(rune) bool) {
 	}
 }
 
-func (l *lexer) scanString(quote rune) {
+// scanString scans the remainder of a string literal,
+// until the given closing quote, or the start of an interpolation (`\(`).
+// It returns true if the start of an interpolation was scanned.
+//
+func (l *lexer) scanString(quote rune) (interpolation bool) {
 	r := l.next()
 	for r != quote {
 		switch r {
 		case '\n', EOF:
 			// NOTE: invalid end of string handled by parser
 			l.backupOne()
-			return
+			return false
 		case '\\':
 			r = l.next()
 			switch r {
 			case '\n', EOF:
 				// NOTE: invalid end of string handled by parser
 				l.backupOne()
-				return
+				return false
+			case '(':
+				return true
 			}
 		}
 		r = l.next()
 	}
+	return false
 }
 
 func (l *lexer) scanBinaryRemainder() {
@@ -903,6 +903,157 @@ func TestLexString(t *testing.T) {
 	})
 }
 
+func TestLexStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("interpolation", func(t *testing.T) {
+		testLex(t,
+			`"a\(b)c"`,
+			[]Token{
+				{
+					Type:  TokenStringTemplateStart,
+					Value: `"a\(`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				{
+					Type:  TokenIdentifier,
+					Value: "b",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+						EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+				},
+				{
+					Type:  TokenStringTemplateEnd,
+					Value: `)c"`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+						EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+						EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("nested interpolations and parentheses", func(t *testing.T) {
+		testLex(t,
+			`"\((x))\(f("\(y)"))"`,
+			[]Token{
+				{
+					Type:  TokenStringTemplateStart,
+					Value: `"\(`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 2, Offset: 2},
+					},
+				},
+				{
+					Type: TokenParenOpen,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				{
+					Type:  TokenIdentifier,
+					Value: "x",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+						EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+				},
+				{
+					Type: TokenParenClose,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+				{
+					Type:  TokenStringTemplateMiddle,
+					Value: `)\(`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+					},
+				},
+				{
+					Type:  TokenIdentifier,
+					Value: "f",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+						EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+					},
+				},
+				{
+					Type: TokenParenOpen,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 10, Offset: 10},
+						EndPos:   ast.Position{Line: 1, Column: 10, Offset: 10},
+					},
+				},
+				{
+					Type:  TokenStringTemplateStart,
+					Value: `"\(`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+						EndPos:   ast.Position{Line: 1, Column: 13, Offset: 13},
+					},
+				},
+				{
+					Type:  TokenIdentifier,
+					Value: "y",
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+						EndPos:   ast.Position{Line: 1, Column: 14, Offset: 14},
+					},
+				},
+				{
+					Type:  TokenStringTemplateEnd,
+					Value: `)"`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 15, Offset: 15},
+						EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+					},
+				},
+				{
+					Type: TokenParenClose,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 17, Offset: 17},
+						EndPos:   ast.Position{Line: 1, Column: 17, Offset: 17},
+					},
+				},
+				{
+					Type:  TokenStringTemplateEnd,
+					Value: `)"`,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 18, Offset: 18},
+						EndPos:   ast.Position{Line: 1, Column: 19, Offset: 19},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 20, Offset: 20},
+						EndPos:   ast.Position{Line: 1, Column: 20, Offset: 20},
+					},
+				},
+			},
+		)
+	})
+}
+
 func TestLexBlockComment(t *testing.T) {
 
 	t.Parallel()
@@ -48,8 +48,20 @@ func rootState(l *lexer) stateFn {
 		case '%':
 			l.emitType(TokenPercent)
 		case '(':
+			if depthCount := len(l.stringTemplateParenDepths); depthCount > 0 {
+				l.stringTemplateParenDepths[depthCount-1]++
+			}
 			l.emitType(TokenParenOpen)
 		case ')':
+			if depthCount := len(l.stringTemplateParenDepths); depthCount > 0 {
+				lastIndex := depthCount - 1
+				if l.stringTemplateParenDepths[lastIndex] == 0 {
+					// The parenthesis closes the interpolation
+					l.stringTemplateParenDepths = l.stringTemplateParenDepths[:lastIndex]
+					return stringTemplateContinuationState
+				}
+				l.stringTemplateParenDepths[lastIndex]--
+			}
 			l.emitType(TokenParenClose)
 		case '{':
 			l.emitType(TokenBraceOpen)
@@ -290,11 +302,28 @@ func identifierState(l *lexer) stateFn {
 }
 
 func stringState(l *lexer) stateFn {
-	l.scanString('"')
+	if l.scanString('"') {
+		l.emitValue(TokenStringTemplateStart)
+		l.stringTemplateParenDepths = append(l.stringTemplateParenDepths, 0)
+		return rootState
+	}
 	l.emitValue(TokenString)
 	return rootState
 }
 
+// stringTemplateContinuationState scans the remainder of a string template
+// after an interpolation, i.e. after the closing parenthesis
+//
+func stringTemplateContinuationState(l *lexer) stateFn {
+	if l.scanString('"') {
+		l.emitValue(TokenStringTemplateMiddle)
+		l.stringTemplateParenDepths = append(l.stringTemplateParenDepths, 0)
+		return rootState
+	}
+	l.emitValue(TokenStringTemplateEnd)
+	return rootState
+}
+
 func lineCommentState(l *lexer) stateFn {
 	l.scanLineComment()
 	l.emitValue(TokenLineComment)
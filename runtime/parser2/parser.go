@@ -48,6 +48,9 @@ type parser struct {
 	bufferedTokens []lexer.Token
 	// bufferPos is the index of the next buffered token to read from (`bufferedTokens`)
 	bufferPos int
+	// bufferStartPos is the index of the token (in `bufferedTokens`)
+	// that was current when buffering started, i.e. the token replay starts at
+	bufferStartPos int
 	// bufferedErrors are the parsing errors encountered during buffering
 	bufferedErrors []error
 }
@@ -185,11 +188,18 @@ func (p *parser) next() {
 
 		if p.buffering {
 
-			// If we need to buffer the next token
-			// then read the token from the lexer and buffer it.
+			// If we need to buffer the next token,
+			// then read the token from the buffer if there are tokens left to read,
+			// e.g. when buffering while replaying previously buffered tokens.
+			// Otherwise, read the token from the lexer and buffer it.
 
-			token = nextFromLexer()
-			p.bufferedTokens = append(p.bufferedTokens, token)
+			if p.bufferPos < len(p.bufferedTokens) {
+				token = p.bufferedTokens[p.bufferPos]
+			} else {
+				token = nextFromLexer()
+				p.bufferedTokens = append(p.bufferedTokens, token)
+			}
+			p.bufferPos++
 
 		} else if p.bufferPos < len(p.bufferedTokens) {
 
@@ -244,14 +254,15 @@ func (p *parser) mustOneString(tokenType lexer.TokenType, string string) lexer.T
 
 func (p *parser) acceptBuffered() {
 	p.buffering = false
-	p.bufferPos = len(p.bufferedTokens)
 	p.report(p.bufferedErrors...)
+	p.bufferedErrors = nil
 	p.maybeTrimBuffer()
 }
 
 func (p *parser) replayBuffered() {
 	p.buffering = false
 	p.bufferedErrors = nil
+	p.bufferPos = p.bufferStartPos
 	p.next()
 }
 
@@ -336,11 +347,21 @@ func (p *parser) startBuffering() {
 
 	// Starting buffering should only buffer the current token
 	// if there's nothing to be read from the buffer.
-	// Otherwise, the current token would be buffered twice
+	// Otherwise, the current token is the last token read from the buffer,
+	// and would be buffered twice.
+	//
+	// If the buffer was trimmed after the current token was read from it,
+	// the current token has to be put back in front of the remaining tokens
 
 	if p.bufferPos >= len(p.bufferedTokens) {
 		p.bufferedTokens = append(p.bufferedTokens, p.current)
+		p.bufferPos = len(p.bufferedTokens)
+	} else if p.bufferPos == 0 {
+		p.bufferedTokens = append([]lexer.Token{p.current}, p.bufferedTokens...)
+		p.bufferPos = 1
 	}
+
+	p.bufferStartPos = p.bufferPos - 1
 }
 
 func mustIdentifier(p *parser) ast.Identifier {
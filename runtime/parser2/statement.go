@@ -98,7 +98,13 @@ func parseStatement(p *parser) ast.Statement {
 		case keywordFun:
 			// The `fun` keyword is ambiguous: it either introduces a function expression
 			// or a function declaration, depending on if an identifier follows, or not.
-			return parseFunctionDeclarationOrFunctionExpressionStatement(p)
+			return parseFunctionDeclarationOrFunctionExpressionStatement(p, ast.FunctionPurityUnspecified)
+		case keywordView:
+			// The `view` keyword is only a modifier if it is followed by the `fun` keyword,
+			// otherwise it is an identifier
+			if isNextTokenKeywordFun(p) {
+				return parseFunctionDeclarationOrFunctionExpressionStatement(p, ast.FunctionPurityView)
+			}
 		}
 	}
 
@@ -141,6 +147,18 @@ func parseStatement(p *parser) ast.Statement {
 			Right: right,
 		}
 
+	case lexer.TokenColon:
+		// If the expression is an identifier followed by a colon,
+		// it is actually the label of a loop statement
+
+		if identifierExpression, ok := expression.(*ast.IdentifierExpression); ok {
+			return parseLabeledStatement(p, identifierExpression.Identifier)
+		}
+
+		return &ast.ExpressionStatement{
+			Expression: expression,
+		}
+
 	default:
 		return &ast.ExpressionStatement{
 			Expression: expression,
@@ -148,10 +166,16 @@ func parseStatement(p *parser) ast.Statement {
 	}
 }
 
-func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser) ast.Statement {
+func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser, purity ast.FunctionPurity) ast.Statement {
 
 	startPos := p.current.StartPos
 
+	if purity != ast.FunctionPurityUnspecified {
+		// Skip the purity modifier
+		p.next()
+		p.skipSpaceAndComments(true)
+	}
+
 	// Skip the `fun` keyword
 	p.next()
 
@@ -167,6 +191,7 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser) ast.Statem
 
 		return &ast.FunctionDeclaration{
 			Access:               ast.AccessNotSpecified,
+			Purity:               purity,
 			Identifier:           identifier,
 			ParameterList:        parameterList,
 			ReturnTypeAnnotation: returnTypeAnnotation,
@@ -179,6 +204,7 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser) ast.Statem
 
 		return &ast.ExpressionStatement{
 			Expression: &ast.FunctionExpression{
+				Purity:               purity,
 				ParameterList:        parameterList,
 				ReturnTypeAnnotation: returnTypeAnnotation,
 				FunctionBlock:        functionBlock,
@@ -219,7 +245,13 @@ func parseBreakStatement(p *parser) *ast.BreakStatement {
 	tokenRange := p.current.Range
 	p.next()
 
+	label := parseControlStatementLabel(p)
+	if label != nil {
+		tokenRange.EndPos = label.EndPosition()
+	}
+
 	return &ast.BreakStatement{
+		Label: label,
 		Range: tokenRange,
 	}
 }
@@ -228,11 +260,62 @@ func parseContinueStatement(p *parser) *ast.ContinueStatement {
 	tokenRange := p.current.Range
 	p.next()
 
+	label := parseControlStatementLabel(p)
+	if label != nil {
+		tokenRange.EndPos = label.EndPosition()
+	}
+
 	return &ast.ContinueStatement{
+		Label: label,
 		Range: tokenRange,
 	}
 }
 
+// parseControlStatementLabel parses the optional label of a `break` or `continue` statement,
+// which must be on the same line as the keyword
+//
+func parseControlStatementLabel(p *parser) *ast.Identifier {
+	sawNewLine := p.skipSpaceAndComments(false)
+	if sawNewLine || p.current.Type != lexer.TokenIdentifier {
+		return nil
+	}
+
+	label := mustIdentifier(p)
+	return &label
+}
+
+// parseLabeledStatement parses the rest of a loop statement which is preceded by a label,
+// e.g. `outer: while true { ... }`. The current token is the colon after the label
+//
+func parseLabeledStatement(p *parser, label ast.Identifier) ast.Statement {
+
+	p.mustOne(lexer.TokenColon)
+	p.skipSpaceAndComments(true)
+
+	if p.current.Type == lexer.TokenIdentifier {
+		switch p.current.Value {
+		case keywordWhile:
+			statement := parseWhileStatement(p)
+			statement.Label = &label
+			statement.StartPos = label.Pos
+			return statement
+
+		case keywordFor:
+			statement := parseForStatement(p)
+			statement.Label = &label
+			statement.StartPos = label.Pos
+			return statement
+		}
+	}
+
+	panic(fmt.Errorf(
+		"expected keyword %q or %q after label, got %s",
+		keywordWhile,
+		keywordFor,
+		p.current.Type,
+	))
+}
+
 func parseIfStatement(p *parser) *ast.IfStatement {
 
 	var ifStatements []*ast.IfStatement
@@ -594,6 +594,151 @@ func TestParseWhileStatement(t *testing.T) {
 	})
 }
 
+func TestParseLabeledStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("while, break with label", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("outer: while true { break outer }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.WhileStatement{
+					Label: &ast.Identifier{
+						Identifier: "outer",
+						Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+					Test: &ast.BoolExpression{
+						Value: true,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+							EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					Block: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.BreakStatement{
+								Label: &ast.Identifier{
+									Identifier: "outer",
+									Pos:        ast.Position{Line: 1, Column: 26, Offset: 26},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 20, Offset: 20},
+									EndPos:   ast.Position{Line: 1, Column: 30, Offset: 30},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 18, Offset: 18},
+							EndPos:   ast.Position{Line: 1, Column: 32, Offset: 32},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("for, continue with label", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("outer : for x in xs { continue outer }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.ForStatement{
+					Label: &ast.Identifier{
+						Identifier: "outer",
+						Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+					Identifier: ast.Identifier{
+						Identifier: "x",
+						Pos:        ast.Position{Line: 1, Column: 12, Offset: 12},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "xs",
+							Pos:        ast.Position{Line: 1, Column: 17, Offset: 17},
+						},
+					},
+					Block: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.ContinueStatement{
+								Label: &ast.Identifier{
+									Identifier: "outer",
+									Pos:        ast.Position{Line: 1, Column: 31, Offset: 31},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 22, Offset: 22},
+									EndPos:   ast.Position{Line: 1, Column: 35, Offset: 35},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 20, Offset: 20},
+							EndPos:   ast.Position{Line: 1, Column: 37, Offset: 37},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("break, label on next line", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("break\nx")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.BreakStatement{
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+				},
+				&ast.ExpressionStatement{
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 2, Column: 0, Offset: 6},
+						},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("label on other statement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("outer: if true { }")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected keyword \"while\" or \"for\" after label, got identifier",
+					Pos:     ast.Position{Line: 1, Column: 7, Offset: 7},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestParseAssignmentStatement(t *testing.T) {
 
 	t.Parallel()
@@ -930,6 +1075,131 @@ func TestParseFunctionStatementOrExpression(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("view function declaration with name", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("view fun foo() {}")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.FunctionDeclaration{
+					Access: ast.AccessNotSpecified,
+					Purity: ast.FunctionPurityView,
+					Identifier: ast.Identifier{
+						Identifier: "foo",
+						Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+					},
+					ParameterList: &ast.ParameterList{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+							EndPos:   ast.Position{Line: 1, Column: 13, Offset: 13},
+						},
+					},
+					ReturnTypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "",
+								Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+					},
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 15, Offset: 15},
+								EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("view function expression without name", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("view fun () {}")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.ExpressionStatement{
+					Expression: &ast.FunctionExpression{
+						Purity: ast.FunctionPurityView,
+						ParameterList: &ast.ParameterList{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+								EndPos:   ast.Position{Line: 1, Column: 10, Offset: 10},
+							},
+						},
+						ReturnTypeAnnotation: &ast.TypeAnnotation{
+							IsResource: false,
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "",
+									Pos:        ast.Position{Line: 1, Column: 10, Offset: 10},
+								},
+							},
+							StartPos: ast.Position{Line: 1, Column: 10, Offset: 10},
+						},
+						FunctionBlock: &ast.FunctionBlock{
+							Block: &ast.Block{
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+									EndPos:   ast.Position{Line: 1, Column: 13, Offset: 13},
+								},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("view as identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("view = 1")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.AssignmentStatement{
+					Target: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "view",
+							Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+					Value: &ast.IntegerExpression{
+						Value: big.NewInt(1),
+						Base:  10,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+							EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+				},
+			},
+			result,
+		)
+	})
 }
 
 func TestParseStatements(t *testing.T) {
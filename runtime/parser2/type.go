@@ -155,12 +155,33 @@ func init() {
 			switch token.Value {
 			case keywordAuth:
 				p.skipSpaceAndComments(true)
+
+				// The `auth` keyword is optionally followed by a list of entitlements.
+				// Without entitlements, the reference is fully authorized
+
+				var entitlements []*ast.NominalType
+
+				if p.current.Is(lexer.TokenParenOpen) {
+					// Skip the opening paren
+					p.next()
+
+					entitlements, _ = parseNominalTypes(p, lexer.TokenParenClose)
+					if len(entitlements) == 0 {
+						panic(fmt.Errorf("expected at least one entitlement"))
+					}
+
+					// Skip the closing paren
+					p.next()
+					p.skipSpaceAndComments(true)
+				}
+
 				p.mustOne(lexer.TokenAmpersand)
 				right := parseType(p, typeLeftBindingPowerReference)
 				return &ast.ReferenceType{
-					Authorized: true,
-					Type:       right,
-					StartPos:   token.StartPos,
+					Authorized:   entitlements == nil,
+					Entitlements: entitlements,
+					Type:         right,
+					StartPos:     token.StartPos,
 				}
 
 			default:
@@ -574,6 +595,18 @@ func defineFunctionType() {
 		lexer.TokenParenOpen,
 		func(p *parser, startToken lexer.Token) ast.Type {
 
+			// The parameter types may be preceded by the `view` modifier
+
+			purity := ast.FunctionPurityUnspecified
+
+			p.skipSpaceAndComments(true)
+			if p.current.IsString(lexer.TokenIdentifier, keywordView) {
+				purity = ast.FunctionPurityView
+
+				// Skip the `view` keyword
+				p.next()
+			}
+
 			parameterTypeAnnotations := parseParameterTypeAnnotations(p)
 
 			p.skipSpaceAndComments(true)
@@ -586,6 +619,7 @@ func defineFunctionType() {
 			endToken := p.mustOne(lexer.TokenParenClose)
 
 			return &ast.FunctionType{
+				Purity:                   purity,
 				ParameterTypeAnnotations: parameterTypeAnnotations,
 				ReturnTypeAnnotation:     returnTypeAnnotation,
 				Range: ast.Range{
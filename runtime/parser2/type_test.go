@@ -262,6 +262,63 @@ func TestParseReferenceType(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("entitled, nominal", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("auth(E1, C.E2) &Int")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.ReferenceType{
+				Entitlements: []*ast.NominalType{
+					{
+						Identifier: ast.Identifier{
+							Identifier: "E1",
+							Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+					},
+					{
+						Identifier: ast.Identifier{
+							Identifier: "C",
+							Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+						NestedIdentifiers: []ast.Identifier{
+							{
+								Identifier: "E2",
+								Pos:        ast.Position{Line: 1, Column: 11, Offset: 11},
+							},
+						},
+					},
+				},
+				Type: &ast.NominalType{
+					Identifier: ast.Identifier{
+						Identifier: "Int",
+						Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+					},
+				},
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+			},
+			result,
+		)
+	})
+
+	t.Run("entitled, missing entitlements", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseType("auth() &Int")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected at least one entitlement",
+					Pos:     ast.Position{Line: 1, Column: 5, Offset: 5},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseOptionalReferenceType(t *testing.T) {
@@ -1039,6 +1096,38 @@ func TestParseFunctionType(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("view, no parameters, Void return type", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("(view ():Void)")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FunctionType{
+				Purity:                   ast.FunctionPurityView,
+				ParameterTypeAnnotations: nil,
+				ReturnTypeAnnotation: &ast.TypeAnnotation{
+					IsResource: false,
+					Type: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "Void",
+							Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 13, Offset: 13},
+				},
+			},
+			result,
+		)
+
+		require.Equal(t, "(view (): Void)", result.String())
+	})
 }
 
 func TestParseInstantiationType(t *testing.T) {
@@ -19,6 +19,7 @@
 package runtime
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
@@ -69,6 +70,18 @@ type Runtime interface {
 	// SetContractUpdateValidationEnabled configures if contract update validation is enabled.
 	//
 	SetContractUpdateValidationEnabled(enabled bool)
+
+	// SetCoreEventsEnabled configures if the core events are emitted,
+	// e.g. when an account is created, or a key or a contract is added to an account.
+	// Core events are enabled by default.
+	//
+	SetCoreEventsEnabled(enabled bool)
+
+	// SetViewConditionsEnabled configures if the conditions of functions
+	// may only call view functions and may not have other side effects.
+	// View conditions are enabled by default.
+	//
+	SetViewConditionsEnabled(enabled bool)
 }
 
 var typeDeclarations = append(
@@ -82,6 +95,7 @@ var validTopLevelDeclarationsInTransaction = []common.DeclarationKind{
 	common.DeclarationKindImport,
 	common.DeclarationKindFunction,
 	common.DeclarationKindTransaction,
+	common.DeclarationKindTypeAlias,
 }
 
 var validTopLevelDeclarationsInAccountCode = []common.DeclarationKind{
@@ -89,6 +103,7 @@ var validTopLevelDeclarationsInAccountCode = []common.DeclarationKind{
 	common.DeclarationKindImport,
 	common.DeclarationKindContract,
 	common.DeclarationKindContractInterface,
+	common.DeclarationKindTypeAlias,
 }
 
 func validTopLevelDeclarations(location common.Location) []common.DeclarationKind {
@@ -124,6 +139,8 @@ func reportMetric(
 type interpreterRuntime struct {
 	coverageReport                  *CoverageReport
 	contractUpdateValidationEnabled bool
+	coreEventsEnabled               bool
+	viewConditionsEnabled           bool
 }
 
 type Option func(Runtime)
@@ -137,9 +154,30 @@ func WithContractUpdateValidationEnabled(enabled bool) Option {
 	}
 }
 
+// WithCoreEventsEnabled returns a runtime option
+// that configures if core events are emitted.
+//
+func WithCoreEventsEnabled(enabled bool) Option {
+	return func(runtime Runtime) {
+		runtime.SetCoreEventsEnabled(enabled)
+	}
+}
+
+// WithViewConditionsEnabled returns a runtime option
+// that configures if the conditions of functions are checked for side effects.
+//
+func WithViewConditionsEnabled(enabled bool) Option {
+	return func(runtime Runtime) {
+		runtime.SetViewConditionsEnabled(enabled)
+	}
+}
+
 // NewInterpreterRuntime returns a interpreter-based version of the Flow runtime.
 func NewInterpreterRuntime(options ...Option) Runtime {
-	runtime := &interpreterRuntime{}
+	runtime := &interpreterRuntime{
+		coreEventsEnabled:     true,
+		viewConditionsEnabled: true,
+	}
 	for _, option := range options {
 		option(runtime)
 	}
@@ -154,6 +192,14 @@ func (r *interpreterRuntime) SetContractUpdateValidationEnabled(enabled bool) {
 	r.contractUpdateValidationEnabled = enabled
 }
 
+func (r *interpreterRuntime) SetCoreEventsEnabled(enabled bool) {
+	r.coreEventsEnabled = enabled
+}
+
+func (r *interpreterRuntime) SetViewConditionsEnabled(enabled bool) {
+	r.viewConditionsEnabled = enabled
+}
+
 func (r *interpreterRuntime) ExecuteScript(script Script, context Context) (cadence.Value, error) {
 	context.InitializeCodesAndPrograms()
 
@@ -323,6 +369,8 @@ func (r *interpreterRuntime) newAuthAccountValue(
 		addressValue,
 		storageUsedGetFunction(addressValue, context.Interface, runtimeStorage),
 		storageCapacityGetFunction(addressValue, context.Interface),
+		balanceGetFunction(addressValue, context.Interface),
+		availableBalanceGetFunction(addressValue, context.Interface),
 		r.newAddPublicKeyFunction(addressValue, context.Interface),
 		r.newRemovePublicKeyFunction(addressValue, context.Interface),
 		r.newAuthAccountContracts(
@@ -673,6 +721,7 @@ func (r *interpreterRuntime) check(
 				sema.WithPredeclaredValues(valueDeclarations),
 				sema.WithPredeclaredTypes(typeDeclarations),
 				sema.WithValidTopLevelDeclarationsHandler(validTopLevelDeclarations),
+				sema.WithViewConditionsEnabled(r.viewConditionsEnabled),
 				sema.WithLocationHandler(
 					func(identifiers []Identifier, location Location) (res []ResolvedLocation, err error) {
 						wrapPanic(func() {
@@ -776,6 +825,16 @@ func (r *interpreterRuntime) newInterpreter(
 			})
 			return
 		}),
+		interpreter.WithHashHandler(func(
+			data []byte,
+			tag string,
+			hashAlgorithm sema.HashAlgorithm,
+		) (digest []byte, err error) {
+			wrapPanic(func() {
+				digest, err = context.Interface.Hash(data, tag, hashAlgorithm)
+			})
+			return
+		}),
 		interpreter.WithContractValueHandler(
 			func(
 				inter *interpreter.Interpreter,
@@ -974,6 +1033,18 @@ func (r *interpreterRuntime) storageInterpreterOptions(runtimeStorage *runtimeSt
 	}
 }
 
+// computationIntensityPerUnit is the intensity of the computation of built-in functions,
+// e.g. the number of bytes of a string, which is metered as one unit of computation
+//
+const computationIntensityPerUnit = 32
+
+// computationForIntensity returns the units of computation for the given intensity
+// of the computation of a built-in function, rounded up
+//
+func computationForIntensity(intensity uint) uint64 {
+	return (uint64(intensity) + computationIntensityPerUnit - 1) / computationIntensityPerUnit
+}
+
 func (r *interpreterRuntime) meteringInterpreterOptions(runtimeInterface Interface) []interpreter.Option {
 	var limit uint64
 	wrapPanic(func() {
@@ -989,8 +1060,8 @@ func (r *interpreterRuntime) meteringInterpreterOptions(runtimeInterface Interfa
 
 	var used uint64
 
-	checkLimit := func() {
-		used++
+	checkLimit := func(computation uint64) {
+		used += computation
 
 		if used <= limit {
 			return
@@ -1011,17 +1082,22 @@ func (r *interpreterRuntime) meteringInterpreterOptions(runtimeInterface Interfa
 	return []interpreter.Option{
 		interpreter.WithOnStatementHandler(
 			func(_ *interpreter.Interpreter, _ ast.Statement) {
-				checkLimit()
+				checkLimit(1)
 			},
 		),
 		interpreter.WithOnLoopIterationHandler(
 			func(_ *interpreter.Interpreter, _ int) {
-				checkLimit()
+				checkLimit(1)
 			},
 		),
 		interpreter.WithOnFunctionInvocationHandler(
 			func(_ *interpreter.Interpreter, _ int) {
-				checkLimit()
+				checkLimit(1)
+			},
+		),
+		interpreter.WithOnComputationHandler(
+			func(_ *interpreter.Interpreter, intensity uint) {
+				checkLimit(computationForIntensity(intensity))
 			},
 		),
 	}
@@ -1093,16 +1169,13 @@ func (r *interpreterRuntime) emitEvent(
 	return err
 }
 
+// emitAccountEvent emits the given core event, if core events are enabled
+//
 func (r *interpreterRuntime) emitAccountEvent(
 	eventType *sema.CompositeType,
 	runtimeInterface Interface,
 	eventFields []exportableValue,
 ) {
-	eventValue := exportableEvent{
-		Type:   eventType,
-		Fields: eventFields,
-	}
-
 	actualLen := len(eventFields)
 	expectedLen := len(eventType.ConstructorParameters)
 
@@ -1115,6 +1188,15 @@ func (r *interpreterRuntime) emitAccountEvent(
 		))
 	}
 
+	if !r.coreEventsEnabled {
+		return
+	}
+
+	eventValue := exportableEvent{
+		Type:   eventType,
+		Fields: eventFields,
+	}
+
 	var err error
 	exportedEvent := exportEvent(eventValue)
 	wrapPanic(func() {
@@ -1213,6 +1295,38 @@ func storageCapacityGetFunction(addressValue interpreter.AddressValue, runtimeIn
 	}
 }
 
+func balanceGetFunction(addressValue interpreter.AddressValue, runtimeInterface Interface) func() interpreter.UFix64Value {
+	return accountBalanceGetFunction(addressValue, runtimeInterface, AccountBalances.GetAccountBalance)
+}
+
+func availableBalanceGetFunction(addressValue interpreter.AddressValue, runtimeInterface Interface) func() interpreter.UFix64Value {
+	return accountBalanceGetFunction(addressValue, runtimeInterface, AccountBalances.GetAccountAvailableBalance)
+}
+
+func accountBalanceGetFunction(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+	getBalance func(AccountBalances, Address) (uint64, error),
+) func() interpreter.UFix64Value {
+	address := addressValue.ToAddress()
+	return func() interpreter.UFix64Value {
+		accountBalances, ok := runtimeInterface.(AccountBalances)
+		if !ok {
+			panic(AccountBalanceUnavailableError{})
+		}
+
+		var balance uint64
+		var err error
+		wrapPanic(func() {
+			balance, err = getBalance(accountBalances, address)
+		})
+		if err != nil {
+			panic(err)
+		}
+		return interpreter.UFix64Value(balance)
+	}
+}
+
 func (r *interpreterRuntime) newAddPublicKeyFunction(
 	addressValue interpreter.AddressValue,
 	runtimeInterface Interface,
@@ -1321,6 +1435,7 @@ func (r *interpreterRuntime) loadContract(
 			constructor,
 			runtimeInterface,
 			runtimeInterface,
+			runtimeInterface,
 			invocationRange,
 		)
 		if err != nil {
@@ -1496,6 +1611,8 @@ func (r *interpreterRuntime) newGetAccountFunction(runtimeInterface Interface, r
 			accountAddress,
 			storageUsedGetFunction(accountAddress, runtimeInterface, runtimeStorage),
 			storageCapacityGetFunction(accountAddress, runtimeInterface),
+			balanceGetFunction(accountAddress, runtimeInterface),
+			availableBalanceGetFunction(accountAddress, runtimeInterface),
 			r.newPublicAccountKeys(accountAddress, runtimeInterface),
 		)
 	}
@@ -1643,6 +1760,18 @@ func (r *interpreterRuntime) newAuthAccountKeys(addressValue interpreter.Address
 			addressValue,
 			runtimeInterface,
 		),
+		r.newAccountKeysCountGetter(
+			addressValue,
+			runtimeInterface,
+		),
+		r.newAccountKeysForEachFunction(
+			addressValue,
+			runtimeInterface,
+		),
+		r.newAccountKeysGetByPublicKeyFunction(
+			addressValue,
+			runtimeInterface,
+		),
 	)
 }
 
@@ -2199,7 +2328,10 @@ func (r *interpreterRuntime) newAccountKeysAddFunction(
 				runtimeInterface,
 				[]exportableValue{
 					newExportableValue(addressValue, nil),
-					newExportableValue(publicKeyValue, nil),
+					newExportableValue(
+						interpreter.ByteSliceToByteArrayValue(accountKey.PublicKey.PublicKey),
+						nil,
+					),
 				},
 			)
 
@@ -2245,8 +2377,7 @@ func (r *interpreterRuntime) newAccountKeysRevokeFunction(
 ) interpreter.HostFunctionValue {
 	return interpreter.NewHostFunctionValue(
 		func(invocation interpreter.Invocation) interpreter.Value {
-			indexValue := invocation.Arguments[0].(interpreter.IntValue)
-			index := indexValue.ToInt()
+			index := invocation.Arguments[0].(interpreter.IntValue).ToInt()
 			address := addressValue.ToAddress()
 
 			var err error
@@ -2270,7 +2401,10 @@ func (r *interpreterRuntime) newAccountKeysRevokeFunction(
 				runtimeInterface,
 				[]exportableValue{
 					newExportableValue(addressValue, nil),
-					newExportableValue(indexValue, nil),
+					newExportableValue(
+						interpreter.ByteSliceToByteArrayValue(accountKey.PublicKey.PublicKey),
+						nil,
+					),
 				},
 			)
 
@@ -2279,12 +2413,137 @@ func (r *interpreterRuntime) newAccountKeysRevokeFunction(
 	)
 }
 
+func (r *interpreterRuntime) newAccountKeysCountGetter(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) interpreter.ComputedField {
+	return func(_ *interpreter.Interpreter) interpreter.Value {
+		count := getAccountKeyCount(runtimeInterface, addressValue.ToAddress())
+		return interpreter.UInt64Value(count)
+	}
+}
+
+func (r *interpreterRuntime) newAccountKeysForEachFunction(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			f := interpreter.ArgumentFunctionInvoker(invocation, 0)
+			reportIteration := interpreter.ElementIterationReporter(invocation)
+
+			forEachAccountKey(
+				runtimeInterface,
+				addressValue.ToAddress(),
+				func(accountKey *AccountKey) bool {
+					reportIteration()
+					result := f(NewAccountKeyValue(accountKey))
+					return bool(result.(interpreter.BoolValue))
+				},
+			)
+
+			return interpreter.VoidValue{}
+		},
+	)
+}
+
+func (r *interpreterRuntime) newAccountKeysGetByPublicKeyFunction(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			publicKeyValue := invocation.Arguments[0].(*interpreter.CompositeValue)
+			publicKey := NewPublicKeyFromValue(publicKeyValue)
+
+			reportIteration := interpreter.ElementIterationReporter(invocation)
+
+			var values []interpreter.Value
+
+			forEachAccountKey(
+				runtimeInterface,
+				addressValue.ToAddress(),
+				func(accountKey *AccountKey) bool {
+					reportIteration()
+
+					if accountKey.PublicKey.SignAlgo == publicKey.SignAlgo &&
+						bytes.Equal(accountKey.PublicKey.PublicKey, publicKey.PublicKey) {
+
+						values = append(values, NewAccountKeyValue(accountKey))
+					}
+
+					return true
+				},
+			)
+
+			return interpreter.NewArrayValueUnownedNonCopying(values...)
+		},
+	)
+}
+
+func getAccountKeyCount(runtimeInterface Interface, address Address) int {
+	var count int
+	var err error
+	wrapPanic(func() {
+		count, err = runtimeInterface.GetAccountKeyCount(address)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return count
+}
+
+// forEachAccountKey calls the given function with each key of the account, in index order,
+// until the function returns false.
+//
+// The keys are retrieved using GetAccountKey, so they are the same as the keys returned by
+// `AuthAccount.keys.get` and `PublicAccount.keys.get`, including revoked keys
+//
+func forEachAccountKey(runtimeInterface Interface, address Address, f func(accountKey *AccountKey) bool) {
+	count := getAccountKeyCount(runtimeInterface, address)
+
+	for index := 0; index < count; index++ {
+
+		var err error
+		var accountKey *AccountKey
+		wrapPanic(func() {
+			accountKey, err = runtimeInterface.GetAccountKey(address, index)
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		// Skip keys which are not found, like in the get function
+
+		if accountKey == nil {
+			continue
+		}
+
+		if !f(accountKey) {
+			return
+		}
+	}
+}
+
 func (r *interpreterRuntime) newPublicAccountKeys(addressValue interpreter.AddressValue, runtimeInterface Interface) *interpreter.CompositeValue {
 	return interpreter.NewPublicAccountKeysValue(
 		r.newAccountKeysGetFunction(
 			addressValue,
 			runtimeInterface,
 		),
+		r.newAccountKeysCountGetter(
+			addressValue,
+			runtimeInterface,
+		),
+		r.newAccountKeysForEachFunction(
+			addressValue,
+			runtimeInterface,
+		),
+		r.newAccountKeysGetByPublicKeyFunction(
+			addressValue,
+			runtimeInterface,
+		),
 	)
 }
 
@@ -20,10 +20,13 @@ package runtime
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -32,6 +35,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/onflow/cadence"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
@@ -40,6 +44,7 @@ import (
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/stdlib"
+	"github.com/onflow/cadence/runtime/tests/bls"
 	"github.com/onflow/cadence/runtime/tests/checker"
 	"github.com/onflow/cadence/runtime/tests/utils"
 )
@@ -98,6 +103,7 @@ type testRuntimeInterface struct {
 	removeEncodedAccountKey   func(address Address, index int) (publicKey []byte, err error)
 	addAccountKey             func(address Address, publicKey *PublicKey, hashAlgo HashAlgorithm, weight int) (*AccountKey, error)
 	getAccountKey             func(address Address, index int) (*AccountKey, error)
+	getAccountKeyCount        func(address Address) (int, error)
 	removeAccountKey          func(address Address, index int) (*AccountKey, error)
 	updateAccountContractCode func(address Address, name string, code []byte) error
 	getAccountContractCode    func(address Address, name string) (code []byte, err error)
@@ -122,12 +128,17 @@ type testRuntimeInterface struct {
 		signatureAlgorithm SignatureAlgorithm,
 		hashAlgorithm HashAlgorithm,
 	) (bool, error)
-	hash                   func(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error)
-	setCadenceValue        func(owner Address, key string, value cadence.Value) (err error)
-	getStorageUsed         func(_ Address) (uint64, error)
-	getStorageCapacity     func(_ Address) (uint64, error)
-	programs               map[common.LocationID]*interpreter.Program
-	implementationDebugLog func(message string) error
+	hash                       func(data []byte, tag string, hashAlgorithm HashAlgorithm) ([]byte, error)
+	aggregateBLSSignatures     func(signatures [][]byte) ([]byte, error)
+	aggregateBLSPublicKeys     func(publicKeys [][]byte) ([]byte, error)
+	verifyBLSProofOfPossession func(publicKey []byte, proof []byte) (bool, error)
+	setCadenceValue            func(owner Address, key string, value cadence.Value) (err error)
+	getStorageUsed             func(_ Address) (uint64, error)
+	getStorageCapacity         func(_ Address) (uint64, error)
+	getAccountBalance          func(_ Address) (uint64, error)
+	getAccountAvailableBalance func(_ Address) (uint64, error)
+	programs                   map[common.LocationID]*interpreter.Program
+	implementationDebugLog     func(message string) error
 }
 
 // testRuntimeInterface should implement Interface
@@ -207,6 +218,10 @@ func (i *testRuntimeInterface) GetAccountKey(address Address, index int) (*Accou
 	return i.getAccountKey(address, index)
 }
 
+func (i *testRuntimeInterface) GetAccountKeyCount(address Address) (int, error) {
+	return i.getAccountKeyCount(address)
+}
+
 func (i *testRuntimeInterface) RevokeAccountKey(address Address, index int) (*AccountKey, error) {
 	return i.removeAccountKey(address, index)
 }
@@ -334,6 +349,9 @@ func (i *testRuntimeInterface) VerifySignature(
 	hashAlgorithm HashAlgorithm,
 ) (bool, error) {
 	if i.verifySignature == nil {
+		if signatureAlgorithm == SignatureAlgorithmBLS_BLS12_381 {
+			return bls.Verify(publicKey, signature, tag, signedData), nil
+		}
 		return false, nil
 	}
 	return i.verifySignature(
@@ -346,11 +364,80 @@ func (i *testRuntimeInterface) VerifySignature(
 	)
 }
 
-func (i *testRuntimeInterface) Hash(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error) {
+func (i *testRuntimeInterface) Hash(data []byte, tag string, hashAlgorithm HashAlgorithm) ([]byte, error) {
 	if i.hash == nil {
-		return nil, nil
+		return testHash(data, tag, hashAlgorithm)
+	}
+	return i.hash(data, tag, hashAlgorithm)
+}
+
+// testHashTagLength is the length a non-empty hash tag is right-padded to
+//
+const testHashTagLength = 32
+
+// testHash hashes the given data, prefixed by the given tag,
+// right-padded to testHashTagLength, if the tag is not empty
+//
+func testHash(data []byte, tag string, hashAlgorithm HashAlgorithm) ([]byte, error) {
+	if len(tag) > testHashTagLength {
+		return nil, fmt.Errorf("hash tag is longer than %d bytes: %d", testHashTagLength, len(tag))
+	}
+
+	var hasher hash.Hash
+
+	switch hashAlgorithm {
+	case HashAlgorithmSHA2_256:
+		hasher = sha256.New()
+	case HashAlgorithmSHA2_384:
+		hasher = sha512.New384()
+	case HashAlgorithmSHA3_256:
+		hasher = sha3.New256()
+	case HashAlgorithmSHA3_384:
+		hasher = sha3.New384()
+	case HashAlgorithmKECCAK_256:
+		hasher = sha3.NewLegacyKeccak256()
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm: %s", hashAlgorithm)
+	}
+
+	if tag != "" {
+		paddedTag := make([]byte, testHashTagLength)
+		copy(paddedTag, tag)
+		hasher.Write(paddedTag)
+	}
+
+	hasher.Write(data)
+
+	return hasher.Sum(nil), nil
+}
+
+func (i *testRuntimeInterface) AggregateBLSSignatures(signatures [][]byte) ([]byte, error) {
+	if i.aggregateBLSSignatures == nil {
+		aggregatedSignature, err := bls.AggregateSignatures(signatures)
+		if err != nil {
+			return nil, nil
+		}
+		return aggregatedSignature, nil
+	}
+	return i.aggregateBLSSignatures(signatures)
+}
+
+func (i *testRuntimeInterface) AggregateBLSPublicKeys(publicKeys [][]byte) ([]byte, error) {
+	if i.aggregateBLSPublicKeys == nil {
+		aggregatedPublicKey, err := bls.AggregatePublicKeys(publicKeys)
+		if err != nil {
+			return nil, nil
+		}
+		return aggregatedPublicKey, nil
+	}
+	return i.aggregateBLSPublicKeys(publicKeys)
+}
+
+func (i *testRuntimeInterface) VerifyBLSProofOfPossession(publicKey []byte, proof []byte) (bool, error) {
+	if i.verifyBLSProofOfPossession == nil {
+		return bls.VerifyProofOfPossession(publicKey, proof), nil
 	}
-	return i.hash(data, hashAlgorithm)
+	return i.verifyBLSProofOfPossession(publicKey, proof)
 }
 
 func (i *testRuntimeInterface) HighLevelStorageEnabled() bool {
@@ -369,6 +456,20 @@ func (i *testRuntimeInterface) GetStorageCapacity(address Address) (uint64, erro
 	return i.getStorageCapacity(address)
 }
 
+func (i *testRuntimeInterface) GetAccountBalance(address Address) (uint64, error) {
+	if i.getAccountBalance == nil {
+		return 0, AccountBalanceUnavailableError{}
+	}
+	return i.getAccountBalance(address)
+}
+
+func (i *testRuntimeInterface) GetAccountAvailableBalance(address Address) (uint64, error) {
+	if i.getAccountAvailableBalance == nil {
+		return 0, AccountBalanceUnavailableError{}
+	}
+	return i.getAccountAvailableBalance(address)
+}
+
 func (i *testRuntimeInterface) ImplementationDebugLog(message string) error {
 	if i.implementationDebugLog == nil {
 		return nil
@@ -2810,176 +2911,599 @@ func TestRuntimeTransaction_CreateAccount(t *testing.T) {
 	assert.EqualValues(t, stdlib.AccountCreatedEventType.ID(), events[0].Type().ID())
 }
 
-func TestRuntimeContractAccount(t *testing.T) {
+func TestRuntimeCoreEvents(t *testing.T) {
 
 	t.Parallel()
 
-	runtime := NewInterpreterRuntime()
-
-	addressValue := cadence.BytesToAddress([]byte{0xCA, 0xDE})
-
-	contract := []byte(`
-      pub contract Test {
-          pub let address: Address
+	const contract = `pub contract Test {}`
+	const updatedContract = `pub contract Test { pub fun test() {} }`
 
-          init() {
-              // field 'account' can be used, as it is considered initialized
-              self.address = self.account.address
-          }
+	script := []byte(fmt.Sprintf(
+		`
+          transaction {
+            prepare(signer: AuthAccount) {
+              let account = AuthAccount(payer: signer)
+
+              account.keys.add(
+                  publicKey: PublicKey(
+                      publicKey: "010203".decodeHex(),
+                      signatureAlgorithm: SignatureAlgorithm.ECDSA_P256
+                  ),
+                  hashAlgorithm: HashAlgorithm.SHA3_256,
+                  weight: 100.0
+              )
+              account.keys.revoke(keyIndex: 0)
 
-          // test that both functions are linked back into restored composite values,
-          // and also injected fields are injected back into restored composite values
-          //
-          pub fun test(): Address {
-              return self.account.address
+              account.contracts.add(name: "Test", code: "%s".decodeHex())
+              account.contracts.update__experimental(name: "Test", code: "%s".decodeHex())
+              account.contracts.remove(name: "Test")
+            }
           }
-      }
-    `)
-
-	script1 := []byte(`
-      import Test from 0xCADE
-
-      pub fun main(): Address {
-          return Test.address
-      }
-    `)
+        `,
+		hex.EncodeToString([]byte(contract)),
+		hex.EncodeToString([]byte(updatedContract)),
+	))
 
-	script2 := []byte(`
-      import Test from 0xCADE
+	execute := func(t *testing.T, runtime Runtime) []cadence.Event {
 
-      pub fun main(): Address {
-          return Test.test()
-      }
-    `)
+		var events []cadence.Event
 
-	deploy := utils.DeploymentTransaction("Test", contract)
+		accountKeys := newTestAccountKeyStorage()
+		runtimeInterface := getAccountKeyTestRuntimeInterface(accountKeys)
 
-	var accountCode []byte
-	var events []cadence.Event
+		runtimeInterface.createAccount = func(payer Address) (address Address, err error) {
+			return common.BytesToAddress([]byte{0x1}), nil
+		}
 
-	runtimeInterface := &testRuntimeInterface{
-		getCode: func(_ Location) (bytes []byte, err error) {
-			return accountCode, nil
-		},
-		storage: newTestStorage(nil, nil),
-		getSigningAccounts: func() ([]Address, error) {
-			return []Address{common.BytesToAddress(addressValue.Bytes())}, nil
-		},
-		resolveLocation: singleIdentifierLocationResolver(t),
-		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
-			return accountCode, nil
-		},
-		updateAccountContractCode: func(_ Address, _ string, code []byte) error {
-			accountCode = code
-			return nil
-		},
-		emitEvent: func(event cadence.Event) error {
+		runtimeInterface.emitEvent = func(event cadence.Event) error {
 			events = append(events, event)
 			return nil
-		},
-	}
-
-	nextTransactionLocation := newTransactionLocationGenerator()
+		}
 
-	err := runtime.ExecuteTransaction(
-		Script{
-			Source: deploy,
-		},
-		Context{
-			Interface: runtimeInterface,
-			Location:  nextTransactionLocation(),
-		},
-	)
-	require.NoError(t, err)
+		contractCodes := map[string][]byte{}
 
-	assert.NotNil(t, accountCode)
+		runtimeInterface.getAccountContractCode = func(_ Address, name string) ([]byte, error) {
+			return contractCodes[name], nil
+		}
+		runtimeInterface.updateAccountContractCode = func(_ Address, name string, code []byte) error {
+			contractCodes[name] = code
+			return nil
+		}
+		runtimeInterface.removeAccountContractCode = func(_ Address, name string) error {
+			delete(contractCodes, name)
+			return nil
+		}
 
-	t.Run("", func(t *testing.T) {
-		value, err := runtime.ExecuteScript(
+		err := runtime.ExecuteTransaction(
 			Script{
-				Source: script1,
+				Source: script,
 			},
 			Context{
 				Interface: runtimeInterface,
-				Location:  nextTransactionLocation(),
+				Location:  common.TransactionLocation{},
 			},
 		)
 		require.NoError(t, err)
 
-		assert.Equal(t, addressValue, value)
+		return events
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		events := execute(t, NewInterpreterRuntime())
+
+		address := cadence.BytesToAddress([]byte{0x1})
+
+		publicKey := cadence.NewArray([]cadence.Value{
+			cadence.NewUInt8(1),
+			cadence.NewUInt8(2),
+			cadence.NewUInt8(3),
+		})
+
+		codeHash := func(code string) cadence.Value {
+			return ExportValue(CodeToHashValue([]byte(code)), nil)
+		}
+
+		expected := []struct {
+			eventType *sema.CompositeType
+			fields    []cadence.Value
+		}{
+			{
+				stdlib.AccountCreatedEventType,
+				[]cadence.Value{address},
+			},
+			{
+				stdlib.AccountKeyAddedEventType,
+				[]cadence.Value{address, publicKey},
+			},
+			{
+				stdlib.AccountKeyRemovedEventType,
+				[]cadence.Value{address, publicKey},
+			},
+			{
+				stdlib.AccountContractAddedEventType,
+				[]cadence.Value{address, codeHash(contract), cadence.NewString("Test")},
+			},
+			{
+				stdlib.AccountContractUpdatedEventType,
+				[]cadence.Value{address, codeHash(updatedContract), cadence.NewString("Test")},
+			},
+			{
+				stdlib.AccountContractRemovedEventType,
+				[]cadence.Value{address, codeHash(updatedContract), cadence.NewString("Test")},
+			},
+		}
+
+		require.Len(t, events, len(expected))
+
+		for i, expectedEvent := range expected {
+			event := events[i]
+			assert.EqualValues(t, expectedEvent.eventType.ID(), event.Type().ID())
+			assert.Equal(t, expectedEvent.fields, event.Fields)
+		}
 	})
 
-	t.Run("", func(t *testing.T) {
-		value, err := runtime.ExecuteScript(
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		events := execute(t, NewInterpreterRuntime(WithCoreEventsEnabled(false)))
+
+		assert.Empty(t, events)
+	})
+}
+
+func TestRuntimeViewConditions(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`
+      pub fun isPositive(_ x: Int): Bool {
+          return x > 0
+      }
+
+      pub fun main(): Int {
+          return test(1)
+      }
+
+      pub fun test(_ x: Int): Int {
+          pre {
+              isPositive(x)
+          }
+          return x
+      }
+    `)
+
+	execute := func(runtime Runtime) (cadence.Value, error) {
+		return runtime.ExecuteScript(
 			Script{
-				Source: script2,
+				Source: script,
 			},
 			Context{
-				Interface: runtimeInterface,
-				Location:  nextTransactionLocation(),
+				Interface: &testRuntimeInterface{},
+				Location:  utils.TestLocation,
 			},
 		)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		value, err := execute(NewInterpreterRuntime(WithViewConditionsEnabled(false)))
 		require.NoError(t, err)
 
-		assert.Equal(t, addressValue, value)
+		assert.Equal(t, cadence.NewInt(1), value)
 	})
+
+	for name, runtime := range map[string]Runtime{
+		"enabled":            NewInterpreterRuntime(WithViewConditionsEnabled(true)),
+		"enabled by default": NewInterpreterRuntime(),
+	} {
+
+		runtime := runtime
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := execute(runtime)
+			require.Error(t, err)
+
+			var checkerErr *sema.CheckerError
+			require.ErrorAs(t, err, &checkerErr)
+
+			errs := checker.ExpectCheckerErrors(t, checkerErr, 1)
+
+			assert.IsType(t, &sema.PurityError{}, errs[0])
+		})
+	}
 }
 
-func TestRuntimeContractNestedResource(t *testing.T) {
+func TestRuntimeLogPurity(t *testing.T) {
 
 	t.Parallel()
 
-	runtime := NewInterpreterRuntime()
+	execute := func(script []byte, loggedMessages *[]string) (cadence.Value, error) {
+		runtime := NewInterpreterRuntime()
 
-	addressValue := Address{
-		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+		runtimeInterface := &testRuntimeInterface{
+			log: func(message string) {
+				*loggedMessages = append(*loggedMessages, message)
+			},
+		}
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  utils.TestLocation,
+			},
+		)
 	}
 
-	contract := []byte(`
-        pub contract Test {
-            pub resource R {
-                // test that the hello function is linked back into the nested resource
-                // after being loaded from storage
-                pub fun hello(): String {
-                    return "Hello World!"
-                }
-            }
+	t.Run("impure function", func(t *testing.T) {
 
-            init() {
-                // store nested resource in account on deployment
-                self.account.save(<-create R(), to: /storage/r)
-            }
-        }
-    `)
+		t.Parallel()
 
-	tx := []byte(`
-		import Test from 0x01
+		script := []byte(`
+          pub fun main() {
+              log("hello")
+          }
+        `)
 
-		transaction {
+		var loggedMessages []string
 
-			prepare(acct: AuthAccount) {
-				log(acct.borrow<&Test.R>(from: /storage/r)?.hello())
-			}
-		}
-	`)
+		_, err := execute(script, &loggedMessages)
+		require.NoError(t, err)
 
-	deploy := utils.DeploymentTransaction("Test", contract)
+		assert.Equal(t, []string{`"hello"`}, loggedMessages)
+	})
 
-	var accountCode []byte
-	var loggedMessage string
+	t.Run("view function", func(t *testing.T) {
 
-	runtimeInterface := &testRuntimeInterface{
-		getCode: func(_ Location) (bytes []byte, err error) {
-			return accountCode, nil
-		},
-		storage: newTestStorage(nil, nil),
-		getSigningAccounts: func() ([]Address, error) {
-			return []Address{addressValue}, nil
-		},
-		resolveLocation: singleIdentifierLocationResolver(t),
-		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
-			return accountCode, nil
-		},
+		t.Parallel()
+
+		script := []byte(`
+          pub view fun test() {
+              log("hello")
+          }
+
+          pub fun main() {
+              test()
+          }
+        `)
+
+		var loggedMessages []string
+
+		_, err := execute(script, &loggedMessages)
+		require.Error(t, err)
+
+		var checkerErr *sema.CheckerError
+		require.ErrorAs(t, err, &checkerErr)
+
+		errs := checker.ExpectCheckerErrors(t, checkerErr, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+
+		assert.Empty(t, loggedMessages)
+	})
+}
+
+func TestRuntimeAccountBalance(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`
+      pub fun main(): [UFix64] {
+        let account = getAccount(0x1)
+        return [account.balance, account.availableBalance]
+      }
+    `)
+
+	newRuntimeInterface := func() *testRuntimeInterface {
+		return &testRuntimeInterface{
+			storage: newTestStorage(nil, nil),
+			getAccountBalance: func(address Address) (uint64, error) {
+				assert.Equal(t, common.BytesToAddress([]byte{0x1}), address)
+				return 1_5000_0000, nil
+			},
+			getAccountAvailableBalance: func(address Address) (uint64, error) {
+				assert.Equal(t, common.BytesToAddress([]byte{0x1}), address)
+				return 1_2500_0000, nil
+			},
+		}
+	}
+
+	t.Run("script", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := NewInterpreterRuntime()
+
+		value, err := runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: newRuntimeInterface(),
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.UFix64(1_5000_0000),
+				cadence.UFix64(1_2500_0000),
+			}),
+			value,
+		)
+	})
+
+	t.Run("transaction", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := NewInterpreterRuntime()
+
+		var loggedMessages []string
+
+		runtimeInterface := newRuntimeInterface()
+		runtimeInterface.getSigningAccounts = func() ([]Address, error) {
+			return []Address{common.BytesToAddress([]byte{0x1})}, nil
+		}
+		runtimeInterface.log = func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		}
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: []byte(`
+                  transaction {
+                    prepare(signer: AuthAccount) {
+                      log(signer.balance)
+                      log(signer.availableBalance)
+                    }
+                  }
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.TransactionLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"1.50000000", "1.25000000"}, loggedMessages)
+	})
+
+	t.Run("unavailable", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := NewInterpreterRuntime()
+
+		// The host environment only implements Interface, not AccountBalances
+
+		runtimeInterface := struct {
+			Interface
+		}{
+			Interface: newRuntimeInterface(),
+		}
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &AccountBalanceUnavailableError{})
+	})
+
+	t.Run("not provided", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := NewInterpreterRuntime()
+
+		// The host environment implements AccountBalances,
+		// but does not provide the balances
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: &testRuntimeInterface{},
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &AccountBalanceUnavailableError{})
+	})
+}
+
+func TestRuntimeContractAccount(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	addressValue := cadence.BytesToAddress([]byte{0xCA, 0xDE})
+
+	contract := []byte(`
+      pub contract Test {
+          pub let address: Address
+
+          init() {
+              // field 'account' can be used, as it is considered initialized
+              self.address = self.account.address
+          }
+
+          // test that both functions are linked back into restored composite values,
+          // and also injected fields are injected back into restored composite values
+          //
+          pub fun test(): Address {
+              return self.account.address
+          }
+      }
+    `)
+
+	script1 := []byte(`
+      import Test from 0xCADE
+
+      pub fun main(): Address {
+          return Test.address
+      }
+    `)
+
+	script2 := []byte(`
+      import Test from 0xCADE
+
+      pub fun main(): Address {
+          return Test.test()
+      }
+    `)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	var accountCode []byte
+	var events []cadence.Event
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: newTestStorage(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{common.BytesToAddress(addressValue.Bytes())}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+			return accountCode, nil
+		},
+		updateAccountContractCode: func(_ Address, _ string, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			events = append(events, event)
+			return nil
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: deploy,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.NotNil(t, accountCode)
+
+	t.Run("", func(t *testing.T) {
+		value, err := runtime.ExecuteScript(
+			Script{
+				Source: script1,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, addressValue, value)
+	})
+
+	t.Run("", func(t *testing.T) {
+		value, err := runtime.ExecuteScript(
+			Script{
+				Source: script2,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, addressValue, value)
+	})
+}
+
+func TestRuntimeContractNestedResource(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	addressValue := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+        pub contract Test {
+            pub resource R {
+                // test that the hello function is linked back into the nested resource
+                // after being loaded from storage
+                pub fun hello(): String {
+                    return "Hello World!"
+                }
+            }
+
+            init() {
+                // store nested resource in account on deployment
+                self.account.save(<-create R(), to: /storage/r)
+            }
+        }
+    `)
+
+	tx := []byte(`
+		import Test from 0x01
+
+		transaction {
+
+			prepare(acct: AuthAccount) {
+				log(acct.borrow<&Test.R>(from: /storage/r)?.hello())
+			}
+		}
+	`)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	var accountCode []byte
+	var loggedMessage string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: newTestStorage(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{addressValue}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+			return accountCode, nil
+		},
 		updateAccountContractCode: func(addess Address, _ string, code []byte) error {
 			accountCode = code
 			return nil
@@ -2988,37 +3512,147 @@ func TestRuntimeContractNestedResource(t *testing.T) {
 			return nil
 		},
 		log: func(message string) {
-			loggedMessage = message
+			loggedMessage = message
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: deploy,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.NotNil(t, accountCode)
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: tx,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, `"Hello World!"`, loggedMessage)
+}
+
+func TestRuntimeTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	addressValue := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+        pub typealias Names = [String]
+
+        pub contract Test {
+
+            pub typealias Scores = {String: Int}
+
+            pub fun names(): Names {
+                return ["a", "b"]
+            }
+
+            pub fun scores(): Scores {
+                return {"a": 1}
+            }
+        }
+    `)
+
+	// Type aliases are transparent in the storage encoding:
+	// values saved using an alias can be loaded using the aliased type, and vice versa
+
+	saveTx := []byte(`
+        import Test from 0x01
+
+        pub typealias Scores = {String: Int}
+
+        transaction {
+
+            prepare(acct: AuthAccount) {
+                let scores: Scores = Test.scores()
+                acct.save(scores, to: /storage/scores)
+                acct.save(Test.names(), to: /storage/names)
+            }
+        }
+    `)
+
+	loadTx := []byte(`
+        pub typealias Names = [String]
+
+        transaction {
+
+            prepare(acct: AuthAccount) {
+                log(acct.load<{String: Int}>(from: /storage/scores))
+                log(acct.load<Names>(from: /storage/names))
+            }
+        }
+    `)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	var accountCode []byte
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: newTestStorage(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{addressValue}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+			return accountCode, nil
+		},
+		updateAccountContractCode: func(_ Address, _ string, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			return nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
 		},
 	}
 
 	nextTransactionLocation := newTransactionLocationGenerator()
 
-	err := runtime.ExecuteTransaction(
-		Script{
-			Source: deploy,
-		},
-		Context{
-			Interface: runtimeInterface,
-			Location:  nextTransactionLocation(),
-		},
-	)
-	require.NoError(t, err)
-
-	assert.NotNil(t, accountCode)
+	for _, tx := range [][]byte{deploy, saveTx, loadTx} {
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: tx,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	}
 
-	err = runtime.ExecuteTransaction(
-		Script{
-			Source: tx,
-		},
-		Context{
-			Interface: runtimeInterface,
-			Location:  nextTransactionLocation(),
+	assert.Equal(t,
+		[]string{
+			`{"a": 1}`,
+			`["a", "b"]`,
 		},
+		loggedMessages,
 	)
-	require.NoError(t, err)
-
-	assert.Equal(t, `"Hello World!"`, loggedMessage)
 }
 
 func TestRuntimeStorageLoadedDestructionConcreteType(t *testing.T) {
@@ -3115,6 +3749,211 @@ func TestRuntimeStorageLoadedDestructionConcreteType(t *testing.T) {
 	assert.Equal(t, `"destroyed"`, loggedMessage)
 }
 
+func TestRuntimeStorageExtensionFields(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	addressValue := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+        pub contract Test {
+            pub resource R {}
+
+            pub extension Notes for R {
+                pub(set) var note: String?
+
+                pub fun describe(): String {
+                    return self.note ?? "none"
+                }
+            }
+
+            init() {
+                self.account.save(<-create R(), to: /storage/r)
+            }
+        }
+    `)
+
+	tx1 := []byte(`
+		import Test from 0x01
+
+		transaction {
+
+			prepare(acct: AuthAccount) {
+                let r = acct.borrow<&Test.R>(from: /storage/r)!
+                log(r.describe())
+                r.note = "hello"
+			}
+		}
+	`)
+
+	tx2 := []byte(`
+		import Test from 0x01
+
+		transaction {
+
+			prepare(acct: AuthAccount) {
+                let r = acct.borrow<&Test.R>(from: /storage/r)!
+                log(r.describe())
+			}
+		}
+	`)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	var accountCode []byte
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: newTestStorage(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{addressValue}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+			return accountCode, nil
+		},
+		updateAccountContractCode: func(address Address, _ string, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error { return nil },
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	for _, tx := range [][]byte{deploy, tx1, tx2} {
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: tx,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{`"none"`, `"hello"`}, loggedMessages)
+}
+
+func TestRuntimeStorageGenericStructure(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	addressValue := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+        pub contract Test {
+            pub struct Box<T> {
+                pub let value: T
+
+                init(value: T) {
+                    self.value = value
+                }
+
+                pub fun contains<U>(): Bool {
+                    return (self.value as? U) != nil
+                }
+            }
+        }
+    `)
+
+	tx1 := []byte(`
+		import Test from 0x01
+
+		transaction {
+
+			prepare(acct: AuthAccount) {
+                acct.save(Test.Box(value: [1, 2]), to: /storage/box)
+			}
+		}
+	`)
+
+	tx2 := []byte(`
+		import Test from 0x01
+
+		transaction {
+
+			prepare(acct: AuthAccount) {
+                let box = acct.copy<Test.Box<[Int]>>(from: /storage/box)!
+                log(box.value)
+                log(box.contains<[Int]>())
+                log(box.contains<String>())
+                log(box.getType().identifier)
+                log(acct.copy<Test.Box<[String]>>(from: /storage/box))
+			}
+		}
+	`)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	var accountCode []byte
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: newTestStorage(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{addressValue}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+			return accountCode, nil
+		},
+		updateAccountContractCode: func(address Address, _ string, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error { return nil },
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	for _, tx := range [][]byte{deploy, tx1, tx2} {
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: tx,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t,
+		[]string{
+			"[1, 2]",
+			"true",
+			"false",
+			`"A.0000000000000001.Test.Box<[Int]>"`,
+			"nil",
+		},
+		loggedMessages,
+	)
+}
+
 func TestRuntimeStorageLoadedDestructionAnyResource(t *testing.T) {
 
 	t.Parallel()
@@ -4723,6 +5562,21 @@ func TestRuntimeComputationLimit(t *testing.T) {
             `,
 			ok: true,
 		},
+		{
+			name: "String function on long string",
+			code: fmt.Sprintf(
+				`"%s".toUpper()`,
+				strings.Repeat("a", 200),
+			),
+			ok: false,
+		},
+		{
+			name: "String function on short string",
+			code: `
+              "abc".toUpper()
+            `,
+			ok: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -180,6 +180,7 @@ Returns nil if no contract/contract interface with the given name exists in the
 const AuthAccountContractsTypeGetFunctionName = "get"
 
 var authAccountContractsTypeGetFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Identifier: "name",
@@ -71,6 +71,28 @@ var AuthAccountType = &SimpleType{
 					)
 				},
 			},
+			"balance": {
+				Kind: common.DeclarationKindField,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						t,
+						identifier,
+						&UFix64Type{},
+						accountTypeBalanceFieldDocString,
+					)
+				},
+			},
+			"availableBalance": {
+				Kind: common.DeclarationKindField,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						t,
+						identifier,
+						&UFix64Type{},
+						accountTypeAvailableBalanceFieldDocString,
+					)
+				},
+			},
 			"addPublicKey": {
 				Kind: common.DeclarationKindFunction,
 				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -343,6 +365,7 @@ var authAccountTypeCopyFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -386,6 +409,7 @@ var authAccountTypeBorrowFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -496,6 +520,7 @@ var authAccountTypeGetCapabilityFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -527,6 +552,7 @@ var publicAccountTypeGetCapabilityFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -552,6 +578,7 @@ Returns the capability at the given private or public path, or nil if it does no
 `
 
 var accountTypeGetLinkTargetFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:          ArgumentLabelNotRequired,
@@ -593,6 +620,24 @@ var AuthAccountKeysType = func() *CompositeType {
 			authAccountKeysTypeRevokeFunctionType,
 			authAccountKeysTypeRevokeFunctionDocString,
 		),
+		NewPublicConstantFieldMember(
+			accountKeys,
+			AccountKeysCountFieldName,
+			&UInt64Type{},
+			accountKeysTypeCountFieldDocString,
+		),
+		NewPublicFunctionMember(
+			accountKeys,
+			AccountKeysForEachFunctionName,
+			accountKeysTypeForEachFunctionType,
+			accountKeysTypeForEachFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			accountKeys,
+			AccountKeysGetByPublicKeyFunctionName,
+			accountKeysTypeGetByPublicKeyFunctionType,
+			accountKeysTypeGetByPublicKeyFunctionDocString,
+		),
 	}
 
 	accountKeys.Members = GetMembersAsMap(members)
@@ -620,6 +665,7 @@ var authAccountKeysTypeAddFunctionType = &FunctionType{
 }
 
 var accountKeysTypeGetFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Identifier:     AccountKeyKeyIndexField,
@@ -630,6 +676,46 @@ var accountKeysTypeGetFunctionType = &FunctionType{
 	RequiredArgumentCount: RequiredArgumentCount(1),
 }
 
+var accountKeysTypeForEachFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "function",
+			TypeAnnotation: NewTypeAnnotation(
+				&FunctionType{
+					Parameters: []*Parameter{
+						{
+							Label:          ArgumentLabelNotRequired,
+							Identifier:     "key",
+							TypeAnnotation: NewTypeAnnotation(AccountKeyType),
+						},
+					},
+					ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation:  NewTypeAnnotation(VoidType),
+	RequiredArgumentCount: RequiredArgumentCount(1),
+}
+
+var accountKeysTypeGetByPublicKeyFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     AccountKeyPublicKeyField,
+			TypeAnnotation: NewTypeAnnotation(PublicKeyType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&VariableSizedType{
+			Type: AccountKeyType,
+		},
+	),
+	RequiredArgumentCount: RequiredArgumentCount(1),
+}
+
 var authAccountKeysTypeRevokeFunctionType = &FunctionType{
 	Parameters: []*Parameter{
 		{
@@ -650,6 +736,9 @@ const AccountKeysTypeName = "Keys"
 const AccountKeysAddFunctionName = "add"
 const AccountKeysGetFunctionName = "get"
 const AccountKeysRevokeFunctionName = "revoke"
+const AccountKeysCountFieldName = "count"
+const AccountKeysForEachFunctionName = "forEach"
+const AccountKeysGetByPublicKeyFunctionName = "getByPublicKey"
 
 const accountTypeGetLinkTargetFunctionDocString = `
 Returns the target path of the capability at the given public or private path, or nil if there exists no capability at the given path.
@@ -671,6 +760,14 @@ const accountTypeStorageCapacityFieldDocString = `
 The storage capacity of the account in bytes
 `
 
+const accountTypeBalanceFieldDocString = `
+The FLOW balance of the default vault of the account
+`
+
+const accountTypeAvailableBalanceFieldDocString = `
+The FLOW balance of the default vault of the account that is available to be moved, i.e. the balance minus the amount reserved for the storage used by the account
+`
+
 const accountTypeKeysFieldDocString = `
 The keys associated with the account
 `
@@ -686,3 +783,17 @@ Retrieves the key at the given index of the account.
 const authAccountKeysTypeRevokeFunctionDocString = `
 Revokes the key at the given index of the account.
 `
+
+const accountKeysTypeCountFieldDocString = `
+The number of keys of the account, including revoked keys
+`
+
+const accountKeysTypeForEachFunctionDocString = `
+Calls the given function with each key of the account, including revoked keys, in index order.
+
+Iteration stops when the function returns false
+`
+
+const accountKeysTypeGetByPublicKeyFunctionDocString = `
+Returns all keys of the account, including revoked keys, which have the given public key and signature algorithm, in index order
+`
@@ -22,7 +22,7 @@ import "github.com/onflow/cadence/runtime/ast"
 
 func (checker *Checker) VisitArrayExpression(expression *ast.ArrayExpression) ast.Repr {
 
-	// visit all elements, ensure they are all the same type
+	// visit all elements, ensure they have a common supertype
 
 	var elementType Type
 
@@ -36,23 +36,29 @@ func (checker *Checker) VisitArrayExpression(expression *ast.ArrayExpression) as
 		checker.checkVariableMove(value)
 		checker.checkResourceMoveOperation(value, valueType)
 
-		// infer element type from first element
-		// TODO: find common super type?
+		// infer element type as the least common supertype of all elements
 		if elementType == nil {
 			elementType = valueType
-		} else if !valueType.IsInvalidType() &&
-			!IsSubType(valueType, elementType) {
-
-			checker.report(
-				&TypeMismatchError{
-					ExpectedType: elementType,
-					ActualType:   valueType,
-					Range:        ast.NewRangeFromPositioned(value),
-				},
-			)
+		} else if !valueType.IsInvalidType() {
+			superType := LeastCommonSuperType(elementType, valueType)
+			if superType == nil {
+				checker.report(
+					&TypeMismatchError{
+						ExpectedType: elementType,
+						ActualType:   valueType,
+						Range:        ast.NewRangeFromPositioned(value),
+					},
+				)
+			} else {
+				elementType = superType
+			}
 		}
 	}
 
+	if elementType != nil {
+		checker.checkCommonSuperTypeWidening(elementType, argumentTypes, expression)
+	}
+
 	checker.Elaboration.ArrayExpressionArgumentTypes[expression] = argumentTypes
 
 	if elementType == nil {
@@ -171,17 +171,21 @@ func (checker *Checker) visitAssignmentValueType(
 
 	switch target := targetExpression.(type) {
 	case *ast.IdentifierExpression:
-		return checker.visitIdentifierExpressionAssignment(valueExpression, target, valueType)
+		targetType = checker.visitIdentifierExpressionAssignment(valueExpression, target, valueType)
 
 	case *ast.IndexExpression:
-		return checker.visitIndexExpressionAssignment(valueExpression, target, valueType)
+		targetType = checker.visitIndexExpressionAssignment(valueExpression, target, valueType)
 
 	case *ast.MemberExpression:
-		return checker.visitMemberExpressionAssignment(valueExpression, target, valueType)
+		targetType = checker.visitMemberExpressionAssignment(valueExpression, target, valueType)
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
+
+	checker.checkAssignmentTargetPurity(targetExpression)
+
+	return targetType
 }
 
 func (checker *Checker) visitIdentifierExpressionAssignment(
@@ -293,6 +297,7 @@ func (checker *Checker) visitMemberExpressionAssignment(
 				Name:              member.Identifier.Identifier,
 				RestrictingAccess: member.Access,
 				DeclarationKind:   member.DeclarationKind,
+				Extension:         memberExtension(member),
 				Range:             ast.NewRangeFromPositioned(target.Identifier),
 			},
 		)
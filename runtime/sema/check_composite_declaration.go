@@ -74,8 +74,12 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 		defer checker.valueActivations.Leave()
 	}
 
+	checker.declareTypeParameters(declaration.TypeParameterList, compositeType.typeParameters)
+
 	checker.declareCompositeNestedTypes(declaration, kind, true)
 
+	checker.declareTypeAliases(declaration.Members.TypeAliases())
+
 	var initializationInfo *InitializationInfo
 
 	if kind == ContainerKindComposite {
@@ -193,6 +197,18 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 	for _, nestedComposite := range declaration.Members.Composites() {
 		nestedComposite.Accept(checker)
 	}
+
+	for _, typeAlias := range declaration.Members.TypeAliases() {
+		typeAlias.Accept(checker)
+	}
+
+	for _, nestedExtension := range declaration.Members.Extensions() {
+		nestedExtension.Accept(checker)
+	}
+
+	for _, nestedEntitlement := range declaration.Members.Entitlements() {
+		nestedEntitlement.Accept(checker)
+	}
 }
 
 // declareCompositeNestedTypes declares the types nested in a composite,
@@ -281,10 +297,12 @@ func (checker *Checker) declareNestedDeclarations(
 	containerDeclarationKind common.DeclarationKind,
 	nestedCompositeDeclarations []*ast.CompositeDeclaration,
 	nestedInterfaceDeclarations []*ast.InterfaceDeclaration,
+	nestedEntitlementDeclarations []*ast.EntitlementDeclaration,
 ) (
 	nestedDeclarations map[string]ast.Declaration,
 	nestedInterfaceTypes []*InterfaceType,
 	nestedCompositeTypes []*CompositeType,
+	nestedEntitlementTypes []*EntitlementType,
 ) {
 	nestedDeclarations = map[string]ast.Declaration{}
 
@@ -318,6 +336,15 @@ func (checker *Checker) declareNestedDeclarations(
 				firstNestedInterfaceDeclaration.DeclarationKind(),
 				firstNestedInterfaceDeclaration.Identifier,
 			)
+
+		} else if len(nestedEntitlementDeclarations) > 0 {
+
+			firstNestedEntitlementDeclaration := nestedEntitlementDeclarations[0]
+
+			reportInvalidNesting(
+				firstNestedEntitlementDeclaration.DeclarationKind(),
+				firstNestedEntitlementDeclaration.Identifier,
+			)
 		}
 
 		// NOTE: don't return, so nested declarations / types are still declared
@@ -391,6 +418,18 @@ func (checker *Checker) declareNestedDeclarations(
 		nestedCompositeTypes = append(nestedCompositeTypes, nestedCompositeType)
 	}
 
+	// Declare nested entitlements.
+	// The container type is set by the caller
+
+	for _, nestedDeclaration := range nestedEntitlementDeclarations {
+		if _, exists := nestedDeclarations[nestedDeclaration.Identifier.Identifier]; !exists {
+			nestedDeclarations[nestedDeclaration.Identifier.Identifier] = nestedDeclaration
+		}
+
+		nestedEntitlementType := checker.declareEntitlementType(nestedDeclaration, nil)
+		nestedEntitlementTypes = append(nestedEntitlementTypes, nestedEntitlementType)
+	}
+
 	return
 }
 
@@ -435,11 +474,28 @@ func (checker *Checker) declareCompositeType(declaration *ast.CompositeDeclarati
 
 	if declaration.CompositeKind == common.CompositeKindEnum {
 		compositeType.EnumRawType = checker.enumRawType(declaration)
+		compositeType.EnumCases = enumCaseNames(declaration)
 	} else {
 		compositeType.ExplicitInterfaceConformances =
 			checker.explicitInterfaceConformances(declaration, compositeType)
 	}
 
+	// Resolve type parameters.
+	// Only structures can be generic
+
+	if declaration.TypeParameterList != nil {
+		if declaration.CompositeKind != common.CompositeKindStructure {
+			checker.report(
+				&UnsupportedTypeParametersError{
+					DeclarationKind: declaration.DeclarationKind(),
+					Range:           declaration.TypeParameterList.Range,
+				},
+			)
+		}
+
+		compositeType.typeParameters = checker.typeParameters(declaration.TypeParameterList)
+	}
+
 	// Register in elaboration
 
 	checker.Elaboration.CompositeDeclarationTypes[declaration] = compositeType
@@ -454,12 +510,13 @@ func (checker *Checker) declareCompositeType(declaration *ast.CompositeDeclarati
 
 	// Check and declare nested types
 
-	nestedDeclarations, nestedInterfaceTypes, nestedCompositeTypes :=
+	nestedDeclarations, nestedInterfaceTypes, nestedCompositeTypes, nestedEntitlementTypes :=
 		checker.declareNestedDeclarations(
 			declaration.CompositeKind,
 			declaration.DeclarationKind(),
 			declaration.Members.Composites(),
 			declaration.Members.Interfaces(),
+			declaration.Members.Entitlements(),
 		)
 
 	checker.Elaboration.CompositeNestedDeclarations[declaration] = nestedDeclarations
@@ -474,6 +531,11 @@ func (checker *Checker) declareCompositeType(declaration *ast.CompositeDeclarati
 		nestedCompositeType.ContainerType = compositeType
 	}
 
+	for _, nestedEntitlementType := range nestedEntitlementTypes {
+		compositeType.nestedTypes.Set(nestedEntitlementType.Identifier, nestedEntitlementType)
+		nestedEntitlementType.ContainerType = compositeType
+	}
+
 	return compositeType
 }
 
@@ -504,9 +566,13 @@ func (checker *Checker) declareCompositeMembersAndValue(
 		checker.valueActivations.Enter()
 		defer checker.valueActivations.Leave()
 
+		checker.declareTypeParameters(declaration.TypeParameterList, compositeType.typeParameters)
+
 		checker.declareCompositeNestedTypes(declaration, kind, false)
 
-		// NOTE: determine initializer parameter types while nested types are in scope,
+		checker.declareTypeAliases(declaration.Members.TypeAliases())
+
+		// NOTE: determine initializer parameter types while nested types and type aliases are in scope,
 		// and after declaring nested types as the initializer may use nested type in parameters
 
 		initializers := declaration.Members.Initializers()
@@ -629,6 +695,34 @@ func (checker *Checker) declareCompositeMembersAndValue(
 		if checker.originsAndOccurrencesEnabled {
 			checker.memberOrigins[compositeType] = origins
 		}
+
+		// Declare nested extensions and bring them into effect,
+		// *after* declaring the members of the nested composite declarations.
+		//
+		// Only contracts may declare extensions,
+		// but still declare them to avoid spurious errors
+
+		for _, nestedExtensionDeclaration := range declaration.Members.Extensions() {
+			if compositeType.Kind != common.CompositeKindContract {
+				checker.report(
+					&InvalidNestedDeclarationError{
+						NestedDeclarationKind:    nestedExtensionDeclaration.DeclarationKind(),
+						ContainerDeclarationKind: declaration.DeclarationKind(),
+						Range:                    ast.NewRangeFromPositioned(nestedExtensionDeclaration.Identifier),
+					},
+				)
+			}
+
+			extensionType := checker.declareExtensionType(nestedExtensionDeclaration, compositeType)
+			compositeType.Extensions = append(compositeType.Extensions, extensionType)
+
+			checker.declareExtensionMembers(nestedExtensionDeclaration)
+
+			checker.activateExtension(
+				extensionType,
+				ast.NewRangeFromPositioned(nestedExtensionDeclaration.Identifier),
+			)
+		}
 	})()
 
 	// Always determine composite constructor type
@@ -697,6 +791,23 @@ func (checker *Checker) declareCompositeConstructor(
 		argumentLabels:           constructorArgumentLabels,
 		allowOuterScopeShadowing: false,
 	})
+	checker.reportCompositeValueRedeclaration(err)
+}
+
+// reportCompositeValueRedeclaration reports the given error
+// of declaring the value of a composite declaration.
+//
+// Built-in types like `String` or `Int8` also have a value (their constructor or converter).
+// Redeclaring such a type as a composite is already reported when the composite type is declared,
+// so the redeclaration of the value is not reported a second time.
+//
+func (checker *Checker) reportCompositeValueRedeclaration(err error) {
+	if redeclarationError, ok := err.(*RedeclarationError); ok &&
+		BaseTypeActivation.Find(redeclarationError.Name) != nil {
+
+		return
+	}
+
 	checker.report(err)
 }
 
@@ -714,7 +825,7 @@ func (checker *Checker) declareContractValue(
 		pos:        declaration.Identifier.Pos,
 		isConstant: true,
 	})
-	checker.report(err)
+	checker.reportCompositeValueRedeclaration(err)
 
 	declarationMembers.Foreach(func(name string, declarationMember *Member) {
 		if _, ok := compositeType.Members.Get(name); ok {
@@ -724,6 +835,27 @@ func (checker *Checker) declareContractValue(
 	})
 }
 
+// enumCaseNames returns the names of the cases of the given enum declaration,
+// in declaration order. Redeclared cases are only included once
+//
+func enumCaseNames(declaration *ast.CompositeDeclaration) []string {
+	enumCases := declaration.Members.EnumCases()
+
+	names := make([]string, 0, len(enumCases))
+	seen := make(map[string]struct{}, len(enumCases))
+
+	for _, enumCase := range enumCases {
+		name := enumCase.Identifier.Identifier
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+
+	return names
+}
+
 func (checker *Checker) declareEnumConstructor(
 	declaration *ast.CompositeDeclaration,
 	compositeType *CompositeType,
@@ -740,6 +872,7 @@ func (checker *Checker) declareEnumConstructor(
 
 	constructorType := &SpecialFunctionType{
 		FunctionType: &FunctionType{
+			Purity: FunctionPurityView,
 			Parameters: []*Parameter{
 				{
 					Identifier:     EnumRawValueFieldName,
@@ -801,7 +934,7 @@ func (checker *Checker) declareEnumConstructor(
 		isConstant:     true,
 		argumentLabels: []string{EnumRawValueFieldName},
 	})
-	checker.report(err)
+	checker.reportCompositeValueRedeclaration(err)
 }
 
 // checkMemberStorability check that all fields have a type that is storable.
@@ -1111,6 +1244,15 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 				return false
 			}
 
+			// If the interface requires a view function,
+			// the implementation must be a view function, too
+
+			if interfaceMemberFunctionType.Purity == FunctionPurityView &&
+				compositeMemberFunctionType.Purity != FunctionPurityView {
+
+				return false
+			}
+
 			// Functions are invariant in their parameter types
 
 			for i, subParameter := range compositeMemberFunctionType.Parameters {
@@ -1158,7 +1300,19 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 	effectiveInterfaceMemberAccess := checker.effectiveInterfaceMemberAccess(interfaceMember.Access)
 	effectiveCompositeMemberAccess := checker.effectiveCompositeMemberAccess(compositeMember.Access)
 
-	return !effectiveCompositeMemberAccess.IsLessPermissiveThan(effectiveInterfaceMemberAccess)
+	if effectiveCompositeMemberAccess.IsLessPermissiveThan(effectiveInterfaceMemberAccess) {
+		return false
+	}
+
+	// If both members require entitlements, they must require the same entitlements
+
+	if effectiveCompositeMemberAccess == ast.AccessEntitlements &&
+		effectiveInterfaceMemberAccess == ast.AccessEntitlements {
+
+		return compositeMember.Entitlements.Equal(interfaceMember.Entitlements)
+	}
+
+	return true
 }
 
 // checkTypeRequirement checks conformance of a nested type declaration
@@ -1281,6 +1435,31 @@ func (checker *Checker) compositeConstructorType(
 		},
 	}
 
+	// The initializer of an event only initializes the fields
+	// from the parameters, so constructing an event has no side effects
+
+	if compositeType.Kind == common.CompositeKindEvent {
+		constructorFunctionType.Purity = FunctionPurityView
+	}
+
+	// The constructor of a generic structure is generic:
+	// It returns an instantiation of the structure
+
+	typeParameters := compositeType.TypeParameters()
+	if len(typeParameters) > 0 {
+		typeArguments := make([]Type, len(typeParameters))
+		for i, typeParameter := range typeParameters {
+			typeArguments[i] = &GenericType{
+				TypeParameter: typeParameter,
+			}
+		}
+
+		constructorFunctionType.TypeParameters = typeParameters
+		constructorFunctionType.ReturnTypeAnnotation = NewTypeAnnotation(
+			compositeType.Instantiate(typeArguments, checker.report),
+		)
+	}
+
 	// TODO: support multiple overloaded initializers
 
 	initializers := compositeDeclaration.Members.Initializers()
@@ -1408,6 +1587,7 @@ func (checker *Checker) defaultMembersAndOrigins(
 			&Member{
 				ContainerType:   containerType,
 				Access:          field.Access,
+				Entitlements:    checker.entitlementAccess(field.Entitlements),
 				Identifier:      field.Identifier,
 				DeclarationKind: declarationKind,
 				TypeAnnotation:  fieldTypeAnnotation,
@@ -1445,7 +1625,28 @@ func (checker *Checker) defaultMembersAndOrigins(
 
 		identifier := function.Identifier.Identifier
 
-		functionType := checker.functionType(function.ParameterList, function.ReturnTypeAnnotation)
+		if function.TypeParameterList != nil &&
+			containerKind == ContainerKindInterface {
+
+			checker.report(
+				&UnsupportedTypeParametersError{
+					DeclarationKind:          common.DeclarationKindFunction,
+					ContainerDeclarationKind: containerDeclarationKind,
+					Range:                    function.TypeParameterList.Range,
+				},
+			)
+		}
+
+		functionType := checker.functionDeclarationType(function)
+
+		// The type parameters of a generic function are shared
+		// by the member's type and the function's body,
+		// so register the function type for the check of the body,
+		// see `visitFunctionDeclaration`
+
+		if function.TypeParameterList != nil {
+			checker.Elaboration.FunctionDeclarationFunctionTypes[function] = functionType
+		}
 
 		argumentLabels := function.ParameterList.EffectiveArgumentLabels()
 
@@ -1473,6 +1674,7 @@ func (checker *Checker) defaultMembersAndOrigins(
 			&Member{
 				ContainerType:   containerType,
 				Access:          function.Access,
+				Entitlements:    checker.entitlementAccess(function.Entitlements),
 				Identifier:      function.Identifier,
 				DeclarationKind: declarationKind,
 				TypeAnnotation:  fieldTypeAnnotation,
@@ -1749,7 +1951,7 @@ func (checker *Checker) checkSpecialFunction(
 
 func (checker *Checker) checkCompositeFunctions(
 	functions []*ast.FunctionDeclaration,
-	selfType *CompositeType,
+	selfType Type,
 ) {
 	for _, function := range functions {
 		// NOTE: new activation, as function declarations
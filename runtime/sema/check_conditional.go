@@ -68,19 +68,28 @@ func (checker *Checker) VisitConditionalExpression(expression *ast.ConditionalEx
 		panic(errors.NewUnreachableError())
 	}
 
-	// TODO: improve
-	resultType := thenType
+	// The result type is the least common supertype of the two branch types
 
-	if !IsSubType(elseType, resultType) {
+	resultType := LeastCommonSuperType(thenType, elseType)
+
+	if resultType == nil {
 		checker.report(
 			&TypeMismatchError{
-				ExpectedType: resultType,
+				ExpectedType: thenType,
 				ActualType:   elseType,
 				Range:        ast.NewRangeFromPositioned(expression.Else),
 			},
 		)
+
+		resultType = thenType
+	} else {
+		checker.checkCommonSuperTypeWidening(resultType, []Type{thenType, elseType}, expression)
 	}
 
+	checker.Elaboration.ConditionalExpressionThenTypes[expression] = thenType
+	checker.Elaboration.ConditionalExpressionElseTypes[expression] = elseType
+	checker.Elaboration.ConditionalExpressionResultTypes[expression] = resultType
+
 	return resultType
 }
 
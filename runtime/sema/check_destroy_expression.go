@@ -25,6 +25,8 @@ import (
 func (checker *Checker) VisitDestroyExpression(expression *ast.DestroyExpression) (resultType ast.Repr) {
 	resultType = VoidType
 
+	checker.reportImpureOperation("cannot destroy resource", expression)
+
 	valueType := expression.Expression.Accept(checker).(Type)
 
 	checker.recordResourceInvalidation(
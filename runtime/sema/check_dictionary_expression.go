@@ -25,8 +25,8 @@ import (
 
 func (checker *Checker) VisitDictionaryExpression(expression *ast.DictionaryExpression) ast.Repr {
 
-	// visit all entries, ensure key are all the same type,
-	// and values are all the same type
+	// visit all entries, ensure keys have a common supertype,
+	// and values have a common supertype
 
 	var keyType, valueType Type
 
@@ -49,39 +49,55 @@ func (checker *Checker) VisitDictionaryExpression(expression *ast.DictionaryExpr
 			ValueType: entryValueType,
 		}
 
-		// infer key type from first entry's key
-		// TODO: find common super type?
+		// infer key type as the least common supertype of all entries' keys
 		if keyType == nil {
 			keyType = entryKeyType
-		} else if !entryKeyType.IsInvalidType() &&
-			!IsSubType(entryKeyType, keyType) {
-
-			checker.report(
-				&TypeMismatchError{
-					ExpectedType: keyType,
-					ActualType:   entryKeyType,
-					Range:        ast.NewRangeFromPositioned(entry.Key),
-				},
-			)
+		} else if !entryKeyType.IsInvalidType() {
+			superType := LeastCommonSuperType(keyType, entryKeyType)
+			if superType == nil {
+				checker.report(
+					&TypeMismatchError{
+						ExpectedType: keyType,
+						ActualType:   entryKeyType,
+						Range:        ast.NewRangeFromPositioned(entry.Key),
+					},
+				)
+			} else {
+				keyType = superType
+			}
 		}
 
-		// infer value type from first entry's value
-		// TODO: find common super type?
+		// infer value type as the least common supertype of all entries' values
 		if valueType == nil {
 			valueType = entryValueType
-		} else if !entryValueType.IsInvalidType() &&
-			!IsSubType(entryValueType, valueType) {
-
-			checker.report(
-				&TypeMismatchError{
-					ExpectedType: valueType,
-					ActualType:   entryValueType,
-					Range:        ast.NewRangeFromPositioned(entry.Value),
-				},
-			)
+		} else if !entryValueType.IsInvalidType() {
+			superType := LeastCommonSuperType(valueType, entryValueType)
+			if superType == nil {
+				checker.report(
+					&TypeMismatchError{
+						ExpectedType: valueType,
+						ActualType:   entryValueType,
+						Range:        ast.NewRangeFromPositioned(entry.Value),
+					},
+				)
+			} else {
+				valueType = superType
+			}
 		}
 	}
 
+	if keyType != nil {
+		keyTypes := make([]Type, len(entryTypes))
+		valueTypes := make([]Type, len(entryTypes))
+		for i, entryType := range entryTypes {
+			keyTypes[i] = entryType.KeyType
+			valueTypes[i] = entryType.ValueType
+		}
+
+		checker.checkCommonSuperTypeWidening(keyType, keyTypes, expression)
+		checker.checkCommonSuperTypeWidening(valueType, valueTypes, expression)
+	}
+
 	if keyType == nil {
 		keyType = NeverType
 	}
@@ -24,6 +24,8 @@ import (
 )
 
 func (checker *Checker) VisitEmitStatement(statement *ast.EmitStatement) ast.Repr {
+	checker.reportImpureOperation("cannot emit event", statement)
+
 	invocation := statement.InvocationExpression
 
 	ty := checker.checkInvocationExpression(invocation)
@@ -0,0 +1,122 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// VisitEntitlementDeclaration checks the entitlement declaration.
+//
+// NOTE: The entitlement type was already declared in `declareEntitlementType`
+//
+func (checker *Checker) VisitEntitlementDeclaration(declaration *ast.EntitlementDeclaration) ast.Repr {
+	checker.checkDeclarationAccessModifier(
+		declaration.Access,
+		declaration.DeclarationKind(),
+		declaration.StartPos,
+		true,
+	)
+
+	return nil
+}
+
+// declareEntitlementType declares the type of the given entitlement declaration
+// and records it in the elaboration.
+//
+// The container type is the type of the contract the entitlement is declared in, if any.
+//
+func (checker *Checker) declareEntitlementType(
+	declaration *ast.EntitlementDeclaration,
+	containerType Type,
+) *EntitlementType {
+
+	identifier := declaration.Identifier
+
+	entitlementType := &EntitlementType{
+		Location:      checker.Location,
+		Identifier:    identifier.Identifier,
+		ContainerType: containerType,
+	}
+
+	variable, err := checker.typeActivations.DeclareType(typeDeclaration{
+		identifier:               identifier,
+		ty:                       entitlementType,
+		declarationKind:          declaration.DeclarationKind(),
+		access:                   declaration.Access,
+		allowOuterScopeShadowing: false,
+	})
+	checker.report(err)
+
+	if checker.originsAndOccurrencesEnabled {
+		checker.recordVariableDeclarationOccurrence(
+			identifier.Identifier,
+			variable,
+		)
+	}
+
+	checker.Elaboration.EntitlementDeclarationTypes[declaration] = entitlementType
+
+	return entitlementType
+}
+
+// convertEntitlements converts the given entitlements, e.g. of a reference type.
+// Only entitlement types may be used as entitlements
+//
+func (checker *Checker) convertEntitlements(entitlements []*ast.NominalType) []*EntitlementType {
+	if len(entitlements) == 0 {
+		return nil
+	}
+
+	result := make([]*EntitlementType, 0, len(entitlements))
+
+	for _, entitlement := range entitlements {
+		ty := checker.resolveNominalType(entitlement)
+
+		entitlementType, ok := ty.(*EntitlementType)
+		if !ok {
+			if !ty.IsInvalidType() {
+				checker.report(
+					&InvalidNonEntitlementTypeError{
+						Type:  ty,
+						Range: ast.NewRangeFromPositioned(entitlement),
+					},
+				)
+			}
+			continue
+		}
+
+		result = append(result, entitlementType)
+	}
+
+	return result
+}
+
+// entitlementAccess converts the entitlements of an entitlement access modifier, if any
+//
+func (checker *Checker) entitlementAccess(entitlementSet *ast.EntitlementSet) *EntitlementAccess {
+	if entitlementSet == nil {
+		return nil
+	}
+
+	return &EntitlementAccess{
+		Entitlements:  checker.convertEntitlements(entitlementSet.Entitlements),
+		IsDisjunction: entitlementSet.IsDisjunction,
+	}
+}
@@ -76,7 +76,13 @@ func IsValidEventParameterType(t Type, results map[*Member]bool) bool {
 			IsValidEventParameterType(t.ValueType, results)
 
 	case *CompositeType:
-		if t.Kind != common.CompositeKindStructure {
+		// Structures and enums are exported like the event itself,
+		// i.e. their fields are exported
+
+		switch t.Kind {
+		case common.CompositeKindStructure, common.CompositeKindEnum:
+			break
+		default:
 			return false
 		}
 
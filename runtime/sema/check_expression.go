@@ -184,13 +184,32 @@ func (checker *Checker) VisitIntegerExpression(_ *ast.IntegerExpression) ast.Rep
 }
 
 func (checker *Checker) VisitFixedPointExpression(expression *ast.FixedPointExpression) ast.Repr {
-	// TODO: adjust once/if we support more fixed point types
+	return FixedPointLiteralType(expression)
+}
 
+// FixedPointLiteralType returns the type of the given fixed-point literal.
+//
+// Negative literals have type `Fix64`, and all other literals have type `UFix64`.
+// If the literal has more decimal places than these types support,
+// or if it does not fit into these types, but fits into the 128-bit fixed-point types,
+// it has type `Fix128` or `UFix128`, respectively
+//
+func FixedPointLiteralType(expression *ast.FixedPointExpression) Type {
+	var smallType, largeType Type
 	if expression.Negative {
-		return &Fix64Type{}
+		smallType, largeType = &Fix64Type{}, &Fix128Type{}
 	} else {
-		return &UFix64Type{}
+		smallType, largeType = &UFix64Type{}, &UFix128Type{}
+	}
+
+	if expression.Scale > Fix64Scale ||
+		(!CheckFixedPointLiteral(expression, smallType, nil) &&
+			CheckFixedPointLiteral(expression, largeType, nil)) {
+
+		return largeType
 	}
+
+	return smallType
 }
 
 func (checker *Checker) VisitStringExpression(_ *ast.StringExpression) ast.Repr {
@@ -213,6 +232,8 @@ func (checker *Checker) visitIndexExpression(
 	targetExpression := indexExpression.TargetExpression
 	targetType := targetExpression.Accept(checker).(Type)
 
+	checker.Elaboration.IndexExpressionTargetTypes[indexExpression] = targetType
+
 	// NOTE: check indexed type first for UX reasons
 
 	// check indexed expression's type is indexable
@@ -0,0 +1,478 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// extensionActivations are the extensions which are in effect
+// in the checked program
+//
+type extensionActivations struct {
+	// byBaseType are the active extensions, by the type ID of their base type
+	byBaseType map[TypeID][]*ExtensionType
+	// activated are the type IDs of the active extensions
+	activated map[TypeID]bool
+}
+
+func newExtensionActivations() *extensionActivations {
+	return &extensionActivations{
+		byBaseType: map[TypeID][]*ExtensionType{},
+		activated:  map[TypeID]bool{},
+	}
+}
+
+// VisitExtensionDeclaration checks the extension declaration.
+//
+// NOTE: The extension type and its members were already declared
+// in `declareExtensionType` and `declareExtensionMembers`
+//
+func (checker *Checker) VisitExtensionDeclaration(declaration *ast.ExtensionDeclaration) ast.Repr {
+
+	extensionType := checker.Elaboration.ExtensionDeclarationTypes[declaration]
+	if extensionType == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	checker.containerTypes[extensionType] = true
+	defer func() {
+		checker.containerTypes[extensionType] = false
+	}()
+
+	checker.checkDeclarationAccessModifier(
+		declaration.Access,
+		declaration.DeclarationKind(),
+		declaration.StartPos,
+		true,
+	)
+
+	checker.checkFieldsAccessModifier(declaration.Members.Fields())
+
+	checker.checkNestedIdentifiers(declaration.Members)
+
+	checker.checkExtensionMemberDeclarations(declaration.Members)
+
+	checker.checkExtensionBaseTypeConflicts(extensionType)
+
+	// The functions of the extension are functions of the base type,
+	// so `self` has the base type
+
+	checker.checkCompositeFunctions(
+		declaration.Members.Functions(),
+		extensionType.BaseType,
+	)
+
+	return nil
+}
+
+// checkExtensionMemberDeclarations reports all member declarations
+// which are not fields or functions
+//
+func (checker *Checker) checkExtensionMemberDeclarations(members *ast.Members) {
+	for _, declaration := range members.Declarations() {
+		switch declaration.(type) {
+		case *ast.FieldDeclaration, *ast.FunctionDeclaration:
+			continue
+		}
+
+		var errorRange ast.Range
+		identifier := declaration.DeclarationIdentifier()
+		if identifier != nil {
+			errorRange = ast.NewRangeFromPositioned(identifier)
+		} else {
+			errorRange = ast.NewRangeFromPositioned(declaration)
+		}
+
+		checker.report(
+			&InvalidExtensionMemberError{
+				DeclarationKind: declaration.DeclarationKind(),
+				Range:           errorRange,
+			},
+		)
+	}
+}
+
+// checkExtensionBaseTypeConflicts reports all members of the extension
+// which have the same name as a member of the base type.
+//
+// NOTE: The members of the base type must only be requested
+// once the members of all types of the program are declared
+//
+func (checker *Checker) checkExtensionBaseTypeConflicts(extensionType *ExtensionType) {
+	baseType := extensionType.BaseType
+	if baseType.IsInvalidType() {
+		return
+	}
+
+	baseMembers := baseType.GetMembers()
+
+	extensionType.Members.Foreach(func(name string, member *Member) {
+		if _, ok := baseMembers[name]; !ok {
+			return
+		}
+
+		checker.report(
+			&ExtensionMemberConflictError{
+				Name:            name,
+				ExtensionType:   extensionType,
+				ConflictingType: baseType,
+				Range:           ast.NewRangeFromPositioned(member.Identifier),
+			},
+		)
+	})
+}
+
+// declareExtensionType declares the type of the given extension declaration
+// and converts its base type.
+//
+// The container type is the type of the contract the extension is declared in, if any.
+//
+func (checker *Checker) declareExtensionType(
+	declaration *ast.ExtensionDeclaration,
+	containerType Type,
+) *ExtensionType {
+
+	identifier := declaration.Identifier
+
+	extensionType := &ExtensionType{
+		Location:      checker.Location,
+		Identifier:    identifier.Identifier,
+		Members:       NewStringMemberOrderedMap(),
+		ContainerType: containerType,
+	}
+
+	extensionType.BaseType = checker.extensionBaseType(declaration.BaseType)
+
+	variable, err := checker.typeActivations.DeclareType(typeDeclaration{
+		identifier:               identifier,
+		ty:                       extensionType,
+		declarationKind:          declaration.DeclarationKind(),
+		access:                   declaration.Access,
+		allowOuterScopeShadowing: false,
+	})
+	checker.report(err)
+
+	if checker.originsAndOccurrencesEnabled {
+		checker.recordVariableDeclarationOccurrence(
+			identifier.Identifier,
+			variable,
+		)
+	}
+
+	checker.Elaboration.ExtensionDeclarationTypes[declaration] = extensionType
+
+	return extensionType
+}
+
+// extensionBaseType converts the given base type of an extension declaration.
+//
+// Only structures, resources, and structure and resource interfaces can be extended.
+//
+func (checker *Checker) extensionBaseType(nominalType *ast.NominalType) Type {
+	baseType := checker.ConvertType(nominalType)
+
+	var compositeKind common.CompositeKind
+
+	switch baseType := baseType.(type) {
+	case *CompositeType:
+		compositeKind = baseType.Kind
+	case *InterfaceType:
+		compositeKind = baseType.CompositeKind
+	default:
+		if !baseType.IsInvalidType() {
+			checker.report(
+				&InvalidExtensionBaseTypeError{
+					Type:  baseType,
+					Range: ast.NewRangeFromPositioned(nominalType),
+				},
+			)
+		}
+		return InvalidType
+	}
+
+	switch compositeKind {
+	case common.CompositeKindStructure, common.CompositeKindResource:
+		return baseType
+	}
+
+	checker.report(
+		&InvalidExtensionBaseTypeError{
+			Type:  baseType,
+			Range: ast.NewRangeFromPositioned(nominalType),
+		},
+	)
+
+	return InvalidType
+}
+
+// declareExtensionMembers declares the fields and functions of the given extension declaration.
+//
+// Extension fields are stored alongside the base value and are `nil` until assigned,
+// so they must be variable fields of a storable, non-resource optional type.
+//
+func (checker *Checker) declareExtensionMembers(declaration *ast.ExtensionDeclaration) {
+
+	extensionType := checker.Elaboration.ExtensionDeclarationTypes[declaration]
+
+	fields := declaration.Members.Fields()
+	functions := declaration.Members.Functions()
+
+	members := NewStringMemberOrderedMap()
+	fieldNames := make([]string, 0, len(fields))
+
+	var origins map[string]*Origin
+	if checker.originsAndOccurrencesEnabled {
+		origins = make(map[string]*Origin, len(fields)+len(functions))
+	}
+
+	for _, field := range fields {
+		identifier := field.Identifier.Identifier
+
+		fieldTypeAnnotation := checker.ConvertTypeAnnotation(field.TypeAnnotation)
+		checker.checkTypeAnnotation(fieldTypeAnnotation, field.TypeAnnotation)
+
+		fieldType := fieldTypeAnnotation.Type
+
+		if field.VariableKind != ast.VariableKindVariable ||
+			!fieldType.IsInvalidType() && !isValidExtensionFieldType(fieldType) {
+
+			checker.report(
+				&InvalidExtensionFieldError{
+					Name:  identifier,
+					Range: ast.NewRangeFromPositioned(field.Identifier),
+				},
+			)
+		}
+
+		fieldNames = append(fieldNames, identifier)
+
+		members.Set(
+			identifier,
+			&Member{
+				ContainerType:   extensionType,
+				Access:          field.Access,
+				Identifier:      field.Identifier,
+				DeclarationKind: common.DeclarationKindField,
+				TypeAnnotation:  fieldTypeAnnotation,
+				VariableKind:    field.VariableKind,
+				DocString:       field.DocString,
+			})
+
+		if checker.originsAndOccurrencesEnabled {
+			origin := checker.recordFieldDeclarationOrigin(
+				field.Identifier,
+				field.StartPos,
+				field.EndPos,
+				fieldType,
+			)
+			origin.Extension = extensionType
+			origins[identifier] = origin
+		}
+	}
+
+	for _, function := range functions {
+		identifier := function.Identifier.Identifier
+
+		functionType := checker.functionDeclarationType(function)
+
+		// The type parameters of a generic function are shared
+		// by the member's type and the function's body,
+		// see `visitFunctionDeclaration`
+
+		if function.TypeParameterList != nil {
+			checker.Elaboration.FunctionDeclarationFunctionTypes[function] = functionType
+		}
+
+		members.Set(
+			identifier,
+			&Member{
+				ContainerType:   extensionType,
+				Access:          function.Access,
+				Identifier:      function.Identifier,
+				DeclarationKind: common.DeclarationKindFunction,
+				TypeAnnotation:  NewTypeAnnotation(functionType),
+				VariableKind:    ast.VariableKindConstant,
+				ArgumentLabels:  function.ParameterList.EffectiveArgumentLabels(),
+				DocString:       function.DocString,
+			})
+
+		if checker.originsAndOccurrencesEnabled {
+			origin := checker.recordFunctionDeclarationOrigin(function, functionType)
+			origin.Extension = extensionType
+			origins[identifier] = origin
+		}
+	}
+
+	extensionType.Members = members
+	extensionType.Fields = fieldNames
+
+	if checker.originsAndOccurrencesEnabled {
+		checker.memberOrigins[extensionType] = origins
+	}
+}
+
+func isValidExtensionFieldType(fieldType Type) bool {
+	if _, ok := fieldType.(*OptionalType); !ok {
+		return false
+	}
+
+	return !fieldType.IsResourceType() &&
+		fieldType.IsStorable(map[*Member]bool{})
+}
+
+// activateExtension brings the given extension into effect,
+// i.e. its members become members of its base type.
+//
+// The members of the extension may not conflict
+// with the members of other active extensions of the same base type.
+//
+func (checker *Checker) activateExtension(extensionType *ExtensionType, errorRange ast.Range) {
+
+	extensionTypeID := extensionType.ID()
+
+	if checker.extensions.activated[extensionTypeID] {
+		return
+	}
+	checker.extensions.activated[extensionTypeID] = true
+
+	baseType := extensionType.BaseType
+	if baseType.IsInvalidType() {
+		return
+	}
+
+	baseTypeID := baseType.ID()
+
+	for _, otherExtensionType := range checker.extensions.byBaseType[baseTypeID] {
+		extensionType.Members.Foreach(func(name string, _ *Member) {
+			if _, ok := otherExtensionType.Members.Get(name); !ok {
+				return
+			}
+
+			checker.report(
+				&ExtensionMemberConflictError{
+					Name:            name,
+					ExtensionType:   extensionType,
+					ConflictingType: otherExtensionType,
+					Range:           errorRange,
+				},
+			)
+		})
+	}
+
+	checker.extensions.byBaseType[baseTypeID] = append(
+		checker.extensions.byBaseType[baseTypeID],
+		extensionType,
+	)
+}
+
+// activateImportedExtensions brings the extensions of the given imported type into effect:
+// An imported extension, and the extensions declared in an imported contract.
+//
+func (checker *Checker) activateImportedExtensions(ty Type, errorRange ast.Range) {
+	switch ty := ty.(type) {
+	case *ExtensionType:
+		checker.activateExtension(ty, errorRange)
+
+	case *CompositeType:
+		for _, extensionType := range ty.Extensions {
+			checker.activateExtension(extensionType, errorRange)
+		}
+	}
+}
+
+// extensionMember returns the member with the given name
+// which an active extension adds to the given type, if any.
+//
+// The extensions of the type itself take precedence
+// over the extensions of the interfaces it conforms to.
+//
+func (checker *Checker) extensionMember(ty Type, name string) *Member {
+	for _, extendedType := range extendedTypes(ty) {
+		for _, extensionType := range checker.extensions.byBaseType[extendedType.ID()] {
+			member, ok := extensionType.Members.Get(name)
+			if ok {
+				return member
+			}
+		}
+	}
+
+	return nil
+}
+
+// extendedTypes returns the types whose extensions apply to values of the given type
+//
+func extendedTypes(ty Type) []Type {
+	switch ty := ty.(type) {
+	case *CompositeType:
+		result := make([]Type, 0, 1+len(ty.ExplicitInterfaceConformances))
+		result = append(result, ty)
+		for _, conformance := range ty.ExplicitInterfaceConformances {
+			result = append(result, conformance)
+		}
+		return result
+
+	case *InterfaceType:
+		return []Type{ty}
+
+	case *RestrictedType:
+		// Only the members of the restrictions are available
+		result := make([]Type, 0, len(ty.Restrictions))
+		for _, restriction := range ty.Restrictions {
+			result = append(result, restriction)
+		}
+		return result
+
+	case *ReferenceType:
+		return extendedTypes(ty.Type)
+	}
+
+	return nil
+}
+
+// extensionMemberOrigin returns the origin of the given member of the given extension.
+//
+// The origins of the members of imported extensions are not available,
+// so the origin only has the type of the member and its extension.
+//
+func (checker *Checker) extensionMemberOrigin(extensionType *ExtensionType, member *Member) *Origin {
+	origin := checker.memberOrigins[extensionType][member.Identifier.Identifier]
+	if origin != nil {
+		return origin
+	}
+
+	return &Origin{
+		Type:            member.TypeAnnotation.Type,
+		DeclarationKind: member.DeclarationKind,
+		Extension:       extensionType,
+	}
+}
+
+// memberExtension returns the extension which declares the given member, if any
+//
+func memberExtension(member *Member) *ExtensionType {
+	if member == nil {
+		return nil
+	}
+
+	extensionType, _ := member.ContainerType.(*ExtensionType)
+	return extensionType
+}
@@ -48,10 +48,12 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 			)
 		} else if arrayType, ok := valueType.(ArrayType); ok {
 			elementType = arrayType.ElementType(false)
+		} else if rangeType, ok := valueType.(*InclusiveRangeType); ok {
+			elementType = rangeType.memberType()
 		} else {
 			checker.report(
 				&TypeMismatchWithDescriptionError{
-					ExpectedTypeDescription: "array",
+					ExpectedTypeDescription: "array or range",
 					ActualType:              valueType,
 					Range:                   ast.NewRangeFromPositioned(valueExpression),
 				},
@@ -79,8 +81,10 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr
 	// That means that resource invalidations and
 	// returns are not definite, but only potential.
 
+	label := checker.declareLoopLabel(statement.Label)
+
 	_ = checker.checkPotentiallyUnevaluated(func() Type {
-		checker.functionActivations.WithLoop(func() {
+		checker.functionActivations.WithLoop(label, func() {
 			statement.Block.Accept(checker)
 		})
 
@@ -65,7 +65,7 @@ func (checker *Checker) visitFunctionDeclaration(
 
 	functionType := checker.Elaboration.FunctionDeclarationFunctionTypes[declaration]
 	if functionType == nil {
-		functionType = checker.functionType(declaration.ParameterList, declaration.ReturnTypeAnnotation)
+		functionType = checker.functionDeclarationType(declaration)
 
 		if options.declareFunction {
 			checker.declareFunctionDeclaration(declaration, functionType)
@@ -74,6 +74,15 @@ func (checker *Checker) visitFunctionDeclaration(
 
 	checker.Elaboration.FunctionDeclarationFunctionTypes[declaration] = functionType
 
+	// The type parameters of a generic function are in scope in the function's body
+
+	if declaration.TypeParameterList != nil {
+		checker.typeActivations.Enter()
+		defer checker.typeActivations.Leave()
+
+		checker.declareTypeParameters(declaration.TypeParameterList, functionType.TypeParameters)
+	}
+
 	checker.checkFunction(
 		declaration.ParameterList,
 		declaration.ReturnTypeAnnotation,
@@ -157,10 +166,19 @@ func (checker *Checker) checkFunction(
 			functionActivation.InitializationInfo = initializationInfo
 
 			if functionBlock != nil {
-				checker.visitFunctionBlock(
-					functionBlock,
-					functionType.ReturnTypeAnnotation,
-					checkResourceLoss,
+				// The body of a view function must not have side effects.
+				// The body of an impure function may have side effects,
+				// even if it is declared in a view context
+
+				checker.withPurityCheckScope(
+					functionType.Purity == FunctionPurityView,
+					func() {
+						checker.visitFunctionBlock(
+							functionBlock,
+							functionType.ReturnTypeAnnotation,
+							checkResourceLoss,
+						)
+					},
 				)
 
 				if mustExit {
@@ -307,7 +325,12 @@ func (checker *Checker) visitWithPostConditions(postConditions *ast.Conditions,
 
 		checker.Elaboration.PostConditionsRewrite[postConditions] = rewriteResult
 
-		checker.visitStatements(rewriteResult.BeforeStatements)
+		// The extracted `before` expressions are part of the post-conditions,
+		// so they are checked like them
+
+		checker.withConditionsPurityCheckScope(func() {
+			checker.visitStatements(rewriteResult.BeforeStatements)
+		})
 	}
 
 	body()
@@ -380,7 +403,7 @@ func (checker *Checker) declareBefore() {
 func (checker *Checker) VisitFunctionExpression(expression *ast.FunctionExpression) ast.Repr {
 
 	// TODO: infer
-	functionType := checker.functionType(expression.ParameterList, expression.ReturnTypeAnnotation)
+	functionType := checker.functionType(expression.Purity, expression.ParameterList, expression.ReturnTypeAnnotation)
 
 	checker.Elaboration.FunctionExpressionFunctionType[expression] = functionType
 
@@ -133,7 +133,7 @@ func (checker *Checker) importResolvedLocation(resolvedLocation ResolvedLocation
 	// Attempt to import the requested value declarations
 
 	allValueElements := imp.AllValueElements()
-	foundValues, invalidAccessedValues := checker.importElements(
+	foundValues, invalidAccessedValues, _ := checker.importElements(
 		checker.valueActivations,
 		resolvedLocation.Identifiers,
 		allValueElements,
@@ -143,13 +143,19 @@ func (checker *Checker) importResolvedLocation(resolvedLocation ResolvedLocation
 	// Attempt to import the requested type declarations
 
 	allTypeElements := imp.AllTypeElements()
-	foundTypes, invalidAccessedTypes := checker.importElements(
+	foundTypes, invalidAccessedTypes, importedTypes := checker.importElements(
 		checker.typeActivations,
 		resolvedLocation.Identifiers,
 		allTypeElements,
 		imp.IsImportableType,
 	)
 
+	// Bring the imported extensions, and the extensions declared in imported contracts, into effect
+
+	for _, importedType := range importedTypes {
+		checker.activateImportedExtensions(importedType.Type, locationRange)
+	}
+
 	// For each identifier, report if the import is invalid due to
 	// restricted access and report an error (i.e. if there is
 	// both a value and type with the same name, only report a single error)
@@ -271,6 +277,7 @@ func (checker *Checker) importElements(
 ) (
 	found map[ast.Identifier]bool,
 	invalidAccessed map[ast.Identifier]ImportElement,
+	imported []ImportElement,
 ) {
 	found = map[ast.Identifier]bool{}
 	invalidAccessed = map[ast.Identifier]ImportElement{}
@@ -336,6 +343,8 @@ func (checker *Checker) importElements(
 				allowOuterScopeShadowing: false,
 			})
 			checker.report(err)
+
+			imported = append(imported, element)
 		})
 	}
 
@@ -62,10 +62,12 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 	checker.typeActivations.Enter()
 	defer checker.typeActivations.Leave()
 
-	// Declare nested types
+	// Declare nested types and type aliases
 
 	checker.declareInterfaceNestedTypes(declaration)
 
+	checker.declareTypeAliases(declaration.Members.TypeAliases())
+
 	checker.checkInitializers(
 		declaration.Members.Initializers(),
 		declaration.Members.Fields(),
@@ -117,6 +119,14 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 		checker.visitCompositeDeclaration(nestedComposite, kind)
 	}
 
+	for _, typeAlias := range declaration.Members.TypeAliases() {
+		typeAlias.Accept(checker)
+	}
+
+	for _, nestedEntitlement := range declaration.Members.Entitlements() {
+		nestedEntitlement.Accept(checker)
+	}
+
 	return nil
 }
 
@@ -247,12 +257,13 @@ func (checker *Checker) declareInterfaceType(declaration *ast.InterfaceDeclarati
 
 	// Check and declare nested types
 
-	nestedDeclarations, nestedInterfaceTypes, nestedCompositeTypes :=
+	nestedDeclarations, nestedInterfaceTypes, nestedCompositeTypes, nestedEntitlementTypes :=
 		checker.declareNestedDeclarations(
 			declaration.CompositeKind,
 			declaration.DeclarationKind(),
 			declaration.Members.Composites(),
 			declaration.Members.Interfaces(),
+			declaration.Members.Entitlements(),
 		)
 
 	checker.Elaboration.InterfaceNestedDeclarations[declaration] = nestedDeclarations
@@ -267,6 +278,11 @@ func (checker *Checker) declareInterfaceType(declaration *ast.InterfaceDeclarati
 		nestedCompositeType.ContainerType = interfaceType
 	}
 
+	for _, nestedEntitlementType := range nestedEntitlementTypes {
+		interfaceType.nestedTypes.Set(nestedEntitlementType.Identifier, nestedEntitlementType)
+		nestedEntitlementType.ContainerType = interfaceType
+	}
+
 	return interfaceType
 }
 
@@ -292,10 +308,12 @@ func (checker *Checker) declareInterfaceMembers(declaration *ast.InterfaceDeclar
 	checker.valueActivations.Enter()
 	defer checker.valueActivations.Leave()
 
-	// Declare nested types
+	// Declare nested types and type aliases
 
 	checker.declareInterfaceNestedTypes(declaration)
 
+	checker.declareTypeAliases(declaration.Members.TypeAliases())
+
 	// Declare members
 
 	members, fields, origins := checker.defaultMembersAndOrigins(
@@ -330,6 +348,18 @@ func (checker *Checker) declareInterfaceMembers(declaration *ast.InterfaceDeclar
 	for _, nestedCompositeDeclaration := range declaration.Members.Composites() {
 		checker.declareCompositeMembersAndValue(nestedCompositeDeclaration, ContainerKindInterface)
 	}
+
+	// Interfaces cannot declare extensions
+
+	for _, nestedExtensionDeclaration := range declaration.Members.Extensions() {
+		checker.report(
+			&InvalidNestedDeclarationError{
+				NestedDeclarationKind:    nestedExtensionDeclaration.DeclarationKind(),
+				ContainerDeclarationKind: declaration.DeclarationKind(),
+				Range:                    ast.NewRangeFromPositioned(nestedExtensionDeclaration.Identifier),
+			},
+		)
+	}
 }
 
 func (checker *Checker) checkInterfaceSpecialFunctionBlock(
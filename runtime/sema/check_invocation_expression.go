@@ -327,6 +327,8 @@ func (checker *Checker) checkInvocation(
 ) {
 	functionType := invokableType.InvocationFunctionType()
 
+	checker.checkInvocationPurity(functionType, invocationExpression)
+
 	parameterCount := len(functionType.Parameters)
 	requiredArgumentCount := functionType.RequiredArgumentCount
 	typeParameterCount := len(functionType.TypeParameters)
@@ -337,6 +339,17 @@ func (checker *Checker) checkInvocation(
 
 	typeArguments := NewTypeParameterTypeOrderedMap()
 
+	// If the invocation is in a generic declaration,
+	// the parameter types and the return type might refer to its type parameters.
+	// Bind them to themselves, so they are not inferred
+
+	enclosingTypeParameterTypes := checker.enclosingTypeParameterTypes(functionType.TypeParameters)
+	if enclosingTypeParameterTypes != nil {
+		enclosingTypeParameterTypes.Foreach(func(typeParameter *TypeParameter, ty Type) {
+			typeArguments.Set(typeParameter, ty)
+		})
+	}
+
 	// If the function type is generic, the invocation might provide
 	// explicit type arguments for the type parameters.
 
@@ -438,6 +451,19 @@ func (checker *Checker) checkInvocation(
 
 	// Save types in the elaboration
 
+	// Only record the type arguments for the type parameters of the invoked function
+
+	if enclosingTypeParameterTypes != nil {
+		invocationTypeArguments := NewTypeParameterTypeOrderedMap()
+		typeArguments.Foreach(func(typeParameter *TypeParameter, ty Type) {
+			if _, ok := enclosingTypeParameterTypes.Get(typeParameter); ok {
+				return
+			}
+			invocationTypeArguments.Set(typeParameter, ty)
+		})
+		typeArguments = invocationTypeArguments
+	}
+
 	checker.Elaboration.InvocationExpressionTypeArguments[invocationExpression] = typeArguments
 	checker.Elaboration.InvocationExpressionParameterTypes[invocationExpression] = parameterTypes
 	checker.Elaboration.InvocationExpressionReturnTypes[invocationExpression] = returnType
@@ -158,6 +158,8 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 	getMemberForType := func(expressionType Type) {
 		resolver, ok := expressionType.GetMembers()[identifier]
 		if !ok {
+			// The member might be added by an extension which is in effect
+			member = checker.extensionMember(expressionType, identifier)
 			return
 		}
 		targetRange := ast.NewRangeFromPositioned(expression.Expression)
@@ -218,9 +220,16 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 		}
 	} else {
 
+		extensionType := memberExtension(member)
+
 		if checker.originsAndOccurrencesEnabled {
-			origins := checker.memberOrigins[accessedType]
-			origin := origins[identifier]
+			var origin *Origin
+			if extensionType != nil {
+				origin = checker.extensionMemberOrigin(extensionType, member)
+			} else {
+				origins := checker.memberOrigins[accessedType]
+				origin = origins[identifier]
+			}
 			checker.Occurrences.Put(
 				identifierStartPosition,
 				identifierEndPosition,
@@ -236,11 +245,29 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 					Name:              member.Identifier.Identifier,
 					RestrictingAccess: member.Access,
 					DeclarationKind:   member.DeclarationKind,
+					Extension:         extensionType,
 					Range:             ast.NewRangeFromPositioned(expression),
 				},
 			)
 		}
 
+		// Check that the member is not accessed through a reference
+		// which does not grant the entitlements required by the member
+
+		if member.Entitlements != nil {
+			referenceType := checker.accessingReferenceType(accessedType, accessedExpression)
+			if referenceType != nil && !member.Entitlements.PermittedBy(referenceType) {
+				checker.report(
+					&InvalidEntitlementAccessError{
+						Name:                 member.Identifier.Identifier,
+						RequiredEntitlements: member.Entitlements,
+						ReferenceType:        referenceType,
+						Range:                ast.NewRangeFromPositioned(expression),
+					},
+				)
+			}
+		}
+
 		// Check that the member access is not to a function of resource type
 		// outside of an invocation of it.
 		//
@@ -262,6 +289,55 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 	return accessedType, member, isOptional
 }
 
+// accessingReferenceType returns the reference type through which
+// the accessed value of a member expression is accessed, if any.
+//
+// Values which are nested in a referenced value, e.g. a resource in a field,
+// an array element, or a dictionary value, are accessed through the reference,
+// so e.g. for `ref.vault.withdraw`, `ref.vaults[0].withdraw`,
+// `ref.vaults["a"]?.withdraw`, and `ref.vault!.withdraw`
+// the reference type of `ref` is returned
+//
+func (checker *Checker) accessingReferenceType(accessedType Type, accessedExpression ast.Expression) *ReferenceType {
+	for {
+		if referenceType, ok := UnwrapOptionalType(accessedType).(*ReferenceType); ok {
+			return referenceType
+		}
+
+		switch expression := accessedExpression.(type) {
+		case *ast.MemberExpression:
+			// NOTE: optional chaining is also a member expression
+
+			memberInfo, ok := checker.Elaboration.MemberExpressionMemberInfos[expression]
+			if !ok {
+				return nil
+			}
+
+			accessedType = memberInfo.AccessedType
+			accessedExpression = expression.Expression
+
+		case *ast.IndexExpression:
+			targetType, ok := checker.Elaboration.IndexExpressionTargetTypes[expression]
+			if !ok {
+				return nil
+			}
+
+			accessedType = targetType
+			accessedExpression = expression.TargetExpression
+
+		case *ast.ForceExpression:
+			// The forced expression has the optional type of the accessed type,
+			// which is a reference type if and only if the accessed type is one,
+			// and optional types are unwrapped above
+
+			accessedExpression = expression.Expression
+
+		default:
+			return nil
+		}
+	}
+}
+
 // isReadableMember returns true if the given member can be read from
 // in the current location of the checker
 //
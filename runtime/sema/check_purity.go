@@ -0,0 +1,210 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// purityCheckScope is a scope in which operations may be checked for side effects,
+// e.g. the body of a function, or the conditions of a function
+//
+type purityCheckScope struct {
+	// enforcePurity specifies if operations with side effects are reported,
+	// i.e. if the scope is a view context
+	enforcePurity bool
+	// valueActivationDepth is the depth of the value activations when the scope was entered.
+	// Variables declared at this depth or deeper are local to the scope
+	valueActivationDepth int
+}
+
+// withPurityCheckScope calls the given function in a new purity check scope.
+// If enforcePurity is true, the scope is a view context,
+// and operations with side effects are reported
+//
+func (checker *Checker) withPurityCheckScope(enforcePurity bool, f func()) {
+	checker.purityCheckScopes = append(
+		checker.purityCheckScopes,
+		purityCheckScope{
+			enforcePurity:        enforcePurity,
+			valueActivationDepth: checker.valueActivations.Depth(),
+		},
+	)
+	defer func() {
+		lastIndex := len(checker.purityCheckScopes) - 1
+		checker.purityCheckScopes = checker.purityCheckScopes[:lastIndex]
+	}()
+
+	f()
+}
+
+// withConditionsPurityCheckScope calls the given function, which checks conditions.
+// If view conditions are enabled, the conditions are checked in a view context.
+// Otherwise, the conditions are checked in the current scope,
+// i.e. they may have side effects, unless they are conditions of a view function
+//
+func (checker *Checker) withConditionsPurityCheckScope(f func()) {
+	if !checker.viewConditionsEnabled {
+		f()
+		return
+	}
+
+	checker.withPurityCheckScope(true, f)
+}
+
+func (checker *Checker) currentPurityCheckScope() *purityCheckScope {
+	lastIndex := len(checker.purityCheckScopes) - 1
+	if lastIndex < 0 {
+		return nil
+	}
+	return &checker.purityCheckScopes[lastIndex]
+}
+
+// inViewContext returns true if operations with side effects are currently not allowed
+//
+func (checker *Checker) inViewContext() bool {
+	scope := checker.currentPurityCheckScope()
+	return scope != nil && scope.enforcePurity
+}
+
+// reportImpureOperation reports the given operation with side effects,
+// if the checker is currently in a view context
+//
+func (checker *Checker) reportImpureOperation(operation string, positioned ast.HasPosition) {
+	if !checker.inViewContext() {
+		return
+	}
+
+	checker.report(
+		&PurityError{
+			Operation: operation,
+			Range:     ast.NewRangeFromPositioned(positioned),
+		},
+	)
+}
+
+// checkInvocationPurity reports an invocation of a function that is not a view function,
+// if the checker is currently in a view context
+//
+func (checker *Checker) checkInvocationPurity(functionType *FunctionType, invocation *ast.InvocationExpression) {
+	if functionType.Purity == FunctionPurityView {
+		return
+	}
+
+	checker.reportImpureOperation("cannot call non-view function", invocation)
+}
+
+// checkAssignmentTargetPurity reports an assignment to non-local state,
+// if the checker is currently in a view context.
+//
+// Assigning to a variable is only allowed if the variable was declared in the view context.
+// Assigning to a member or an index is only allowed if the accessed value
+// is stored in such a local variable, and is not a reference
+//
+func (checker *Checker) checkAssignmentTargetPurity(target ast.Expression) {
+	if !checker.inViewContext() {
+		return
+	}
+
+	if !checker.isLocalAssignmentTarget(target) {
+		checker.reportImpureOperation("cannot assign to non-local state", target)
+	}
+}
+
+func (checker *Checker) isLocalAssignmentTarget(target ast.Expression) bool {
+	switch target := target.(type) {
+	case *ast.IdentifierExpression:
+		variable := checker.valueActivations.Find(target.Identifier.Identifier)
+		if variable == nil {
+			// An error for the undeclared variable is reported elsewhere
+			return true
+		}
+
+		// The composite value `self` is declared "inside" the function,
+		// but its fields are state outside of the function
+
+		if variable.DeclarationKind == common.DeclarationKindSelf {
+			return false
+		}
+
+		scope := checker.currentPurityCheckScope()
+		return variable.ActivationDepth >= scope.valueActivationDepth
+
+	case *ast.MemberExpression:
+		return checker.isLocalAssignmentTargetContainer(target.Expression)
+
+	case *ast.IndexExpression:
+		return checker.isLocalAssignmentTargetContainer(target.TargetExpression)
+
+	default:
+		return false
+	}
+}
+
+// isLocalAssignmentTargetContainer returns true if the given expression,
+// which is accessed by a member or index assignment, is local.
+//
+// Values accessed through references might be non-local,
+// even if the reference itself is stored in a local variable
+//
+func (checker *Checker) isLocalAssignmentTargetContainer(expression ast.Expression) bool {
+	containerType := checker.assignmentTargetType(expression)
+	if containerType == nil {
+		return false
+	}
+
+	if _, ok := UnwrapOptionalType(containerType).(*ReferenceType); ok {
+		return false
+	}
+
+	return checker.isLocalAssignmentTarget(expression)
+}
+
+// assignmentTargetType returns the type of the given, already checked part of an assignment target,
+// or nil if it is unknown
+//
+func (checker *Checker) assignmentTargetType(expression ast.Expression) Type {
+	switch expression := expression.(type) {
+	case *ast.IdentifierExpression:
+		variable := checker.valueActivations.Find(expression.Identifier.Identifier)
+		if variable == nil {
+			return nil
+		}
+		return variable.Type
+
+	case *ast.MemberExpression:
+		member := checker.Elaboration.MemberExpressionMemberInfos[expression].Member
+		if member == nil {
+			return nil
+		}
+		return member.TypeAnnotation.Type
+
+	case *ast.IndexExpression:
+		targetType := checker.assignmentTargetType(expression.TargetExpression)
+		indexedType, ok := targetType.(ValueIndexableType)
+		if !ok {
+			return nil
+		}
+		return indexedType.ElementType(false)
+
+	default:
+		return nil
+	}
+}
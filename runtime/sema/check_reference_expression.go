@@ -120,5 +120,22 @@ func (checker *Checker) VisitReferenceExpression(referenceExpression *ast.Refere
 		return InvalidType
 	}
 
+	// Check that the created reference does not grant more entitlements
+	// than the reference through which the referenced value is accessed, if any,
+	// e.g. `&ref.vault as auth(E) &Vault` requires `ref` to grant `E`
+
+	baseReferenceType := checker.accessingReferenceType(referencedType, referencedExpression)
+	if baseReferenceType != nil && !baseReferenceType.GrantsAuthorization(referenceType) {
+		checker.report(
+			&InvalidReferenceEntitlementsError{
+				ReferenceType:     referenceType,
+				BaseReferenceType: baseReferenceType,
+				Range:             ast.NewRangeFromPositioned(referenceExpression.Type),
+			},
+		)
+	}
+
+	checker.Elaboration.ReferenceExpressionReferenceTypes[referenceExpression] = referenceType
+
 	return referenceType
 }
@@ -0,0 +1,56 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import "github.com/onflow/cadence/runtime/ast"
+
+func (checker *Checker) VisitStringTemplateExpression(expression *ast.StringTemplateExpression) ast.Repr {
+
+	for _, interpolatedExpression := range expression.Expressions {
+		interpolatedType := interpolatedExpression.Accept(checker).(Type)
+
+		if interpolatedType.IsInvalidType() ||
+			IsValidStringTemplateExpressionType(interpolatedType) {
+
+			continue
+		}
+
+		checker.report(
+			&InvalidStringTemplateExpressionError{
+				Type:  interpolatedType,
+				Range: ast.NewRangeFromPositioned(interpolatedExpression),
+			},
+		)
+	}
+
+	return StringType
+}
+
+// IsValidStringTemplateExpressionType returns true if values of the given type
+// can be interpolated in a string template, i.e. if the type is a number,
+// address, string, character, boolean, or path type
+//
+func IsValidStringTemplateExpressionType(ty Type) bool {
+	return IsSubType(ty, &NumberType{}) ||
+		IsSubType(ty, &AddressType{}) ||
+		IsSubType(ty, StringType) ||
+		IsSubType(ty, CharacterType) ||
+		IsSubType(ty, BoolType) ||
+		IsSubType(ty, PathType)
+}
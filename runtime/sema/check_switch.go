@@ -20,6 +20,7 @@ package sema
 
 import (
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
 )
 
 func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast.Repr {
@@ -49,6 +50,10 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast
 		checker.visitSwitchCase(switchCase, defaultAllowed, testType, testTypeIsValid)
 	}
 
+	if testTypeIsValid {
+		checker.checkEnumSwitchCases(statement, testType)
+	}
+
 	checker.functionActivations.WithSwitch(func() {
 		checker.checkSwitchCasesStatements(statement.Cases)
 	})
@@ -56,6 +61,112 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast
 	return nil
 }
 
+// checkEnumSwitchCases checks the cases of a switch statement over an enum value.
+//
+// Cases which test for an enum case that is already tested by a previous case
+// are unreachable and are reported as a hint.
+//
+// If the switch statement has no default case and does not test for all enum cases,
+// the missing enum cases are recorded in the elaboration and reported as a hint.
+//
+func (checker *Checker) checkEnumSwitchCases(statement *ast.SwitchStatement, testType Type) {
+
+	enumType, ok := testType.(*CompositeType)
+	if !ok || enumType.Kind != common.CompositeKindEnum {
+		return
+	}
+
+	handledCases := map[string]ast.Range{}
+	hasDefault := false
+
+	for _, switchCase := range statement.Cases {
+		caseExpression := switchCase.Expression
+		if caseExpression == nil {
+			hasDefault = true
+			continue
+		}
+
+		caseName, ok := checker.enumCaseName(caseExpression, enumType)
+		if !ok {
+			continue
+		}
+
+		caseRange := ast.NewRangeFromPositioned(caseExpression)
+
+		if previousRange, ok := handledCases[caseName]; ok {
+			checker.hint(
+				&DuplicateEnumSwitchCaseHint{
+					Type:          enumType,
+					Case:          caseName,
+					PreviousRange: previousRange,
+					Range:         caseRange,
+				},
+			)
+			continue
+		}
+
+		handledCases[caseName] = caseRange
+	}
+
+	if hasDefault {
+		return
+	}
+
+	var missingCases []string
+	for _, caseName := range enumType.EnumCases {
+		if _, ok := handledCases[caseName]; !ok {
+			missingCases = append(missingCases, caseName)
+		}
+	}
+
+	if len(missingCases) == 0 {
+		return
+	}
+
+	checker.Elaboration.SwitchStatementMissingEnumCases[statement] = missingCases
+
+	checker.hint(
+		&MissingEnumCasesHint{
+			Type:         enumType,
+			MissingCases: missingCases,
+			Range:        ast.NewRangeFromPositioned(statement.Expression),
+		},
+	)
+}
+
+// enumCaseName returns the name of the enum case of the given enum type
+// which the given expression accesses, e.g. `a` for `E.a`.
+//
+// The expression must be a member access on the enum's constructor,
+// which has already been checked.
+//
+func (checker *Checker) enumCaseName(expression ast.Expression, enumType *CompositeType) (string, bool) {
+
+	memberExpression, ok := expression.(*ast.MemberExpression)
+	if !ok {
+		return "", false
+	}
+
+	memberInfo, ok := checker.Elaboration.MemberExpressionMemberInfos[memberExpression]
+	if !ok || memberInfo.IsOptional {
+		return "", false
+	}
+
+	if _, ok := memberInfo.AccessedType.(*SpecialFunctionType); !ok {
+		return "", false
+	}
+
+	member := memberInfo.Member
+	if member == nil ||
+		member.DeclarationKind != common.DeclarationKindField ||
+		!member.TypeAnnotation.Type.Equal(enumType) {
+
+		return "", false
+	}
+
+	return member.Identifier.Identifier, true
+}
+
 func (checker *Checker) visitSwitchCase(
 	switchCase *ast.SwitchCase,
 	defaultAllowed bool,
@@ -0,0 +1,184 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// typeAliasResolution is the state of the declaration
+// of the type aliases of a scope
+//
+type typeAliasResolution struct {
+	// pending are the type alias declarations of the scope, by name
+	pending map[string]*ast.TypeAliasDeclaration
+	// declared are the type alias declarations which are already declared
+	declared map[*ast.TypeAliasDeclaration]bool
+	// resolving are the names of the type aliases whose aliased types are currently being resolved
+	resolving map[string]bool
+}
+
+// VisitTypeAliasDeclaration checks the type alias declaration.
+//
+// NOTE: The aliased type was already resolved and the alias was already declared
+// in `declareTypeAliases`
+//
+func (checker *Checker) VisitTypeAliasDeclaration(declaration *ast.TypeAliasDeclaration) ast.Repr {
+	checker.checkDeclarationAccessModifier(
+		declaration.Access,
+		declaration.DeclarationKind(),
+		declaration.StartPos,
+		true,
+	)
+
+	return nil
+}
+
+// declareTypeAliases resolves the aliased types of the given type alias declarations
+// and declares the aliases in the current type activation.
+//
+// Type aliases are transparent: An alias is declared as the aliased type itself,
+// so using the alias is indistinguishable from using the aliased type,
+// e.g. in type IDs and in the storage encoding.
+//
+// The type aliases of a scope may refer to each other regardless of the order
+// in which they are declared, but an alias may not refer to itself, directly or indirectly.
+//
+// The aliased types are only resolved once and recorded in the elaboration.
+// When the aliases of a scope are declared again, e.g. when checking a composite
+// after its members were declared, the recorded types are used.
+//
+func (checker *Checker) declareTypeAliases(declarations []*ast.TypeAliasDeclaration) {
+	if len(declarations) == 0 {
+		return
+	}
+
+	resolution := &typeAliasResolution{
+		pending:   make(map[string]*ast.TypeAliasDeclaration, len(declarations)),
+		declared:  make(map[*ast.TypeAliasDeclaration]bool, len(declarations)),
+		resolving: map[string]bool{},
+	}
+
+	for _, declaration := range declarations {
+		name := declaration.Identifier.Identifier
+
+		// NOTE: Only the first declaration of a name is resolved on demand.
+		// Redeclarations are reported when they are declared below
+
+		if _, ok := resolution.pending[name]; ok {
+			continue
+		}
+
+		resolution.pending[name] = declaration
+	}
+
+	previousTypeAliases := checker.typeAliases
+	checker.typeAliases = resolution
+	defer func() {
+		checker.typeAliases = previousTypeAliases
+	}()
+
+	for _, declaration := range declarations {
+
+		// The type alias might have already been declared
+		// while resolving the aliased type of another type alias
+
+		if resolution.declared[declaration] {
+			continue
+		}
+
+		checker.declareTypeAlias(declaration)
+	}
+}
+
+func (checker *Checker) declareTypeAlias(declaration *ast.TypeAliasDeclaration) {
+
+	resolution := checker.typeAliases
+	resolution.declared[declaration] = true
+
+	identifier := declaration.Identifier
+
+	aliasedType, resolved := checker.Elaboration.TypeAliasDeclarationTypes[declaration]
+	if !resolved {
+		resolution.resolving[identifier.Identifier] = true
+		aliasedType = checker.ConvertType(declaration.AliasedType)
+		delete(resolution.resolving, identifier.Identifier)
+
+		checker.Elaboration.TypeAliasDeclarationTypes[declaration] = aliasedType
+	}
+
+	variable, err := checker.typeActivations.DeclareType(typeDeclaration{
+		identifier:               identifier,
+		ty:                       aliasedType,
+		declarationKind:          declaration.DeclarationKind(),
+		access:                   declaration.Access,
+		allowOuterScopeShadowing: false,
+	})
+
+	// Only report errors and record the occurrence
+	// when the type alias is declared for the first time
+
+	if resolved {
+		return
+	}
+
+	checker.report(err)
+
+	if checker.originsAndOccurrencesEnabled {
+		checker.recordVariableDeclarationOccurrence(
+			identifier.Identifier,
+			variable,
+		)
+	}
+}
+
+// resolvePendingTypeAlias declares the type alias with the given name
+// if the type aliases of the current scope are being declared
+// and the type alias was not declared yet, i.e. it is used before it is declared.
+//
+// It returns false if the aliased type of the type alias is currently being resolved,
+// i.e. the type alias refers to itself.
+//
+func (checker *Checker) resolvePendingTypeAlias(identifier ast.Identifier) bool {
+	resolution := checker.typeAliases
+	if resolution == nil {
+		return true
+	}
+
+	name := identifier.Identifier
+
+	if resolution.resolving[name] {
+		checker.report(
+			&CyclicTypeAliasError{
+				Name:  name,
+				Range: ast.NewRangeFromPositioned(identifier),
+			},
+		)
+
+		return false
+	}
+
+	declaration, ok := resolution.pending[name]
+	if ok && !resolution.declared[declaration] {
+		checker.declareTypeAlias(declaration)
+	}
+
+	return true
+}
@@ -0,0 +1,170 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// typeParameters converts the type parameters of a generic declaration.
+//
+// A type parameter without a type bound is bound to `AnyStruct`.
+// The type bound determines statically if the values of a generic type are resources
+//
+func (checker *Checker) typeParameters(typeParameterList *ast.TypeParameterList) []*TypeParameter {
+	if typeParameterList == nil {
+		return nil
+	}
+
+	typeParameters := make([]*TypeParameter, len(typeParameterList.TypeParameters))
+
+	typeParameterPositions := map[string]ast.Position{}
+
+	for i, typeParameter := range typeParameterList.TypeParameters {
+		identifier := typeParameter.Identifier
+
+		if previousPos, ok := typeParameterPositions[identifier.Identifier]; ok {
+			checker.report(
+				&RedeclarationError{
+					Kind:        common.DeclarationKindTypeParameter,
+					Name:        identifier.Identifier,
+					Pos:         identifier.Pos,
+					PreviousPos: &previousPos,
+				},
+			)
+		}
+
+		typeParameterPositions[identifier.Identifier] = identifier.Pos
+
+		typeParameters[i] = &TypeParameter{
+			Name:      identifier.Identifier,
+			TypeBound: checker.typeParameterTypeBound(typeParameter),
+		}
+	}
+
+	return typeParameters
+}
+
+func (checker *Checker) typeParameterTypeBound(typeParameter *ast.TypeParameter) Type {
+	if typeParameter.TypeBound == nil {
+		return AnyStructType
+	}
+
+	typeBoundAnnotation := checker.ConvertTypeAnnotation(typeParameter.TypeBound)
+	checker.checkTypeAnnotation(typeBoundAnnotation, typeParameter.TypeBound)
+
+	return typeBoundAnnotation.Type
+}
+
+// declareTypeParameters declares a generic type for each of the given type parameters
+// in the current type scope.
+//
+// NOTE: duplicate type parameters are reported when the type parameters
+// are converted, see `typeParameters`
+//
+func (checker *Checker) declareTypeParameters(
+	typeParameterList *ast.TypeParameterList,
+	typeParameters []*TypeParameter,
+) {
+	if typeParameterList == nil {
+		return
+	}
+
+	checker.declaresTypeParameters = true
+
+	for i, typeParameter := range typeParameters {
+		_, _ = checker.typeActivations.DeclareType(typeDeclaration{
+			identifier: typeParameterList.TypeParameters[i].Identifier,
+			ty: &GenericType{
+				TypeParameter: typeParameter,
+			},
+			declarationKind:          common.DeclarationKindTypeParameter,
+			access:                   ast.AccessPublic,
+			allowOuterScopeShadowing: true,
+		})
+	}
+}
+
+// functionDeclarationType returns the type of the given function declaration.
+// The type parameters of a generic function are in scope
+// for the parameter types and the return type
+//
+func (checker *Checker) functionDeclarationType(declaration *ast.FunctionDeclaration) *FunctionType {
+
+	if declaration.TypeParameterList == nil {
+		return checker.functionType(declaration.Purity, declaration.ParameterList, declaration.ReturnTypeAnnotation)
+	}
+
+	typeParameters := checker.typeParameters(declaration.TypeParameterList)
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave()
+
+	checker.declareTypeParameters(declaration.TypeParameterList, typeParameters)
+
+	functionType := checker.functionType(declaration.Purity, declaration.ParameterList, declaration.ReturnTypeAnnotation)
+	functionType.TypeParameters = typeParameters
+
+	return functionType
+}
+
+// enclosingTypeParameterTypes returns the type parameters of the generic declarations
+// which enclose the current scope, excluding the given type parameters.
+//
+// Within a generic declaration, its type parameters are opaque types,
+// so they are bound to themselves: They must not be inferred
+// when checking an invocation, unlike the type parameters of the invoked function
+//
+func (checker *Checker) enclosingTypeParameterTypes(excluded []*TypeParameter) *TypeParameterTypeOrderedMap {
+	if !checker.declaresTypeParameters {
+		return nil
+	}
+
+	var result *TypeParameterTypeOrderedMap
+
+	_ = checker.typeActivations.Current().ForEach(func(_ string, variable *Variable) error {
+		if variable.DeclarationKind != common.DeclarationKindTypeParameter {
+			return nil
+		}
+
+		genericType, ok := variable.Type.(*GenericType)
+		if !ok {
+			return nil
+		}
+
+		typeParameter := genericType.TypeParameter
+
+		for _, excludedTypeParameter := range excluded {
+			if typeParameter == excludedTypeParameter {
+				return nil
+			}
+		}
+
+		if result == nil {
+			result = NewTypeParameterTypeOrderedMap()
+		}
+
+		result.Set(typeParameter, genericType)
+
+		return nil
+	})
+
+	return result
+}
@@ -55,6 +55,8 @@ func (checker *Checker) VisitUnaryExpression(expression *ast.UnaryExpression) as
 		return valueType
 
 	case ast.OperationMove:
+		checker.reportImpureOperation("cannot move resource", expression)
+
 		if !valueType.IsInvalidType() &&
 			!valueType.IsResourceType() {
 
@@ -44,8 +44,10 @@ func (checker *Checker) VisitWhileStatement(statement *ast.WhileStatement) ast.R
 	// That means that resource invalidations and
 	// returns are not definite, but only potential.
 
+	label := checker.declareLoopLabel(statement.Label)
+
 	_ = checker.checkPotentiallyUnevaluated(func() Type {
-		checker.functionActivations.WithLoop(func() {
+		checker.functionActivations.WithLoop(label, func() {
 			statement.Block.Accept(checker)
 		})
 
@@ -112,11 +114,58 @@ func (checker *Checker) reportResourceUsesInLoop(startPos, endPos ast.Position)
 	})
 }
 
+// declareLoopLabel checks the given optional label of a loop statement
+// and returns the name of the label, or the empty string if the loop has no label.
+//
+// The label must not be the label of an enclosing loop
+//
+func (checker *Checker) declareLoopLabel(label *ast.Identifier) string {
+	if label == nil {
+		return ""
+	}
+
+	if checker.functionActivations.Current().HasLoopLabel(label.Identifier) {
+		checker.report(
+			&RedeclaredLoopLabelError{
+				Label: label.Identifier,
+				Range: ast.NewRangeFromPositioned(label),
+			},
+		)
+	}
+
+	return label.Identifier
+}
+
+// checkControlStatementLabel checks that the given optional label of a control statement
+// is the label of an enclosing loop in the current function
+//
+func (checker *Checker) checkControlStatementLabel(
+	controlStatement common.ControlStatement,
+	label *ast.Identifier,
+) {
+	if label == nil {
+		return
+	}
+
+	if !checker.functionActivations.Current().HasLoopLabel(label.Identifier) {
+		checker.report(
+			&UndeclaredControlStatementLabelError{
+				ControlStatement: controlStatement,
+				Label:            label.Identifier,
+				Range:            ast.NewRangeFromPositioned(label),
+			},
+		)
+	}
+}
+
 func (checker *Checker) VisitBreakStatement(statement *ast.BreakStatement) ast.Repr {
 
-	// Ensure that the `break` statement is inside a loop or switch statement
+	// Ensure that the `break` statement is inside a loop or switch statement.
+	// A labeled `break` statement must be inside a loop with the label
 
-	if !(checker.inLoop() || checker.inSwitch()) {
+	if statement.Label != nil {
+		checker.checkControlStatementLabel(common.ControlStatementBreak, statement.Label)
+	} else if !(checker.inLoop() || checker.inSwitch()) {
 		checker.report(
 			&ControlStatementError{
 				ControlStatement: common.ControlStatementBreak,
@@ -130,9 +179,12 @@ func (checker *Checker) VisitBreakStatement(statement *ast.BreakStatement) ast.R
 
 func (checker *Checker) VisitContinueStatement(statement *ast.ContinueStatement) ast.Repr {
 
-	// Ensure that the `continue` statement is inside a loop statement
+	// Ensure that the `continue` statement is inside a loop statement.
+	// A labeled `continue` statement must be inside a loop with the label
 
-	if !checker.inLoop() {
+	if statement.Label != nil {
+		checker.checkControlStatementLabel(common.ControlStatementContinue, statement.Label)
+	} else if !checker.inLoop() {
 		checker.report(
 			&ControlStatementError{
 				ControlStatement: common.ControlStatementContinue,
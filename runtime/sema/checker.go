@@ -49,6 +49,7 @@ var beforeType = func() *FunctionType {
 	)
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -89,9 +90,14 @@ type Checker struct {
 	valueActivations                   *VariableActivations
 	resources                          *Resources
 	typeActivations                    *VariableActivations
+	declaresTypeParameters             bool
+	typeAliases                        *typeAliasResolution
+	extensions                         *extensionActivations
 	containerTypes                     map[Type]bool
 	functionActivations                *FunctionActivations
 	inCondition                        bool
+	purityCheckScopes                  []purityCheckScope
+	viewConditionsEnabled              bool
 	originsAndOccurrencesEnabled       bool
 	Occurrences                        *Occurrences
 	variableOrigins                    map[*Variable]*Origin
@@ -197,6 +203,22 @@ func WithImportHandler(handler ImportHandlerFunc) Option {
 	}
 }
 
+// WithViewConditionsEnabled returns a checker option which enables/disables
+// if the conditions of functions are checked like the body of a view function,
+// i.e. if they may only call view functions and may not have other side effects.
+// View conditions are enabled by default.
+//
+// NOTE: This check is a breaking change: conditions which call functions
+// that are not view functions, e.g. `self.isValid()` or a composite constructor, are rejected.
+// Disabling it is only intended to allow existing programs to be migrated.
+//
+func WithViewConditionsEnabled(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.viewConditionsEnabled = enabled
+		return nil
+	}
+}
+
 // WithOriginsAndOccurrencesEnabled returns a checker option which enables/disables
 // if origins and occurrences are recorded.
 //
@@ -236,7 +258,10 @@ func NewChecker(program *ast.Program, location common.Location, options ...Optio
 		typeActivations:     typeActivations,
 		functionActivations: functionActivations,
 		containerTypes:      map[Type]bool{},
+		extensions:          newExtensionActivations(),
 		Elaboration:         NewElaboration(),
+		// View conditions are enabled by default
+		viewConditionsEnabled: true,
 	}
 
 	checker.beforeExtractor = NewBeforeExtractor(checker.report)
@@ -367,6 +392,34 @@ func (checker *Checker) hint(hint Hint) {
 	checker.hints = append(checker.hints, hint)
 }
 
+// checkCommonSuperTypeWidening reports a hint if the inferred common supertype
+// of the given types is `AnyStruct` or `AnyResource`, but none of the given types is,
+// i.e. if the inferred type loses the type information of all given types
+//
+func (checker *Checker) checkCommonSuperTypeWidening(superType Type, types []Type, hasPosition ast.HasPosition) {
+	isAnyType := func(ty Type) bool {
+		ty = UnwrapOptionalType(ty)
+		return ty == AnyStructType || ty == AnyResourceType
+	}
+
+	if !isAnyType(superType) {
+		return
+	}
+
+	for _, ty := range types {
+		if isAnyType(ty) {
+			return
+		}
+	}
+
+	checker.hint(
+		&TypeWideningHint{
+			Type:  superType,
+			Range: ast.NewRangeFromPositioned(hasPosition),
+		},
+	)
+}
+
 func (checker *Checker) UserDefinedValues() map[string]*Variable {
 	variables := map[string]*Variable{}
 
@@ -409,11 +462,21 @@ func (checker *Checker) VisitProgram(program *ast.Program) ast.Repr {
 			checker.Elaboration.InterfaceTypes[typedType.ID()] = typedType
 		case *CompositeType:
 			checker.Elaboration.CompositeTypes[typedType.ID()] = typedType
+		case *EntitlementType:
+			checker.Elaboration.EntitlementTypes[typedType.ID()] = typedType
 		default:
 			panic(errors.NewUnreachableError())
 		}
 	}
 
+	// Declare entitlements,
+	// *before* declaring interface and composite types, so their members may require them
+
+	for _, declaration := range program.EntitlementDeclarations() {
+		entitlementType := checker.declareEntitlementType(declaration, nil)
+		registerInElaboration(entitlementType)
+	}
+
 	for _, declaration := range program.InterfaceDeclarations() {
 		interfaceType := checker.declareInterfaceType(declaration)
 
@@ -432,6 +495,21 @@ func (checker *Checker) VisitProgram(program *ast.Program) ast.Repr {
 		VisitThisAndNested(compositeType, registerInElaboration)
 	}
 
+	// Declare type aliases,
+	// *after* declaring interface and composite types, so aliases may refer to them,
+	// and *before* declaring interface and composite members, so members may use aliases
+
+	checker.declareTypeAliases(program.TypeAliasDeclarations())
+
+	// Declare extension types,
+	// *after* declaring type aliases, so the base types may be aliases
+
+	extensionDeclarations := program.ExtensionDeclarations()
+
+	for _, declaration := range extensionDeclarations {
+		checker.declareExtensionType(declaration, nil)
+	}
+
 	// Declare interfaces' and composites' members
 
 	for _, declaration := range program.InterfaceDeclarations() {
@@ -442,6 +520,18 @@ func (checker *Checker) VisitProgram(program *ast.Program) ast.Repr {
 		checker.declareCompositeMembersAndValue(declaration, ContainerKindComposite)
 	}
 
+	// Declare extensions' members and bring the extensions into effect
+
+	for _, declaration := range extensionDeclarations {
+		checker.declareExtensionMembers(declaration)
+
+		extensionType := checker.Elaboration.ExtensionDeclarationTypes[declaration]
+		checker.activateExtension(
+			extensionType,
+			ast.NewRangeFromPositioned(declaration.Identifier),
+		)
+	}
+
 	// Declare events, functions, and transactions
 
 	for _, declaration := range program.FunctionDeclarations() {
@@ -517,12 +607,16 @@ func (checker *Checker) checkTopLevelDeclarationValidity(declarations []ast.Decl
 }
 
 func (checker *Checker) declareGlobalFunctionDeclaration(declaration *ast.FunctionDeclaration) {
-	functionType := checker.functionType(declaration.ParameterList, declaration.ReturnTypeAnnotation)
+	functionType := checker.functionDeclarationType(declaration)
 	checker.Elaboration.FunctionDeclarationFunctionTypes[declaration] = functionType
 	checker.declareFunctionDeclaration(declaration, functionType)
 }
 
 func (checker *Checker) checkTransfer(transfer *ast.Transfer, valueType Type) {
+	if transfer.Operation.IsMove() {
+		checker.reportImpureOperation("cannot move resource", transfer)
+	}
+
 	if valueType.IsResourceType() {
 		if !transfer.Operation.IsMove() {
 			checker.report(
@@ -1113,8 +1207,9 @@ func (checker *Checker) convertReferenceType(t *ast.ReferenceType) Type {
 	ty := checker.ConvertType(t.Type)
 
 	return &ReferenceType{
-		Authorized: t.Authorized,
-		Type:       ty,
+		Authorized:   t.Authorized,
+		Entitlements: checker.convertEntitlements(t.Entitlements),
+		Type:         ty,
 	}
 }
 
@@ -1163,6 +1258,7 @@ func (checker *Checker) convertFunctionType(t *ast.FunctionType) Type {
 	returnTypeAnnotation := checker.ConvertTypeAnnotation(t.ReturnTypeAnnotation)
 
 	return &FunctionType{
+		Purity:               checker.convertFunctionPurity(t.Purity),
 		Parameters:           parameters,
 		ReturnTypeAnnotation: returnTypeAnnotation,
 	}
@@ -1222,6 +1318,10 @@ func (checker *Checker) convertVariableSizedType(t *ast.VariableSizedType) Type
 }
 
 func (checker *Checker) findAndCheckTypeVariable(identifier ast.Identifier, recordOccurrence bool) *Variable {
+	if !checker.resolvePendingTypeAlias(identifier) {
+		return nil
+	}
+
 	variable := checker.typeActivations.Find(identifier.Identifier)
 	if variable == nil {
 		checker.report(
@@ -1247,6 +1347,40 @@ func (checker *Checker) findAndCheckTypeVariable(identifier ast.Identifier, reco
 }
 
 func (checker *Checker) convertNominalType(t *ast.NominalType) Type {
+	ty := checker.resolveNominalType(t)
+
+	switch ty.(type) {
+	case *ExtensionType:
+		// Extensions only add members to their base type,
+		// there are no values which have the extension type
+
+		checker.report(
+			&InvalidExtensionUsageError{
+				Name:  t.String(),
+				Range: ast.NewRangeFromPositioned(t),
+			},
+		)
+		return InvalidType
+
+	case *EntitlementType:
+		// Entitlements are only permissions,
+		// there are no values which have the entitlement type
+
+		checker.report(
+			&InvalidEntitlementUsageError{
+				Name:  t.String(),
+				Range: ast.NewRangeFromPositioned(t),
+			},
+		)
+		return InvalidType
+	}
+
+	return ty
+}
+
+// resolveNominalType resolves the type with the given (possibly qualified) name
+//
+func (checker *Checker) resolveNominalType(t *ast.NominalType) Type {
 	variable := checker.findAndCheckTypeVariable(t.Identifier, true)
 	if variable == nil {
 		return InvalidType
@@ -1308,7 +1442,17 @@ func (checker *Checker) ConvertTypeAnnotation(typeAnnotation *ast.TypeAnnotation
 	}
 }
 
+// convertFunctionPurity converts the given AST function purity into a sema function purity.
+//
+func (checker *Checker) convertFunctionPurity(purity ast.FunctionPurity) FunctionPurity {
+	if purity == ast.FunctionPurityView {
+		return FunctionPurityView
+	}
+	return FunctionPurityImpure
+}
+
 func (checker *Checker) functionType(
+	purity ast.FunctionPurity,
 	parameterList *ast.ParameterList,
 	returnTypeAnnotation *ast.TypeAnnotation,
 ) *FunctionType {
@@ -1318,6 +1462,7 @@ func (checker *Checker) functionType(
 		checker.ConvertTypeAnnotation(returnTypeAnnotation)
 
 	return &FunctionType{
+		Purity:               checker.convertFunctionPurity(purity),
 		Parameters:           convertedParameters,
 		ReturnTypeAnnotation: convertedReturnTypeAnnotation,
 	}
@@ -1886,13 +2031,15 @@ func (checker *Checker) isReadableAccess(access ast.Access) bool {
 		AccessCheckModeNotSpecifiedRestricted:
 
 		return access == ast.AccessPublic ||
-			access == ast.AccessPublicSettable
+			access == ast.AccessPublicSettable ||
+			access == ast.AccessEntitlements
 
 	case AccessCheckModeNotSpecifiedUnrestricted:
 
 		return access == ast.AccessNotSpecified ||
 			access == ast.AccessPublic ||
-			access == ast.AccessPublicSettable
+			access == ast.AccessPublicSettable ||
+			access == ast.AccessEntitlements
 
 	case AccessCheckModeNone:
 		return true
@@ -2228,7 +2375,7 @@ func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type
 	}
 
 	parameterizedType, ok := ty.(ParameterizedType)
-	if !ok {
+	if !ok || len(parameterizedType.TypeParameters()) == 0 {
 
 		// The type is not parameterized,
 		// report an error for all type arguments
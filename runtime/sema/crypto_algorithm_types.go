@@ -29,6 +29,7 @@ import (
 var SignatureAlgorithms = []CryptoAlgorithm{
 	SignatureAlgorithmECDSA_P256,
 	SignatureAlgorithmECDSA_Secp256k1,
+	SignatureAlgorithmBLS_BLS12_381,
 }
 
 var HashAlgorithms = []CryptoAlgorithm{
@@ -36,9 +37,10 @@ var HashAlgorithms = []CryptoAlgorithm{
 	HashAlgorithmSHA2_384,
 	HashAlgorithmSHA3_256,
 	HashAlgorithmSHA3_384,
+	HashAlgorithmKECCAK_256,
 }
 
-var SignatureAlgorithmType = newNativeEnumType(SignatureAlgorithmTypeName, &UInt8Type{})
+var SignatureAlgorithmType = newNativeEnumType(SignatureAlgorithmTypeName, &UInt8Type{}, SignatureAlgorithms)
 
 type SignatureAlgorithm uint8
 
@@ -47,6 +49,7 @@ const (
 	SignatureAlgorithmUnknown SignatureAlgorithm = iota
 	SignatureAlgorithmECDSA_P256
 	SignatureAlgorithmECDSA_Secp256k1
+	SignatureAlgorithmBLS_BLS12_381
 )
 
 // Name returns the string representation of this signing algorithm.
@@ -58,6 +61,8 @@ func (algo SignatureAlgorithm) Name() string {
 		return "ECDSA_P256"
 	case SignatureAlgorithmECDSA_Secp256k1:
 		return "ECDSA_Secp256k1"
+	case SignatureAlgorithmBLS_BLS12_381:
+		return "BLS_BLS12_381"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -76,6 +81,8 @@ func (algo SignatureAlgorithm) RawValue() uint8 {
 		return 1
 	case SignatureAlgorithmECDSA_Secp256k1:
 		return 2
+	case SignatureAlgorithmBLS_BLS12_381:
+		return 3
 	}
 
 	panic(errors.NewUnreachableError())
@@ -89,12 +96,63 @@ func (algo SignatureAlgorithm) DocString() string {
 		return SignatureAlgorithmDocStringECDSA_P256
 	case SignatureAlgorithmECDSA_Secp256k1:
 		return SignatureAlgorithmDocStringECDSA_Secp256k1
+	case SignatureAlgorithmBLS_BLS12_381:
+		return SignatureAlgorithmDocStringBLS_BLS12_381
 	}
 
 	panic(errors.NewUnreachableError())
 }
 
-var HashAlgorithmType = newNativeEnumType(HashAlgorithmTypeName, &UInt8Type{})
+var HashAlgorithmType = func() *CompositeType {
+	hashAlgorithmType := newNativeEnumType(HashAlgorithmTypeName, &UInt8Type{}, HashAlgorithms)
+
+	hashAlgorithmType.Members.Set(
+		HashAlgorithmTypeHashWithTagFunctionName,
+		NewPublicFunctionMember(
+			hashAlgorithmType,
+			HashAlgorithmTypeHashWithTagFunctionName,
+			hashAlgorithmHashWithTagFunctionType,
+			hashAlgorithmHashWithTagFunctionDocString,
+		),
+	)
+
+	return hashAlgorithmType
+}()
+
+const HashAlgorithmTypeHashWithTagFunctionName = "hashWithTag"
+
+var hashAlgorithmHashWithTagFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "data",
+			TypeAnnotation: NewTypeAnnotation(
+				&VariableSizedType{
+					Type: &UInt8Type{},
+				},
+			),
+		},
+		{
+			Identifier:     "tag",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&VariableSizedType{
+			Type: &UInt8Type{},
+		},
+	),
+}
+
+const hashAlgorithmHashWithTagFunctionDocString = `
+Returns the hash of the given data, domain-separated by the given tag.
+
+A non-empty tag is right-padded with zero bytes to 32 bytes and prefixed to the data.
+Tags longer than 32 bytes are rejected. An empty tag hashes the data only.
+
+The tagged hash is the hash that signatures with the given tag are verified against
+`
 
 type HashAlgorithm uint8
 
@@ -105,6 +163,7 @@ const (
 	HashAlgorithmSHA2_384
 	HashAlgorithmSHA3_256
 	HashAlgorithmSHA3_384
+	HashAlgorithmKECCAK_256
 )
 
 func (algo HashAlgorithm) Name() string {
@@ -119,6 +178,8 @@ func (algo HashAlgorithm) Name() string {
 		return "SHA3_256"
 	case HashAlgorithmSHA3_384:
 		return "SHA3_384"
+	case HashAlgorithmKECCAK_256:
+		return "KECCAK_256"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -141,6 +202,8 @@ func (algo HashAlgorithm) RawValue() uint8 {
 		return 3
 	case HashAlgorithmSHA3_384:
 		return 4
+	case HashAlgorithmKECCAK_256:
+		return 5
 	}
 
 	panic(errors.NewUnreachableError())
@@ -158,18 +221,24 @@ func (algo HashAlgorithm) DocString() string {
 		return HashAlgorithmDocStringSHA3_256
 	case HashAlgorithmSHA3_384:
 		return HashAlgorithmDocStringSHA3_384
+	case HashAlgorithmKECCAK_256:
+		return HashAlgorithmDocStringKECCAK_256
 	}
 
 	panic(errors.NewUnreachableError())
 }
 
-func newNativeEnumType(identifier string, rawType Type) *CompositeType {
+func newNativeEnumType(identifier string, rawType Type, cases []CryptoAlgorithm) *CompositeType {
 	accountKeyType := &CompositeType{
 		Identifier:  identifier,
 		EnumRawType: rawType,
 		Kind:        common.CompositeKindEnum,
 	}
 
+	for _, algo := range cases {
+		accountKeyType.EnumCases = append(accountKeyType.EnumCases, algo.Name())
+	}
+
 	// Members of the enum type are *not* the enum cases!
 	// Each individual enum case is an instance of the enum type,
 	// so only has a single member, the raw value field
@@ -196,6 +265,10 @@ const SignatureAlgorithmDocStringECDSA_Secp256k1 = `
 ECDSA_Secp256k1 is Elliptic Curve Digital Signature Algorithm (ECDSA) on the secp256k1 curve
 `
 
+const SignatureAlgorithmDocStringBLS_BLS12_381 = `
+BLS_BLS12_381 is Boneh-Lynn-Shacham (BLS) signature scheme on the BLS12-381 curve
+`
+
 const HashAlgorithmTypeName = "HashAlgorithm"
 
 const HashAlgorithmDocStringSHA2_256 = `
@@ -213,3 +286,7 @@ SHA3_256 is Secure Hashing Algorithm 3 (SHA-3) with a 256-bit digest
 const HashAlgorithmDocStringSHA3_384 = `
 SHA3_384 is Secure Hashing Algorithm 3 (SHA-3) with a 384-bit digest
 `
+
+const HashAlgorithmDocStringKECCAK_256 = `
+KECCAK_256 is the legacy Keccak algorithm with a 256-bit digest, as used by Ethereum
+`
@@ -51,6 +51,9 @@ type Elaboration struct {
 	ReturnStatementReturnTypes             map[*ast.ReturnStatement]Type
 	BinaryExpressionResultTypes            map[*ast.BinaryExpression]Type
 	BinaryExpressionRightTypes             map[*ast.BinaryExpression]Type
+	ConditionalExpressionThenTypes         map[*ast.ConditionalExpression]Type
+	ConditionalExpressionElseTypes         map[*ast.ConditionalExpression]Type
+	ConditionalExpressionResultTypes       map[*ast.ConditionalExpression]Type
 	MemberExpressionMemberInfos            map[*ast.MemberExpression]MemberInfo
 	ArrayExpressionArgumentTypes           map[*ast.ArrayExpression][]Type
 	ArrayExpressionElementType             map[*ast.ArrayExpression]Type
@@ -60,13 +63,20 @@ type Elaboration struct {
 	SwapStatementLeftTypes                 map[*ast.SwapStatement]Type
 	SwapStatementRightTypes                map[*ast.SwapStatement]Type
 	IsResourceMovingStorageIndexExpression map[*ast.IndexExpression]bool
+	IndexExpressionTargetTypes             map[*ast.IndexExpression]Type
 	CompositeNestedDeclarations            map[*ast.CompositeDeclaration]map[string]ast.Declaration
 	InterfaceNestedDeclarations            map[*ast.InterfaceDeclaration]map[string]ast.Declaration
 	PostConditionsRewrite                  map[*ast.Conditions]PostConditionsRewrite
 	EmitStatementEventTypes                map[*ast.EmitStatement]*CompositeType
+	TypeAliasDeclarationTypes              map[*ast.TypeAliasDeclaration]Type
+	ExtensionDeclarationTypes              map[*ast.ExtensionDeclaration]*ExtensionType
+	EntitlementDeclarationTypes            map[*ast.EntitlementDeclaration]*EntitlementType
+	ReferenceExpressionReferenceTypes      map[*ast.ReferenceExpression]*ReferenceType
+	SwitchStatementMissingEnumCases        map[*ast.SwitchStatement][]string
 	// Keyed by qualified identifier
 	CompositeTypes                      map[TypeID]*CompositeType
 	InterfaceTypes                      map[TypeID]*InterfaceType
+	EntitlementTypes                    map[TypeID]*EntitlementType
 	InvocationExpressionTypeArguments   map[*ast.InvocationExpression]*TypeParameterTypeOrderedMap
 	IdentifierInInvocationTypes         map[*ast.IdentifierExpression]Type
 	ImportDeclarationsResolvedLocations map[*ast.ImportDeclaration][]ResolvedLocation
@@ -100,6 +110,9 @@ func NewElaboration() *Elaboration {
 		ReturnStatementReturnTypes:             map[*ast.ReturnStatement]Type{},
 		BinaryExpressionResultTypes:            map[*ast.BinaryExpression]Type{},
 		BinaryExpressionRightTypes:             map[*ast.BinaryExpression]Type{},
+		ConditionalExpressionThenTypes:         map[*ast.ConditionalExpression]Type{},
+		ConditionalExpressionElseTypes:         map[*ast.ConditionalExpression]Type{},
+		ConditionalExpressionResultTypes:       map[*ast.ConditionalExpression]Type{},
 		MemberExpressionMemberInfos:            map[*ast.MemberExpression]MemberInfo{},
 		ArrayExpressionArgumentTypes:           map[*ast.ArrayExpression][]Type{},
 		ArrayExpressionElementType:             map[*ast.ArrayExpression]Type{},
@@ -109,12 +122,19 @@ func NewElaboration() *Elaboration {
 		SwapStatementLeftTypes:                 map[*ast.SwapStatement]Type{},
 		SwapStatementRightTypes:                map[*ast.SwapStatement]Type{},
 		IsResourceMovingStorageIndexExpression: map[*ast.IndexExpression]bool{},
+		IndexExpressionTargetTypes:             map[*ast.IndexExpression]Type{},
 		CompositeNestedDeclarations:            map[*ast.CompositeDeclaration]map[string]ast.Declaration{},
 		InterfaceNestedDeclarations:            map[*ast.InterfaceDeclaration]map[string]ast.Declaration{},
 		PostConditionsRewrite:                  map[*ast.Conditions]PostConditionsRewrite{},
 		EmitStatementEventTypes:                map[*ast.EmitStatement]*CompositeType{},
+		TypeAliasDeclarationTypes:              map[*ast.TypeAliasDeclaration]Type{},
+		ExtensionDeclarationTypes:              map[*ast.ExtensionDeclaration]*ExtensionType{},
+		EntitlementDeclarationTypes:            map[*ast.EntitlementDeclaration]*EntitlementType{},
+		ReferenceExpressionReferenceTypes:      map[*ast.ReferenceExpression]*ReferenceType{},
+		SwitchStatementMissingEnumCases:        map[*ast.SwitchStatement][]string{},
 		CompositeTypes:                         map[TypeID]*CompositeType{},
 		InterfaceTypes:                         map[TypeID]*InterfaceType{},
+		EntitlementTypes:                       map[TypeID]*EntitlementType{},
 		InvocationExpressionTypeArguments:      map[*ast.InvocationExpression]*TypeParameterTypeOrderedMap{},
 		IdentifierInInvocationTypes:            map[*ast.IdentifierExpression]Type{},
 		ImportDeclarationsResolvedLocations:    map[*ast.ImportDeclaration][]ResolvedLocation{},
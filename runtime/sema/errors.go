@@ -431,6 +431,26 @@ func (e *InvalidUnaryOperandError) SecondaryError() string {
 
 func (*InvalidUnaryOperandError) isSemanticError() {}
 
+// InvalidStringTemplateExpressionError
+
+type InvalidStringTemplateExpressionError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidStringTemplateExpressionError) Error() string {
+	return fmt.Sprintf(
+		"cannot interpolate value of type `%s` in string template",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidStringTemplateExpressionError) SecondaryError() string {
+	return "expected number, address, string, character, boolean, or path"
+}
+
+func (*InvalidStringTemplateExpressionError) isSemanticError() {}
+
 // InvalidBinaryOperandError
 
 type InvalidBinaryOperandError struct {
@@ -505,6 +525,41 @@ func (e *ControlStatementError) Error() string {
 
 func (*ControlStatementError) isSemanticError() {}
 
+// UndeclaredControlStatementLabelError
+
+type UndeclaredControlStatementLabelError struct {
+	ControlStatement common.ControlStatement
+	Label            string
+	ast.Range
+}
+
+func (e *UndeclaredControlStatementLabelError) Error() string {
+	return fmt.Sprintf(
+		"invalid control statement: `%s %s`: no enclosing loop has label `%s`",
+		e.ControlStatement.Symbol(),
+		e.Label,
+		e.Label,
+	)
+}
+
+func (*UndeclaredControlStatementLabelError) isSemanticError() {}
+
+// RedeclaredLoopLabelError
+
+type RedeclaredLoopLabelError struct {
+	Label string
+	ast.Range
+}
+
+func (e *RedeclaredLoopLabelError) Error() string {
+	return fmt.Sprintf(
+		"cannot redeclare loop label: `%s` is already the label of an enclosing loop",
+		e.Label,
+	)
+}
+
+func (*RedeclaredLoopLabelError) isSemanticError() {}
+
 // InvalidAccessModifierError
 
 type InvalidAccessModifierError struct {
@@ -809,6 +864,23 @@ func (e *FunctionExpressionInConditionError) Error() string {
 
 func (*FunctionExpressionInConditionError) isSemanticError() {}
 
+// PurityError is reported when an operation with side effects
+// is performed in a view context, e.g. in a view function or in a condition
+
+type PurityError struct {
+	Operation string
+	ast.Range
+}
+
+func (e *PurityError) Error() string {
+	return fmt.Sprintf(
+		"impure operation in view context: %s",
+		e.Operation,
+	)
+}
+
+func (*PurityError) isSemanticError() {}
+
 // InvalidReturnValueError
 
 type InvalidReturnValueError struct {
@@ -2175,14 +2247,17 @@ type InvalidAccessError struct {
 	Name              string
 	RestrictingAccess ast.Access
 	DeclarationKind   common.DeclarationKind
+	// Extension is the extension which declares the member, if any
+	Extension *ExtensionType
 	ast.Range
 }
 
 func (e *InvalidAccessError) Error() string {
 	return fmt.Sprintf(
-		"cannot access `%s`: %s has %s access",
+		"cannot access `%s`: %s%s has %s access",
 		e.Name,
 		e.DeclarationKind.Name(),
+		extensionDescription(e.Extension),
 		e.RestrictingAccess.Description(),
 	)
 }
@@ -2195,14 +2270,17 @@ type InvalidAssignmentAccessError struct {
 	Name              string
 	RestrictingAccess ast.Access
 	DeclarationKind   common.DeclarationKind
+	// Extension is the extension which declares the member, if any
+	Extension *ExtensionType
 	ast.Range
 }
 
 func (e *InvalidAssignmentAccessError) Error() string {
 	return fmt.Sprintf(
-		"cannot assign to `%s`: %s has %s access",
+		"cannot assign to `%s`: %s%s has %s access",
 		e.Name,
 		e.DeclarationKind.Name(),
+		extensionDescription(e.Extension),
 		e.RestrictingAccess.Description(),
 	)
 }
@@ -2792,6 +2870,36 @@ func (e *TypeParameterTypeInferenceError) Error() string {
 
 func (e *TypeParameterTypeInferenceError) isSemanticError() {}
 
+// UnsupportedTypeParametersError is reported for declarations
+// which have type parameters, but cannot be generic
+//
+type UnsupportedTypeParametersError struct {
+	DeclarationKind          common.DeclarationKind
+	ContainerDeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *UnsupportedTypeParametersError) Error() string {
+	if e.ContainerDeclarationKind != common.DeclarationKindUnknown {
+		return fmt.Sprintf(
+			"%s declarations in %s declarations cannot have type parameters",
+			e.DeclarationKind.Name(),
+			e.ContainerDeclarationKind.Name(),
+		)
+	}
+
+	return fmt.Sprintf(
+		"%s declarations cannot have type parameters",
+		e.DeclarationKind.Name(),
+	)
+}
+
+func (e *UnsupportedTypeParametersError) SecondaryError() string {
+	return "only functions and structures can be generic"
+}
+
+func (*UnsupportedTypeParametersError) isSemanticError() {}
+
 // InvalidConstantSizedTypeBaseError
 
 type InvalidConstantSizedTypeBaseError struct {
@@ -2934,6 +3042,218 @@ func (e *CyclicImportsError) Error() string {
 
 func (*CyclicImportsError) isSemanticError() {}
 
+// CyclicTypeAliasError
+
+type CyclicTypeAliasError struct {
+	Name string
+	ast.Range
+}
+
+func (e *CyclicTypeAliasError) Error() string {
+	return fmt.Sprintf("cyclic type alias `%s`", e.Name)
+}
+
+func (e *CyclicTypeAliasError) SecondaryError() string {
+	return "the aliased type refers to the alias itself"
+}
+
+func (*CyclicTypeAliasError) isSemanticError() {}
+
+// extensionDescription returns a description of the given extension
+// for members declared by it, or an empty string if the extension is nil
+//
+func extensionDescription(extensionType *ExtensionType) string {
+	if extensionType == nil {
+		return ""
+	}
+	return fmt.Sprintf(" of extension `%s`", extensionType.QualifiedString())
+}
+
+// InvalidExtensionBaseTypeError
+
+type InvalidExtensionBaseTypeError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidExtensionBaseTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot extend type `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidExtensionBaseTypeError) SecondaryError() string {
+	return "only structures, resources, and their interfaces can be extended"
+}
+
+func (*InvalidExtensionBaseTypeError) isSemanticError() {}
+
+// InvalidExtensionMemberError
+
+type InvalidExtensionMemberError struct {
+	DeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *InvalidExtensionMemberError) Error() string {
+	return fmt.Sprintf(
+		"extensions cannot declare %s declarations",
+		e.DeclarationKind.Name(),
+	)
+}
+
+func (e *InvalidExtensionMemberError) SecondaryError() string {
+	return "extensions may only declare fields and functions"
+}
+
+func (*InvalidExtensionMemberError) isSemanticError() {}
+
+// InvalidExtensionFieldError
+
+type InvalidExtensionFieldError struct {
+	Name string
+	ast.Range
+}
+
+func (e *InvalidExtensionFieldError) Error() string {
+	return fmt.Sprintf("invalid extension field `%s`", e.Name)
+}
+
+func (e *InvalidExtensionFieldError) SecondaryError() string {
+	return "extension fields are `nil` until assigned, " +
+		"so they must be variable fields of a storable, non-resource optional type"
+}
+
+func (*InvalidExtensionFieldError) isSemanticError() {}
+
+// ExtensionMemberConflictError
+
+type ExtensionMemberConflictError struct {
+	Name          string
+	ExtensionType *ExtensionType
+	// ConflictingType is either the extended type,
+	// or another extension of it
+	ConflictingType Type
+	ast.Range
+}
+
+func (e *ExtensionMemberConflictError) Error() string {
+	conflictingKind := "type"
+	if _, ok := e.ConflictingType.(*ExtensionType); ok {
+		conflictingKind = "extension"
+	}
+
+	return fmt.Sprintf(
+		"member `%s` of extension `%s` conflicts with member of %s `%s`",
+		e.Name,
+		e.ExtensionType.QualifiedString(),
+		conflictingKind,
+		e.ConflictingType.QualifiedString(),
+	)
+}
+
+func (*ExtensionMemberConflictError) isSemanticError() {}
+
+// InvalidExtensionUsageError
+
+type InvalidExtensionUsageError struct {
+	Name string
+	ast.Range
+}
+
+func (e *InvalidExtensionUsageError) Error() string {
+	return fmt.Sprintf("cannot use extension `%s` as a type", e.Name)
+}
+
+func (e *InvalidExtensionUsageError) SecondaryError() string {
+	return "the members of the extension are available on values of the extended type"
+}
+
+func (*InvalidExtensionUsageError) isSemanticError() {}
+
+// InvalidEntitlementUsageError
+
+type InvalidEntitlementUsageError struct {
+	Name string
+	ast.Range
+}
+
+func (e *InvalidEntitlementUsageError) Error() string {
+	return fmt.Sprintf("cannot use entitlement `%s` as a type", e.Name)
+}
+
+func (e *InvalidEntitlementUsageError) SecondaryError() string {
+	return "entitlements may only be used in access modifiers and reference types"
+}
+
+func (*InvalidEntitlementUsageError) isSemanticError() {}
+
+// InvalidNonEntitlementTypeError
+
+type InvalidNonEntitlementTypeError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidNonEntitlementTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot use non-entitlement type `%s` as an entitlement",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*InvalidNonEntitlementTypeError) isSemanticError() {}
+
+// InvalidEntitlementAccessError
+
+type InvalidEntitlementAccessError struct {
+	Name                 string
+	RequiredEntitlements *EntitlementAccess
+	ReferenceType        *ReferenceType
+	ast.Range
+}
+
+func (e *InvalidEntitlementAccessError) Error() string {
+	return fmt.Sprintf(
+		"cannot access `%s`: reference `%s` does not grant the required entitlements",
+		e.Name,
+		e.ReferenceType.QualifiedString(),
+	)
+}
+
+func (e *InvalidEntitlementAccessError) SecondaryError() string {
+	entitlements := e.RequiredEntitlements
+	if entitlements.IsDisjunction && len(entitlements.Entitlements) > 1 {
+		return fmt.Sprintf("requires one of the entitlements `%s`", entitlements)
+	}
+	return fmt.Sprintf("requires the entitlements `%s`", entitlements)
+}
+
+func (*InvalidEntitlementAccessError) isSemanticError() {}
+
+// InvalidReferenceEntitlementsError
+
+type InvalidReferenceEntitlementsError struct {
+	ReferenceType     *ReferenceType
+	BaseReferenceType *ReferenceType
+	ast.Range
+}
+
+func (e *InvalidReferenceEntitlementsError) Error() string {
+	return fmt.Sprintf(
+		"cannot create reference `%s`: reference `%s` does not grant the entitlements",
+		e.ReferenceType.QualifiedString(),
+		e.BaseReferenceType.QualifiedString(),
+	)
+}
+
+func (e *InvalidReferenceEntitlementsError) SecondaryError() string {
+	return "a reference to a value accessed through a reference may not grant more entitlements"
+}
+
+func (*InvalidReferenceEntitlementsError) isSemanticError() {}
+
 // SwitchDefaultPositionError
 
 type SwitchDefaultPositionError struct {
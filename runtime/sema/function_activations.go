@@ -21,6 +21,7 @@ package sema
 type FunctionActivation struct {
 	ReturnType           Type
 	Loops                int
+	LoopLabels           []string
 	Switches             int
 	ValueActivationDepth int
 	ReturnInfo           *ReturnInfo
@@ -36,6 +37,17 @@ func (a FunctionActivation) InSwitch() bool {
 	return a.Switches > 0
 }
 
+// HasLoopLabel returns true if the given label is the label of an enclosing loop
+//
+func (a FunctionActivation) HasLoopLabel(label string) bool {
+	for _, loopLabel := range a.LoopLabels {
+		if loopLabel == label {
+			return true
+		}
+	}
+	return false
+}
+
 type FunctionActivations struct {
 	activations []*FunctionActivation
 }
@@ -80,10 +92,20 @@ func (a *FunctionActivations) Current() *FunctionActivation {
 	return a.activations[lastIndex]
 }
 
-func (a *FunctionActivations) WithLoop(f func()) {
-	a.Current().Loops++
+// WithLoop calls the given function in the context of a loop.
+// If the label is not empty, it is the label of the loop
+//
+func (a *FunctionActivations) WithLoop(label string, f func()) {
+	current := a.Current()
+	current.Loops++
+	if label != "" {
+		current.LoopLabels = append(current.LoopLabels, label)
+	}
 	defer func() {
-		a.Current().Loops--
+		current.Loops--
+		if label != "" {
+			current.LoopLabels = current.LoopLabels[:len(current.LoopLabels)-1]
+		}
 	}()
 	f()
 }
@@ -13,11 +13,12 @@ func _() {
 	_ = x[HashAlgorithmSHA2_384-2]
 	_ = x[HashAlgorithmSHA3_256-3]
 	_ = x[HashAlgorithmSHA3_384-4]
+	_ = x[HashAlgorithmKECCAK_256-5]
 }
 
-const _HashAlgorithm_name = "HashAlgorithmUnknownHashAlgorithmSHA2_256HashAlgorithmSHA2_384HashAlgorithmSHA3_256HashAlgorithmSHA3_384"
+const _HashAlgorithm_name = "HashAlgorithmUnknownHashAlgorithmSHA2_256HashAlgorithmSHA2_384HashAlgorithmSHA3_256HashAlgorithmSHA3_384HashAlgorithmKECCAK_256"
 
-var _HashAlgorithm_index = [...]uint8{0, 20, 41, 62, 83, 104}
+var _HashAlgorithm_index = [...]uint8{0, 20, 41, 62, 83, 104, 127}
 
 func (i HashAlgorithm) String() string {
 	if i >= HashAlgorithm(len(_HashAlgorithm_index)-1) {
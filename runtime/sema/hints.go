@@ -20,6 +20,7 @@ package sema
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/onflow/cadence/runtime/ast"
 )
@@ -100,3 +101,62 @@ func (h *AlwaysSucceedingForceCastHint) Hint() string {
 }
 
 func (*AlwaysSucceedingForceCastHint) isHint() {}
+
+// TypeWideningHint
+
+type TypeWideningHint struct {
+	Type Type
+	ast.Range
+}
+
+func (h *TypeWideningHint) Hint() string {
+	return fmt.Sprintf(
+		"inferred type widens to `%s`, consider adding a type annotation",
+		h.Type,
+	)
+}
+
+func (*TypeWideningHint) isHint() {}
+
+// MissingEnumCasesHint
+
+type MissingEnumCasesHint struct {
+	Type         *CompositeType
+	MissingCases []string
+	ast.Range
+}
+
+func (h *MissingEnumCasesHint) Hint() string {
+	cases := make([]string, len(h.MissingCases))
+	for i, missingCase := range h.MissingCases {
+		cases[i] = fmt.Sprintf("`%s.%s`", h.Type.QualifiedString(), missingCase)
+	}
+
+	return fmt.Sprintf(
+		"switch over `%s` is not exhaustive, missing cases: %s",
+		h.Type.QualifiedString(),
+		strings.Join(cases, ", "),
+	)
+}
+
+func (*MissingEnumCasesHint) isHint() {}
+
+// DuplicateEnumSwitchCaseHint
+
+type DuplicateEnumSwitchCaseHint struct {
+	Type          *CompositeType
+	Case          string
+	PreviousRange ast.Range
+	ast.Range
+}
+
+func (h *DuplicateEnumSwitchCaseHint) Hint() string {
+	return fmt.Sprintf(
+		"unreachable switch case: `%s.%s` is already handled by the case on line %d",
+		h.Type.QualifiedString(),
+		h.Case,
+		h.PreviousRange.StartPos.Line,
+	)
+}
+
+func (*DuplicateEnumSwitchCaseHint) isHint() {}
@@ -0,0 +1,340 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+const InclusiveRangeTypeName = "InclusiveRange"
+const InclusiveRangeTypeStartFieldName = "start"
+const InclusiveRangeTypeEndFieldName = "end"
+const InclusiveRangeTypeStepFieldName = "step"
+const InclusiveRangeTypeContainsFunctionName = "contains"
+
+// InclusiveRangeType is the type of ranges of integers,
+// which include both the start and the end value.
+//
+// The member type is the integer type of the values of the range.
+// If it is nil, the type is the unparameterized `InclusiveRange`,
+// which is the super-type of all range types
+//
+type InclusiveRangeType struct {
+	MemberType Type
+}
+
+func (*InclusiveRangeType) IsType() {}
+
+func (t *InclusiveRangeType) string(typeFormatter func(Type) string) string {
+	var builder strings.Builder
+	builder.WriteString(InclusiveRangeTypeName)
+	if t.MemberType != nil {
+		builder.WriteRune('<')
+		builder.WriteString(typeFormatter(t.MemberType))
+		builder.WriteRune('>')
+	}
+	return builder.String()
+}
+
+func (t *InclusiveRangeType) String() string {
+	return t.string(func(t Type) string {
+		return t.String()
+	})
+}
+
+func (t *InclusiveRangeType) QualifiedString() string {
+	return t.string(func(t Type) string {
+		return t.QualifiedString()
+	})
+}
+
+func (t *InclusiveRangeType) ID() TypeID {
+	return TypeID(t.string(func(t Type) string {
+		return string(t.ID())
+	}))
+}
+
+func (t *InclusiveRangeType) Equal(other Type) bool {
+	otherRange, ok := other.(*InclusiveRangeType)
+	if !ok {
+		return false
+	}
+	if otherRange.MemberType == nil {
+		return t.MemberType == nil
+	}
+	if t.MemberType == nil {
+		return false
+	}
+	return otherRange.MemberType.Equal(t.MemberType)
+}
+
+func (*InclusiveRangeType) IsResourceType() bool {
+	return false
+}
+
+func (t *InclusiveRangeType) IsInvalidType() bool {
+	if t.MemberType == nil {
+		return false
+	}
+	return t.MemberType.IsInvalidType()
+}
+
+func (*InclusiveRangeType) IsStorable(_ map[*Member]bool) bool {
+	return false
+}
+
+func (*InclusiveRangeType) IsExternallyReturnable(_ map[*Member]bool) bool {
+	return false
+}
+
+func (*InclusiveRangeType) IsEquatable() bool {
+	return false
+}
+
+func (t *InclusiveRangeType) TypeAnnotationState() TypeAnnotationState {
+	if t.MemberType == nil {
+		return TypeAnnotationStateValid
+	}
+	return t.MemberType.TypeAnnotationState()
+}
+
+func (t *InclusiveRangeType) RewriteWithRestrictedTypes() (Type, bool) {
+	return t, false
+}
+
+func (t *InclusiveRangeType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) bool {
+	otherRange, ok := other.(*InclusiveRangeType)
+	if !ok {
+		return false
+	}
+
+	if t.MemberType == nil || otherRange.MemberType == nil {
+		return false
+	}
+
+	return t.MemberType.Unify(otherRange.MemberType, typeParameters, report, outerRange)
+}
+
+func (t *InclusiveRangeType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	if t.MemberType == nil {
+		return t
+	}
+
+	resolvedMemberType := t.MemberType.Resolve(typeArguments)
+	if resolvedMemberType == nil {
+		return nil
+	}
+
+	return &InclusiveRangeType{
+		MemberType: resolvedMemberType,
+	}
+}
+
+var inclusiveRangeTypeParameter = &TypeParameter{
+	Name:      "T",
+	TypeBound: &IntegerType{},
+}
+
+func (*InclusiveRangeType) TypeParameters() []*TypeParameter {
+	return []*TypeParameter{
+		inclusiveRangeTypeParameter,
+	}
+}
+
+func (*InclusiveRangeType) Instantiate(typeArguments []Type, _ func(err error)) Type {
+	return &InclusiveRangeType{
+		MemberType: typeArguments[0],
+	}
+}
+
+func (t *InclusiveRangeType) BaseType() Type {
+	if t.MemberType == nil {
+		return nil
+	}
+	return &InclusiveRangeType{}
+}
+
+func (t *InclusiveRangeType) TypeArguments() []Type {
+	return []Type{
+		t.memberType(),
+	}
+}
+
+// memberType returns the type of the values of the range.
+// The values of an unparameterized range are integers of an unknown type
+//
+func (t *InclusiveRangeType) memberType() Type {
+	if t.MemberType == nil {
+		return &IntegerType{}
+	}
+	return t.MemberType
+}
+
+func (t *InclusiveRangeType) GetMembers() map[string]MemberResolver {
+	memberType := t.memberType()
+
+	return withBuiltinMembers(t, map[string]MemberResolver{
+		InclusiveRangeTypeStartFieldName: {
+			Kind: common.DeclarationKindField,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicConstantFieldMember(
+					t,
+					identifier,
+					memberType,
+					inclusiveRangeTypeStartFieldDocString,
+				)
+			},
+		},
+		InclusiveRangeTypeEndFieldName: {
+			Kind: common.DeclarationKindField,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicConstantFieldMember(
+					t,
+					identifier,
+					memberType,
+					inclusiveRangeTypeEndFieldDocString,
+				)
+			},
+		},
+		InclusiveRangeTypeStepFieldName: {
+			Kind: common.DeclarationKindField,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicConstantFieldMember(
+					t,
+					identifier,
+					memberType,
+					inclusiveRangeTypeStepFieldDocString,
+				)
+			},
+		},
+		InclusiveRangeTypeContainsFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					inclusiveRangeContainsFunctionType(memberType),
+					inclusiveRangeTypeContainsFunctionDocString,
+				)
+			},
+		},
+	})
+}
+
+func inclusiveRangeContainsFunctionType(memberType Type) *FunctionType {
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(memberType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+	}
+}
+
+const inclusiveRangeTypeStartFieldDocString = `
+The start of the range, which is included in the range
+`
+
+const inclusiveRangeTypeEndFieldDocString = `
+The end of the range, which is included in the range, if it is reached by the step
+`
+
+const inclusiveRangeTypeStepFieldDocString = `
+The step by which the values of the range increase or decrease
+`
+
+const inclusiveRangeTypeContainsFunctionDocString = `
+Returns true if the given integer is a value of the range
+`
+
+// InclusiveRangeConstructorFunctionType is the type of the constructor function of ranges:
+// `fun InclusiveRange<T: Integer>(_ start: T, _ end: T, step: T): InclusiveRange<T>`.
+//
+// The step is optional and defaults to 1
+//
+var InclusiveRangeConstructorFunctionType = func() *FunctionType {
+
+	typeParameter := &TypeParameter{
+		Name:      inclusiveRangeTypeParameter.Name,
+		TypeBound: inclusiveRangeTypeParameter.TypeBound,
+	}
+
+	memberType := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     InclusiveRangeTypeStartFieldName,
+				TypeAnnotation: NewTypeAnnotation(memberType),
+			},
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     InclusiveRangeTypeEndFieldName,
+				TypeAnnotation: NewTypeAnnotation(memberType),
+			},
+			{
+				Identifier:     InclusiveRangeTypeStepFieldName,
+				TypeAnnotation: NewTypeAnnotation(memberType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&InclusiveRangeType{
+				MemberType: memberType,
+			},
+		),
+		RequiredArgumentCount: RequiredArgumentCount(2),
+	}
+}()
+
+func init() {
+
+	// Check that the function is not accidentally redeclared
+
+	if BaseValueActivation.Find(InclusiveRangeTypeName) != nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	BaseValueActivation.Set(
+		InclusiveRangeTypeName,
+		baseFunctionVariable(
+			InclusiveRangeTypeName,
+			InclusiveRangeConstructorFunctionType,
+		),
+	)
+}
@@ -66,6 +66,8 @@ type Origin struct {
 	DeclarationKind common.DeclarationKind
 	StartPos        *ast.Position
 	EndPos          *ast.Position
+	// Extension is the extension which declares the member, if any
+	Extension *ExtensionType
 }
 
 type Occurrences struct {
@@ -69,6 +69,28 @@ var PublicAccountType = &SimpleType{
 					)
 				},
 			},
+			"balance": {
+				Kind: common.DeclarationKindField,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						t,
+						identifier,
+						&UFix64Type{},
+						accountTypeBalanceFieldDocString,
+					)
+				},
+			},
+			"availableBalance": {
+				Kind: common.DeclarationKindField,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						t,
+						identifier,
+						&UFix64Type{},
+						accountTypeAvailableBalanceFieldDocString,
+					)
+				},
+			},
 			"getCapability": {
 				Kind: common.DeclarationKindFunction,
 				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -127,6 +149,24 @@ var PublicAccountKeysType = func() *CompositeType {
 			accountKeysTypeGetFunctionType,
 			accountKeysTypeGetFunctionDocString,
 		),
+		NewPublicConstantFieldMember(
+			accountKeys,
+			AccountKeysCountFieldName,
+			&UInt64Type{},
+			accountKeysTypeCountFieldDocString,
+		),
+		NewPublicFunctionMember(
+			accountKeys,
+			AccountKeysForEachFunctionName,
+			accountKeysTypeForEachFunctionType,
+			accountKeysTypeForEachFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			accountKeys,
+			AccountKeysGetByPublicKeyFunctionName,
+			accountKeysTypeGetByPublicKeyFunctionType,
+			accountKeysTypeGetByPublicKeyFunctionDocString,
+		),
 	}
 
 	accountKeys.Members = GetMembersAsMap(members)
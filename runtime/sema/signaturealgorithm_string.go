@@ -11,11 +11,12 @@ func _() {
 	_ = x[SignatureAlgorithmUnknown-0]
 	_ = x[SignatureAlgorithmECDSA_P256-1]
 	_ = x[SignatureAlgorithmECDSA_Secp256k1-2]
+	_ = x[SignatureAlgorithmBLS_BLS12_381-3]
 }
 
-const _SignatureAlgorithm_name = "SignatureAlgorithmUnknownSignatureAlgorithmECDSA_P256SignatureAlgorithmECDSA_Secp256k1"
+const _SignatureAlgorithm_name = "SignatureAlgorithmUnknownSignatureAlgorithmECDSA_P256SignatureAlgorithmECDSA_Secp256k1SignatureAlgorithmBLS_BLS12_381"
 
-var _SignatureAlgorithm_index = [...]uint8{0, 25, 53, 86}
+var _SignatureAlgorithm_index = [...]uint8{0, 25, 53, 86, 117}
 
 func (i SignatureAlgorithm) String() string {
 	if i >= SignatureAlgorithm(len(_SignatureAlgorithm_index)-1) {
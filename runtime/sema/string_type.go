@@ -21,6 +21,7 @@ package sema
 import (
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
 )
 
 // StringType represents the string type
@@ -44,10 +45,22 @@ var StringType = &SimpleType{
 	},
 }
 
+const StringTypeConcatFunctionName = "concat"
+const StringTypeSliceFunctionName = "slice"
+const StringTypeDecodeHexFunctionName = "decodeHex"
+const StringTypeLengthFieldName = "length"
+const StringTypeUTF8FieldName = "utf8"
+const StringTypeSplitFunctionName = "split"
+const StringTypeContainsFunctionName = "contains"
+const StringTypeIndexFunctionName = "index"
+const StringTypeReplaceAllFunctionName = "replaceAll"
+const StringTypeToLowerFunctionName = "toLower"
+const StringTypeToUpperFunctionName = "toUpper"
+
 func init() {
 	StringType.Members = func(t *SimpleType) map[string]MemberResolver {
 		return map[string]MemberResolver{
-			"concat": {
+			StringTypeConcatFunctionName: {
 				Kind: common.DeclarationKindFunction,
 				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
 					return NewPublicFunctionMember(
@@ -58,7 +71,7 @@ func init() {
 					)
 				},
 			},
-			"slice": {
+			StringTypeSliceFunctionName: {
 				Kind: common.DeclarationKindFunction,
 				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
 					return NewPublicFunctionMember(
@@ -69,7 +82,7 @@ func init() {
 					)
 				},
 			},
-			"decodeHex": {
+			StringTypeDecodeHexFunctionName: {
 				Kind: common.DeclarationKindFunction,
 				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
 					return NewPublicFunctionMember(
@@ -80,7 +93,7 @@ func init() {
 					)
 				},
 			},
-			"length": {
+			StringTypeLengthFieldName: {
 				Kind: common.DeclarationKindField,
 				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
 					return NewPublicConstantFieldMember(
@@ -91,11 +104,91 @@ func init() {
 					)
 				},
 			},
+			StringTypeUTF8FieldName: {
+				Kind: common.DeclarationKindField,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						t,
+						identifier,
+						&VariableSizedType{
+							Type: &UInt8Type{},
+						},
+						stringTypeUTF8FieldDocString,
+					)
+				},
+			},
+			StringTypeSplitFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeSplitFunctionType,
+						stringTypeSplitFunctionDocString,
+					)
+				},
+			},
+			StringTypeContainsFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeContainsFunctionType,
+						stringTypeContainsFunctionDocString,
+					)
+				},
+			},
+			StringTypeIndexFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeIndexFunctionType,
+						stringTypeIndexFunctionDocString,
+					)
+				},
+			},
+			StringTypeReplaceAllFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeReplaceAllFunctionType,
+						stringTypeReplaceAllFunctionDocString,
+					)
+				},
+			},
+			StringTypeToLowerFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeToLowerFunctionType,
+						stringTypeToLowerFunctionDocString,
+					)
+				},
+			},
+			StringTypeToUpperFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						stringTypeToUpperFunctionType,
+						stringTypeToUpperFunctionDocString,
+					)
+				},
+			},
 		}
 	}
 }
 
 var stringTypeConcatFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:          ArgumentLabelNotRequired,
@@ -113,6 +206,7 @@ Returns a new string which contains the given string concatenated to the end of
 `
 
 var stringTypeSliceFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Identifier:     "from",
@@ -137,6 +231,7 @@ If either of the parameters are out of the bounds of the string, the function wi
 `
 
 var stringTypeDecodeHexFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		&VariableSizedType{
 			Type: &UInt8Type{},
@@ -154,3 +249,245 @@ If the string is malformed, the program aborts
 const stringTypeLengthFieldDocString = `
 The number of characters in the string
 `
+
+const stringTypeUTF8FieldDocString = `
+The byte array of the UTF-8 encoding of the string
+`
+
+var stringTypeSplitFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Identifier:     "separator",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&VariableSizedType{
+			Type: StringType,
+		},
+	),
+}
+
+const stringTypeSplitFunctionDocString = `
+Returns a new array containing the substrings of the string which are separated by the given separator.
+
+The separator only matches whole characters.
+If the separator is empty, the string is split into its characters
+`
+
+var stringTypeContainsFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "other",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		BoolType,
+	),
+}
+
+const stringTypeContainsFunctionDocString = `
+Returns true if the string contains the given string, and false otherwise.
+
+The given string only matches whole characters
+`
+
+var stringTypeIndexFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Identifier:     "of",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: &IntType{},
+		},
+	),
+}
+
+const stringTypeIndexFunctionDocString = `
+Returns the index of the first character of the first occurrence of the given string in the string,
+or nil if the string does not contain the given string.
+
+The given string only matches whole characters
+`
+
+var stringTypeReplaceAllFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Identifier:     "of",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+		{
+			Identifier:     "with",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+const stringTypeReplaceAllFunctionDocString = `
+Returns a new string in which all occurrences of the string ` + "`of`" + ` are replaced with the string ` + "`with`" + `.
+
+The string ` + "`of`" + ` only matches whole characters.
+It does not modify the original string
+`
+
+var stringTypeToLowerFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+const stringTypeToLowerFunctionDocString = `
+Returns a new string in which all characters are mapped to their lower case.
+It does not modify the original string
+`
+
+var stringTypeToUpperFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+const stringTypeToUpperFunctionDocString = `
+Returns a new string in which all characters are mapped to their upper case.
+It does not modify the original string
+`
+
+// StringFunctionType is the type of the base function `String`,
+// which returns an empty string, and which has the static string functions as members
+//
+var StringFunctionType = func() *SpecialFunctionType {
+
+	functionType := &SpecialFunctionType{
+		FunctionType: &FunctionType{
+			Purity: FunctionPurityView,
+			ReturnTypeAnnotation: NewTypeAnnotation(
+				StringType,
+			),
+		},
+	}
+
+	byteArrayType := &VariableSizedType{
+		Type: &UInt8Type{},
+	}
+
+	functionType.Members = GetMembersAsMap([]*Member{
+		NewPublicFunctionMember(
+			functionType,
+			StringFunctionJoinFunctionName,
+			&FunctionType{
+				Purity: FunctionPurityView,
+				Parameters: []*Parameter{
+					{
+						Label:      ArgumentLabelNotRequired,
+						Identifier: "strings",
+						TypeAnnotation: NewTypeAnnotation(
+							&VariableSizedType{
+								Type: StringType,
+							},
+						),
+					},
+					{
+						Identifier:     "separator",
+						TypeAnnotation: NewTypeAnnotation(StringType),
+					},
+				},
+				ReturnTypeAnnotation: NewTypeAnnotation(
+					StringType,
+				),
+			},
+			stringFunctionJoinFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			functionType,
+			StringFunctionFromUTF8FunctionName,
+			&FunctionType{
+				Purity: FunctionPurityView,
+				Parameters: []*Parameter{
+					{
+						Label:          ArgumentLabelNotRequired,
+						Identifier:     "bytes",
+						TypeAnnotation: NewTypeAnnotation(byteArrayType),
+					},
+				},
+				ReturnTypeAnnotation: NewTypeAnnotation(
+					&OptionalType{
+						Type: StringType,
+					},
+				),
+			},
+			stringFunctionFromUTF8FunctionDocString,
+		),
+		NewPublicFunctionMember(
+			functionType,
+			StringFunctionEncodeHexFunctionName,
+			&FunctionType{
+				Purity: FunctionPurityView,
+				Parameters: []*Parameter{
+					{
+						Label:          ArgumentLabelNotRequired,
+						Identifier:     "data",
+						TypeAnnotation: NewTypeAnnotation(byteArrayType),
+					},
+				},
+				ReturnTypeAnnotation: NewTypeAnnotation(
+					StringType,
+				),
+			},
+			stringFunctionEncodeHexFunctionDocString,
+		),
+	})
+
+	return functionType
+}()
+
+const StringFunctionJoinFunctionName = "join"
+
+const stringFunctionJoinFunctionDocString = `
+Returns a new string which contains the given strings, separated by the given separator
+`
+
+const StringFunctionFromUTF8FunctionName = "fromUTF8"
+
+const stringFunctionFromUTF8FunctionDocString = `
+Returns the string which is encoded by the given UTF-8 byte array,
+or nil if the byte array is not valid UTF-8
+`
+
+const StringFunctionEncodeHexFunctionName = "encodeHex"
+
+const stringFunctionEncodeHexFunctionDocString = `
+Returns the hexadecimal string representation of the given byte array
+`
+
+func init() {
+
+	typeName := StringType.String()
+
+	// Check that the function is not accidentally redeclared
+
+	if BaseValueActivation.Find(typeName) != nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	BaseValueActivation.Set(
+		typeName,
+		baseFunctionVariable(
+			typeName,
+			StringFunctionType,
+		),
+	)
+}
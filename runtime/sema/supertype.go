@@ -0,0 +1,191 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+// commonSuperTypeCandidates are the abstract supertypes which are candidates
+// for the least common supertype of two types, from most specific to least specific
+//
+var commonSuperTypeCandidates = []Type{
+	&SignedIntegerType{},
+	&IntegerType{},
+	&SignedFixedPointType{},
+	&FixedPointType{},
+	&SignedNumberType{},
+	&NumberType{},
+	CapabilityPathType,
+	PathType,
+}
+
+// LeastCommonSuperType returns the most specific type which is a supertype of all given types,
+// i.e. the least upper bound of the types.
+//
+// Optional types, array types, and dictionary types are joined by their inner types,
+// number types and path types are joined to their most specific common abstract supertype,
+// composite and restricted types which have common interface conformances
+// are joined to a restricted type, and all other types are joined to `AnyStruct` or `AnyResource`.
+//
+// It returns nil if the types have no common supertype,
+// i.e. if some types are resource types and others are not.
+//
+func LeastCommonSuperType(types ...Type) Type {
+	var result Type = NeverType
+
+	for _, ty := range types {
+		if ty.IsInvalidType() {
+			return InvalidType
+		}
+
+		result = leastCommonSuperType(result, ty)
+		if result == nil {
+			return nil
+		}
+	}
+
+	return result
+}
+
+func leastCommonSuperType(a, b Type) Type {
+
+	if IsSubType(a, b) {
+		return b
+	}
+
+	if IsSubType(b, a) {
+		return a
+	}
+
+	// If one of the types is optional, the supertype is optional:
+	// T? and U, as well as T? and U?, are joined to V?, where V is the supertype of T and U
+
+	optionalA, aIsOptional := a.(*OptionalType)
+	optionalB, bIsOptional := b.(*OptionalType)
+
+	if aIsOptional || bIsOptional {
+		if aIsOptional {
+			a = optionalA.Type
+		}
+		if bIsOptional {
+			b = optionalB.Type
+		}
+
+		innerType := leastCommonSuperType(a, b)
+		if innerType == nil {
+			return nil
+		}
+
+		return &OptionalType{
+			Type: innerType,
+		}
+	}
+
+	isResource := a.IsResourceType()
+	if isResource != b.IsResourceType() {
+		return nil
+	}
+
+	switch a := a.(type) {
+	case *VariableSizedType:
+		if b, ok := b.(*VariableSizedType); ok {
+			elementType := leastCommonSuperType(a.Type, b.Type)
+			if elementType != nil {
+				return &VariableSizedType{
+					Type: elementType,
+				}
+			}
+		}
+
+	case *ConstantSizedType:
+		if b, ok := b.(*ConstantSizedType); ok && a.Size == b.Size {
+			elementType := leastCommonSuperType(a.Type, b.Type)
+			if elementType != nil {
+				return &ConstantSizedType{
+					Type: elementType,
+					Size: a.Size,
+				}
+			}
+		}
+
+	case *DictionaryType:
+		if b, ok := b.(*DictionaryType); ok {
+			keyType := leastCommonSuperType(a.KeyType, b.KeyType)
+			valueType := leastCommonSuperType(a.ValueType, b.ValueType)
+			if keyType != nil && valueType != nil && IsValidDictionaryKeyType(keyType) {
+				return &DictionaryType{
+					KeyType:   keyType,
+					ValueType: valueType,
+				}
+			}
+		}
+	}
+
+	for _, candidate := range commonSuperTypeCandidates {
+		if IsSubType(a, candidate) && IsSubType(b, candidate) {
+			return candidate
+		}
+	}
+
+	var anyType Type = AnyStructType
+	if isResource {
+		anyType = AnyResourceType
+	}
+
+	restrictions := commonInterfaceConformances(a, b)
+	if len(restrictions) > 0 {
+		return &RestrictedType{
+			Type:         anyType,
+			Restrictions: restrictions,
+		}
+	}
+
+	return anyType
+}
+
+// commonInterfaceConformances returns the interfaces which both given types conform to,
+// if the types are composite types or restricted types
+//
+func commonInterfaceConformances(a, b Type) []*InterfaceType {
+	conformancesA := interfaceConformanceSet(a)
+	conformancesB := interfaceConformanceSet(b)
+	if conformancesA == nil || conformancesB == nil {
+		return nil
+	}
+
+	var result []*InterfaceType
+
+	conformancesA.ForEach(func(interfaceType *InterfaceType) {
+		if conformancesB.Includes(interfaceType) {
+			result = append(result, interfaceType)
+		}
+	})
+
+	return result
+}
+
+func interfaceConformanceSet(ty Type) *InterfaceSet {
+	switch ty := ty.(type) {
+	case *CompositeType:
+		return ty.ExplicitInterfaceConformanceSet()
+	case *InstantiatedType:
+		return ty.Type.ExplicitInterfaceConformanceSet()
+	case *RestrictedType:
+		return ty.RestrictionSet()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,221 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func TestLeastCommonSuperType(t *testing.T) {
+
+	t.Parallel()
+
+	interfaceType1 := &InterfaceType{
+		CompositeKind: common.CompositeKindStructure,
+		Identifier:    "I1",
+		Location:      common.StringLocation("test"),
+	}
+
+	interfaceType2 := &InterfaceType{
+		CompositeKind: common.CompositeKindStructure,
+		Identifier:    "I2",
+		Location:      common.StringLocation("test"),
+	}
+
+	structType1 := &CompositeType{
+		Kind:                          common.CompositeKindStructure,
+		Identifier:                    "S1",
+		Location:                      common.StringLocation("test"),
+		ExplicitInterfaceConformances: []*InterfaceType{interfaceType1, interfaceType2},
+	}
+
+	structType2 := &CompositeType{
+		Kind:                          common.CompositeKindStructure,
+		Identifier:                    "S2",
+		Location:                      common.StringLocation("test"),
+		ExplicitInterfaceConformances: []*InterfaceType{interfaceType2},
+	}
+
+	resourceType := &CompositeType{
+		Kind:       common.CompositeKindResource,
+		Identifier: "R",
+		Location:   common.StringLocation("test"),
+	}
+
+	nilType := &OptionalType{
+		Type: NeverType,
+	}
+
+	tests := []struct {
+		name     string
+		types    []Type
+		expected Type
+	}{
+		{
+			name:     "no types",
+			types:    nil,
+			expected: NeverType,
+		},
+		{
+			name:     "same types",
+			types:    []Type{StringType, StringType},
+			expected: StringType,
+		},
+		{
+			name:     "subtype",
+			types:    []Type{&IntType{}, &IntegerType{}},
+			expected: &IntegerType{},
+		},
+		{
+			name:     "signed integers",
+			types:    []Type{&IntType{}, &Int8Type{}},
+			expected: &SignedIntegerType{},
+		},
+		{
+			name:     "integers",
+			types:    []Type{&IntType{}, &UInt8Type{}},
+			expected: &IntegerType{},
+		},
+		{
+			name:     "signed numbers",
+			types:    []Type{&IntType{}, &Fix64Type{}},
+			expected: &SignedNumberType{},
+		},
+		{
+			name:     "numbers",
+			types:    []Type{&UInt8Type{}, &UFix64Type{}},
+			expected: &NumberType{},
+		},
+		{
+			name:     "capability paths",
+			types:    []Type{PublicPathType, PrivatePathType},
+			expected: CapabilityPathType,
+		},
+		{
+			name:     "paths",
+			types:    []Type{PublicPathType, StoragePathType},
+			expected: PathType,
+		},
+		{
+			name:     "nil and non-optional",
+			types:    []Type{nilType, &IntType{}},
+			expected: &OptionalType{Type: &IntType{}},
+		},
+		{
+			name:     "optional and non-optional",
+			types:    []Type{&OptionalType{Type: &IntType{}}, &Int8Type{}},
+			expected: &OptionalType{Type: &SignedIntegerType{}},
+		},
+		{
+			name: "arrays",
+			types: []Type{
+				&VariableSizedType{Type: &IntType{}},
+				&VariableSizedType{Type: nilType},
+			},
+			expected: &VariableSizedType{Type: &OptionalType{Type: &IntType{}}},
+		},
+		{
+			name: "constant-sized arrays",
+			types: []Type{
+				&ConstantSizedType{Type: &IntType{}, Size: 2},
+				&ConstantSizedType{Type: &UInt8Type{}, Size: 2},
+			},
+			expected: &ConstantSizedType{Type: &IntegerType{}, Size: 2},
+		},
+		{
+			name: "constant-sized arrays, different sizes",
+			types: []Type{
+				&ConstantSizedType{Type: &IntType{}, Size: 2},
+				&ConstantSizedType{Type: &IntType{}, Size: 3},
+			},
+			expected: AnyStructType,
+		},
+		{
+			name: "dictionaries",
+			types: []Type{
+				&DictionaryType{KeyType: StringType, ValueType: &IntType{}},
+				&DictionaryType{KeyType: StringType, ValueType: BoolType},
+			},
+			expected: &DictionaryType{KeyType: StringType, ValueType: AnyStructType},
+		},
+		{
+			name:  "composites with common interface",
+			types: []Type{structType1, structType2},
+			expected: &RestrictedType{
+				Type:         AnyStructType,
+				Restrictions: []*InterfaceType{interfaceType2},
+			},
+		},
+		{
+			name: "composite and restricted type",
+			types: []Type{
+				structType1,
+				&RestrictedType{
+					Type:         structType2,
+					Restrictions: []*InterfaceType{interfaceType2},
+				},
+			},
+			expected: &RestrictedType{
+				Type:         AnyStructType,
+				Restrictions: []*InterfaceType{interfaceType2},
+			},
+		},
+		{
+			name:     "unrelated structures",
+			types:    []Type{StringType, BoolType, &IntType{}},
+			expected: AnyStructType,
+		},
+		{
+			name:     "resource and non-resource",
+			types:    []Type{resourceType, StringType},
+			expected: nil,
+		},
+		{
+			name:     "invalid",
+			types:    []Type{StringType, InvalidType},
+			expected: InvalidType,
+		},
+	}
+
+	for _, test := range tests {
+
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+
+			t.Parallel()
+
+			actual := LeastCommonSuperType(test.types...)
+
+			if test.expected == nil {
+				assert.Nil(t, actual)
+			} else {
+				assert.NotNil(t, actual)
+				assert.True(t,
+					test.expected.Equal(actual),
+					"expected %s, got %s", test.expected, actual,
+				)
+			}
+		})
+	}
+}
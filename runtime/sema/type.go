@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strings"
 	"sync"
 
@@ -287,6 +288,7 @@ func NewTypeAnnotation(ty Type) *TypeAnnotation {
 const IsInstanceFunctionName = "isInstance"
 
 var isInstanceFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:      ArgumentLabelNotRequired,
@@ -310,6 +312,7 @@ Returns true if the object conforms to the given type at runtime
 const GetTypeFunctionName = "getType"
 
 var getTypeFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		MetaType,
 	),
@@ -324,6 +327,7 @@ Returns the type of the value
 const ToStringFunctionName = "toString"
 
 var toStringFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		StringType,
 	),
@@ -338,6 +342,7 @@ A textual representation of this object
 const ToBigEndianBytesFunctionName = "toBigEndianBytes"
 
 var toBigEndianBytesFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		&VariableSizedType{
 			Type: &UInt8Type{},
@@ -349,6 +354,64 @@ const toBigEndianBytesFunctionDocString = `
 Returns an array containing the big-endian byte representation of the number
 `
 
+// Saturating arithmetic
+
+const NumericTypeSaturatingAddFunctionName = "saturatingAdd"
+const NumericTypeSaturatingSubtractFunctionName = "saturatingSubtract"
+const NumericTypeSaturatingMultiplyFunctionName = "saturatingMultiply"
+const NumericTypeSaturatingDivideFunctionName = "saturatingDivide"
+
+const numericTypeSaturatingAddFunctionDocString = `
+Adds the given number to this number, saturating at the numeric bounds instead of overflowing
+`
+
+const numericTypeSaturatingSubtractFunctionDocString = `
+Subtracts the given number from this number, saturating at the numeric bounds instead of overflowing
+`
+
+const numericTypeSaturatingMultiplyFunctionDocString = `
+Multiplies this number with the given number, saturating at the numeric bounds instead of overflowing
+`
+
+const numericTypeSaturatingDivideFunctionDocString = `
+Divides this number by the given number, saturating at the numeric bounds instead of overflowing.
+
+Division by zero still aborts the program
+`
+
+func saturatingArithmeticFunctionType(ty Type) *FunctionType {
+	typeAnnotation := NewTypeAnnotation(ty)
+
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: typeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: typeAnnotation,
+	}
+}
+
+// SupportsSaturatingArithmetic returns true if the given type has saturating arithmetic functions.
+//
+// The fixed-size integer types and the fixed-point types saturate at their bounds.
+// Unbounded types (Int, UInt) cannot overflow, and Word types wrap around
+//
+func SupportsSaturatingArithmetic(ty Type) bool {
+	switch ty.(type) {
+	case *Int8Type, *Int16Type, *Int32Type, *Int64Type, *Int128Type, *Int256Type,
+		*UInt8Type, *UInt16Type, *UInt32Type, *UInt64Type, *UInt128Type, *UInt256Type,
+		*Fix64Type, *UFix64Type, *Fix128Type, *UFix128Type:
+
+		return true
+	}
+
+	return false
+}
+
 func withBuiltinMembers(ty Type, members map[string]MemberResolver) map[string]MemberResolver {
 	if members == nil {
 		members = map[string]MemberResolver{}
@@ -416,6 +479,42 @@ func withBuiltinMembers(ty Type, members map[string]MemberResolver) map[string]M
 		}
 	}
 
+	// Fixed-size integer types and fixed-point types have saturating arithmetic functions
+
+	if SupportsSaturatingArithmetic(ty) {
+
+		addSaturatingArithmeticFunction := func(name string, docString string) {
+			members[name] = MemberResolver{
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						ty,
+						identifier,
+						saturatingArithmeticFunctionType(ty),
+						docString,
+					)
+				},
+			}
+		}
+
+		addSaturatingArithmeticFunction(
+			NumericTypeSaturatingAddFunctionName,
+			numericTypeSaturatingAddFunctionDocString,
+		)
+		addSaturatingArithmeticFunction(
+			NumericTypeSaturatingSubtractFunctionName,
+			numericTypeSaturatingSubtractFunctionDocString,
+		)
+		addSaturatingArithmeticFunction(
+			NumericTypeSaturatingMultiplyFunctionName,
+			numericTypeSaturatingMultiplyFunctionDocString,
+		)
+		addSaturatingArithmeticFunction(
+			NumericTypeSaturatingDivideFunctionName,
+			numericTypeSaturatingDivideFunctionDocString,
+		)
+	}
+
 	return members
 }
 
@@ -622,20 +721,27 @@ func (t *GenericType) Equal(other Type) bool {
 	return t.TypeParameter == otherType.TypeParameter
 }
 
-func (*GenericType) IsResourceType() bool {
-	return false
+// IsResourceType returns true if the type parameter's type bound is a resource type.
+// The type bounds of user-declared type parameters are always either
+// a subtype of `AnyStruct` or a subtype of `AnyResource`
+//
+func (t *GenericType) IsResourceType() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsResourceType()
 }
 
 func (*GenericType) IsInvalidType() bool {
 	return false
 }
 
-func (*GenericType) IsStorable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsStorable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsStorable(results)
 }
 
-func (*GenericType) IsExternallyReturnable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsExternallyReturnable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsExternallyReturnable(results)
 }
 
 func (*GenericType) IsEquatable() bool {
@@ -661,9 +767,9 @@ func (t *GenericType) Unify(
 
 		// If the type parameter is already unified with a type argument
 		// (either explicit by a type argument, or implicit through an argument's type),
-		// check that this argument's type matches the unified type
+		// check that this argument's type is a subtype of the unified type
 
-		if !other.Equal(unifiedType) {
+		if !IsSubType(other, unifiedType) {
 			report(
 				&TypeParameterTypeMismatchError{
 					TypeParameter: t.TypeParameter,
@@ -700,6 +806,14 @@ func (t *GenericType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
 }
 
 func (t *GenericType) GetMembers() map[string]MemberResolver {
+	// The members of a value of a generic type
+	// are the members of the type bound
+
+	typeBound := t.TypeParameter.TypeBound
+	if typeBound != nil {
+		return typeBound.GetMembers()
+	}
+
 	return withBuiltinMembers(t, nil)
 }
 
@@ -2690,6 +2804,194 @@ func (t *UFix64Type) GetMembers() map[string]MemberResolver {
 	return withBuiltinMembers(t, nil)
 }
 
+const Fix128Scale = fixedpoint.Fix128Scale
+
+var Fix128FactorBig = fixedpoint.Fix128FactorBig
+
+// Fix128Type represents the 128-bit signed decimal fixed-point type `Fix128`
+// which has a scale of Fix128Scale, and checks for overflow and underflow
+type Fix128Type struct{}
+
+func (*Fix128Type) IsType() {}
+
+func (*Fix128Type) String() string {
+	return "Fix128"
+}
+
+func (*Fix128Type) QualifiedString() string {
+	return "Fix128"
+}
+
+func (*Fix128Type) ID() TypeID {
+	return "Fix128"
+}
+
+func (*Fix128Type) Equal(other Type) bool {
+	_, ok := other.(*Fix128Type)
+	return ok
+}
+
+func (*Fix128Type) IsResourceType() bool {
+	return false
+}
+
+func (*Fix128Type) IsInvalidType() bool {
+	return false
+}
+
+func (*Fix128Type) IsStorable(_ map[*Member]bool) bool {
+	return true
+}
+
+func (*Fix128Type) IsExternallyReturnable(_ map[*Member]bool) bool {
+	return true
+}
+
+func (*Fix128Type) IsEquatable() bool {
+	return true
+}
+
+func (*Fix128Type) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (t *Fix128Type) RewriteWithRestrictedTypes() (result Type, rewritten bool) {
+	return t, false
+}
+
+var Fix128TypeMinScaledBig = fixedpoint.Fix128TypeMinScaledBig
+var Fix128TypeMaxScaledBig = fixedpoint.Fix128TypeMaxScaledBig
+
+var Fix128TypeMinIntBig = fixedpoint.Fix128TypeMinIntBig
+var Fix128TypeMaxIntBig = fixedpoint.Fix128TypeMaxIntBig
+
+var Fix128TypeMinFractionalBig = fixedpoint.Fix128TypeMinFractionalBig
+var Fix128TypeMaxFractionalBig = fixedpoint.Fix128TypeMaxFractionalBig
+
+func (*Fix128Type) MinInt() *big.Int {
+	return Fix128TypeMinIntBig
+}
+
+func (*Fix128Type) MaxInt() *big.Int {
+	return Fix128TypeMaxIntBig
+}
+
+func (*Fix128Type) Scale() uint {
+	return Fix128Scale
+}
+
+func (*Fix128Type) MinFractional() *big.Int {
+	return Fix128TypeMinFractionalBig
+}
+
+func (*Fix128Type) MaxFractional() *big.Int {
+	return Fix128TypeMaxFractionalBig
+}
+
+func (*Fix128Type) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
+	return false
+}
+
+func (t *Fix128Type) Resolve(_ *TypeParameterTypeOrderedMap) Type {
+	return t
+}
+
+func (t *Fix128Type) GetMembers() map[string]MemberResolver {
+	return withBuiltinMembers(t, nil)
+}
+
+// UFix128Type represents the 128-bit unsigned decimal fixed-point type `UFix128`
+// which has a scale of Fix128Scale, and checks for overflow and underflow
+type UFix128Type struct{}
+
+func (*UFix128Type) IsType() {}
+
+func (*UFix128Type) String() string {
+	return "UFix128"
+}
+
+func (*UFix128Type) QualifiedString() string {
+	return "UFix128"
+}
+
+func (*UFix128Type) ID() TypeID {
+	return "UFix128"
+}
+
+func (*UFix128Type) Equal(other Type) bool {
+	_, ok := other.(*UFix128Type)
+	return ok
+}
+
+func (*UFix128Type) IsResourceType() bool {
+	return false
+}
+
+func (*UFix128Type) IsInvalidType() bool {
+	return false
+}
+
+func (*UFix128Type) IsStorable(_ map[*Member]bool) bool {
+	return true
+}
+
+func (*UFix128Type) IsExternallyReturnable(_ map[*Member]bool) bool {
+	return true
+}
+
+func (*UFix128Type) IsEquatable() bool {
+	return true
+}
+
+func (*UFix128Type) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (t *UFix128Type) RewriteWithRestrictedTypes() (result Type, rewritten bool) {
+	return t, false
+}
+
+var UFix128TypeMinScaledBig = fixedpoint.UFix128TypeMinScaledBig
+var UFix128TypeMaxScaledBig = fixedpoint.UFix128TypeMaxScaledBig
+
+var UFix128TypeMinIntBig = fixedpoint.UFix128TypeMinIntBig
+var UFix128TypeMaxIntBig = fixedpoint.UFix128TypeMaxIntBig
+
+var UFix128TypeMinFractionalBig = fixedpoint.UFix128TypeMinFractionalBig
+var UFix128TypeMaxFractionalBig = fixedpoint.UFix128TypeMaxFractionalBig
+
+func (*UFix128Type) MinInt() *big.Int {
+	return UFix128TypeMinIntBig
+}
+
+func (*UFix128Type) MaxInt() *big.Int {
+	return UFix128TypeMaxIntBig
+}
+
+func (*UFix128Type) Scale() uint {
+	return Fix128Scale
+}
+
+func (*UFix128Type) MinFractional() *big.Int {
+	return UFix128TypeMinFractionalBig
+}
+
+func (*UFix128Type) MaxFractional() *big.Int {
+	return UFix128TypeMaxFractionalBig
+}
+
+func (*UFix128Type) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
+	return false
+}
+
+func (t *UFix128Type) Resolve(_ *TypeParameterTypeOrderedMap) Type {
+	return t
+}
+
+func (t *UFix128Type) GetMembers() map[string]MemberResolver {
+	return withBuiltinMembers(t, nil)
+}
+
 // ArrayType
 
 type ArrayType interface {
@@ -2743,8 +3045,78 @@ Removes the last element from the array and returns it.
 The array must not be empty. If the array is empty, the program aborts
 `
 
+const arrayTypeFilterFunctionDocString = `
+Returns a new array which contains the elements of the array for which the given function returns true.
+
+The original array is not modified
+`
+
+const arrayTypeMapFunctionDocString = `
+Returns a new array which contains the results of calling the given function with each element of the array.
+
+The original array is not modified
+`
+
+const arrayTypeReduceFunctionDocString = `
+Returns the result of combining the elements of the array using the given function,
+starting with the given initial value
+`
+
+const arrayTypeFirstIndexFunctionDocString = `
+Returns the index of the first element of the array that is equal to the given element,
+or nil if the array does not contain the element
+`
+
+const arrayTypeReverseFunctionDocString = `
+Returns a new array which contains the elements of the array in reverse order.
+
+The original array is not modified
+`
+
+const arrayTypeSliceFunctionDocString = `
+Returns a new array which contains the elements of the array
+from the start index up to, but not including, the end index.
+
+The indices must be within the bounds of the array, and the start index must not be greater than the end index.
+If the indices are invalid, the program aborts
+`
+
+const arrayTypeSortFunctionDocString = `
+Sorts the elements of the array in place, using the given function to compare elements.
+
+The function must return true if the first element should be ordered before the second element.
+The sort is stable: elements which are equal keep their order
+`
+
+const ArrayTypeFilterFunctionName = "filter"
+const ArrayTypeMapFunctionName = "map"
+const ArrayTypeReduceFunctionName = "reduce"
+const ArrayTypeFirstIndexFunctionName = "firstIndex"
+const ArrayTypeReverseFunctionName = "reverse"
+const ArrayTypeSliceFunctionName = "slice"
+const ArrayTypeSortFunctionName = "sort"
+
 func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 
+	// Functions which pass elements to a given function or return copies of elements
+	// are invalid for arrays of resources: the resources would be moved out of the array or duplicated
+
+	nonResourceElementType := func(identifier string, targetRange ast.Range, report func(error)) Type {
+		elementType := arrayType.ElementType(false)
+
+		if elementType.IsResourceType() {
+			report(
+				&InvalidResourceArrayMemberError{
+					Name:            identifier,
+					DeclarationKind: common.DeclarationKindFunction,
+					Range:           targetRange,
+				},
+			)
+		}
+
+		return elementType
+	}
+
 	members := map[string]MemberResolver{
 		"contains": {
 			Kind: common.DeclarationKindFunction,
@@ -2780,6 +3152,7 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 					arrayType,
 					identifier,
 					&FunctionType{
+						Purity: FunctionPurityView,
 						Parameters: []*Parameter{
 							{
 								Label:          ArgumentLabelNotRequired,
@@ -2806,72 +3179,362 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 				)
 			},
 		},
-	}
-
-	// TODO: maybe still return members but report a helpful error?
-
-	if _, ok := arrayType.(*VariableSizedType); ok {
-
-		members["append"] = MemberResolver{
+		ArrayTypeFilterFunctionName: {
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
-				elementType := arrayType.ElementType(false)
+
+				elementType := nonResourceElementType(identifier, targetRange, report)
+
 				return NewPublicFunctionMember(
 					arrayType,
 					identifier,
 					&FunctionType{
 						Parameters: []*Parameter{
 							{
-								Label:          ArgumentLabelNotRequired,
-								Identifier:     "element",
-								TypeAnnotation: NewTypeAnnotation(elementType),
+								Label:      ArgumentLabelNotRequired,
+								Identifier: "f",
+								TypeAnnotation: NewTypeAnnotation(
+									&FunctionType{
+										Parameters: []*Parameter{
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "element",
+												TypeAnnotation: NewTypeAnnotation(elementType),
+											},
+										},
+										ReturnTypeAnnotation: NewTypeAnnotation(
+											BoolType,
+										),
+									},
+								),
 							},
 						},
 						ReturnTypeAnnotation: NewTypeAnnotation(
-							VoidType,
+							&VariableSizedType{
+								Type: elementType,
+							},
 						),
 					},
-					arrayTypeAppendFunctionDocString,
+					arrayTypeFilterFunctionDocString,
 				)
 			},
-		}
-
-		members["concat"] = MemberResolver{
+		},
+		ArrayTypeMapFunctionName: {
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
 
-				// TODO: maybe allow for resource element type
+				elementType := nonResourceElementType(identifier, targetRange, report)
 
-				elementType := arrayType.ElementType(false)
+				typeParameter := &TypeParameter{
+					Name: "T",
+				}
 
-				if elementType.IsResourceType() {
-					report(
-						&InvalidResourceArrayMemberError{
-							Name:            identifier,
-							DeclarationKind: common.DeclarationKindFunction,
-							Range:           targetRange,
-						},
-					)
+				resultElementType := &GenericType{
+					TypeParameter: typeParameter,
 				}
 
-				typeAnnotation := NewTypeAnnotation(arrayType)
+				// Mapping a constant-sized array results in an array of the same size
+
+				var resultType Type
+				if constantSizedType, ok := arrayType.(*ConstantSizedType); ok {
+					resultType = &ConstantSizedType{
+						Type: resultElementType,
+						Size: constantSizedType.Size,
+					}
+				} else {
+					resultType = &VariableSizedType{
+						Type: resultElementType,
+					}
+				}
 
 				return NewPublicFunctionMember(
 					arrayType,
 					identifier,
 					&FunctionType{
+						TypeParameters: []*TypeParameter{
+							typeParameter,
+						},
 						Parameters: []*Parameter{
 							{
-								Label:          ArgumentLabelNotRequired,
-								Identifier:     "other",
-								TypeAnnotation: typeAnnotation,
-							},
-						},
-						ReturnTypeAnnotation: typeAnnotation,
-					},
-					arrayTypeConcatFunctionDocString,
-				)
-			},
+								Label:      ArgumentLabelNotRequired,
+								Identifier: "transform",
+								TypeAnnotation: NewTypeAnnotation(
+									&FunctionType{
+										Parameters: []*Parameter{
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "element",
+												TypeAnnotation: NewTypeAnnotation(elementType),
+											},
+										},
+										ReturnTypeAnnotation: NewTypeAnnotation(
+											resultElementType,
+										),
+									},
+								),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							resultType,
+						),
+					},
+					arrayTypeMapFunctionDocString,
+				)
+			},
+		},
+		ArrayTypeReduceFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := nonResourceElementType(identifier, targetRange, report)
+
+				typeParameter := &TypeParameter{
+					Name: "T",
+				}
+
+				resultType := &GenericType{
+					TypeParameter: typeParameter,
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						TypeParameters: []*TypeParameter{
+							typeParameter,
+						},
+						Parameters: []*Parameter{
+							{
+								Identifier:     "initial",
+								TypeAnnotation: NewTypeAnnotation(resultType),
+							},
+							{
+								Label:      ArgumentLabelNotRequired,
+								Identifier: "f",
+								TypeAnnotation: NewTypeAnnotation(
+									&FunctionType{
+										Parameters: []*Parameter{
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "accumulator",
+												TypeAnnotation: NewTypeAnnotation(resultType),
+											},
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "element",
+												TypeAnnotation: NewTypeAnnotation(elementType),
+											},
+										},
+										ReturnTypeAnnotation: NewTypeAnnotation(
+											resultType,
+										),
+									},
+								),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							resultType,
+						),
+					},
+					arrayTypeReduceFunctionDocString,
+				)
+			},
+		},
+		ArrayTypeFirstIndexFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := nonResourceElementType(identifier, targetRange, report)
+
+				if !elementType.IsEquatable() {
+					report(
+						&NotEquatableTypeError{
+							Type:  elementType,
+							Range: targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Purity: FunctionPurityView,
+						Parameters: []*Parameter{
+							{
+								Identifier:     "of",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&OptionalType{
+								Type: &IntType{},
+							},
+						),
+					},
+					arrayTypeFirstIndexFunctionDocString,
+				)
+			},
+		},
+		ArrayTypeReverseFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				nonResourceElementType(identifier, targetRange, report)
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Purity: FunctionPurityView,
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							arrayType,
+						),
+					},
+					arrayTypeReverseFunctionDocString,
+				)
+			},
+		},
+		ArrayTypeSliceFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := nonResourceElementType(identifier, targetRange, report)
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Purity: FunctionPurityView,
+						Parameters: []*Parameter{
+							{
+								Identifier:     "from",
+								TypeAnnotation: NewTypeAnnotation(&IntType{}),
+							},
+							{
+								Identifier:     "upTo",
+								TypeAnnotation: NewTypeAnnotation(&IntType{}),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&VariableSizedType{
+								Type: elementType,
+							},
+						),
+					},
+					arrayTypeSliceFunctionDocString,
+				)
+			},
+		},
+		ArrayTypeSortFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := nonResourceElementType(identifier, targetRange, report)
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Identifier: "by",
+								TypeAnnotation: NewTypeAnnotation(
+									&FunctionType{
+										Parameters: []*Parameter{
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "a",
+												TypeAnnotation: NewTypeAnnotation(elementType),
+											},
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "b",
+												TypeAnnotation: NewTypeAnnotation(elementType),
+											},
+										},
+										ReturnTypeAnnotation: NewTypeAnnotation(
+											BoolType,
+										),
+									},
+								),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							VoidType,
+						),
+					},
+					arrayTypeSortFunctionDocString,
+				)
+			},
+		},
+	}
+
+	// TODO: maybe still return members but report a helpful error?
+
+	if _, ok := arrayType.(*VariableSizedType); ok {
+
+		members["append"] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+				elementType := arrayType.ElementType(false)
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							VoidType,
+						),
+					},
+					arrayTypeAppendFunctionDocString,
+				)
+			},
+		}
+
+		members["concat"] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				// TODO: maybe allow for resource element type
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				typeAnnotation := NewTypeAnnotation(arrayType)
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Purity: FunctionPurityView,
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "other",
+								TypeAnnotation: typeAnnotation,
+							},
+						},
+						ReturnTypeAnnotation: typeAnnotation,
+					},
+					arrayTypeConcatFunctionDocString,
+				)
+			},
 		}
 
 		members["insert"] = MemberResolver{
@@ -3363,8 +4026,29 @@ func (p TypeParameter) checkTypeBound(ty Type, typeRange ast.Range) error {
 
 // Function types
 
+// FunctionPurity is the purity of a function.
+//
+// A view function may not have any side effects:
+// It may not modify non-local state, call non-view functions,
+// move resources, or emit events
+//
+type FunctionPurity int
+
+const (
+	FunctionPurityImpure FunctionPurity = iota
+	FunctionPurityView
+)
+
+func (p FunctionPurity) String() string {
+	if p == FunctionPurityView {
+		return "view"
+	}
+	return "impure"
+}
+
 func formatFunctionType(
 	spaces bool,
+	purity FunctionPurity,
 	typeParameters []string,
 	parameters []string,
 	returnTypeAnnotation string,
@@ -3372,6 +4056,10 @@ func formatFunctionType(
 
 	var builder strings.Builder
 	builder.WriteRune('(')
+	if purity == FunctionPurityView {
+		builder.WriteString(purity.String())
+		builder.WriteRune(' ')
+	}
 	if len(typeParameters) > 0 {
 		builder.WriteRune('<')
 		for i, typeParameter := range typeParameters {
@@ -3407,6 +4095,7 @@ func formatFunctionType(
 // FunctionType
 //
 type FunctionType struct {
+	Purity                FunctionPurity
 	TypeParameters        []*TypeParameter
 	Parameters            []*Parameter
 	ReturnTypeAnnotation  *TypeAnnotation
@@ -3445,6 +4134,7 @@ func (t *FunctionType) String() string {
 
 	return formatFunctionType(
 		true,
+		t.Purity,
 		typeParameters,
 		parameters,
 		returnTypeAnnotation,
@@ -3469,6 +4159,7 @@ func (t *FunctionType) QualifiedString() string {
 
 	return formatFunctionType(
 		true,
+		t.Purity,
 		typeParameters,
 		parameters,
 		returnTypeAnnotation,
@@ -3494,6 +4185,7 @@ func (t *FunctionType) ID() TypeID {
 	return TypeID(
 		formatFunctionType(
 			false,
+			t.Purity,
 			typeParameters,
 			parameters,
 			returnTypeAnnotation,
@@ -3508,6 +4200,12 @@ func (t *FunctionType) Equal(other Type) bool {
 		return false
 	}
 
+	// purity
+
+	if t.Purity != otherFunction.Purity {
+		return false
+	}
+
 	// type parameters
 
 	if len(t.TypeParameters) != len(otherFunction.TypeParameters) {
@@ -3684,6 +4382,7 @@ func (t *FunctionType) RewriteWithRestrictedTypes() (Type, bool) {
 		}
 
 		return &FunctionType{
+			Purity:                t.Purity,
 			TypeParameters:        rewrittenTypeParameters,
 			Parameters:            rewrittenParameters,
 			ReturnTypeAnnotation:  NewTypeAnnotation(rewrittenReturnType),
@@ -3766,7 +4465,9 @@ func (t *FunctionType) Unify(
 
 func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
 
-	// TODO: type parameters ?
+	// NOTE: the type parameters are kept as-is,
+	// the type arguments are only bound to the type parameters of outer declarations,
+	// e.g. to the type parameters of a generic composite type
 
 	// parameters
 
@@ -3795,6 +4496,8 @@ func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type
 	}
 
 	return &FunctionType{
+		Purity:                t.Purity,
+		TypeParameters:        t.TypeParameters,
 		Parameters:            newParameters,
 		ReturnTypeAnnotation:  NewTypeAnnotation(newReturnType),
 		RequiredArgumentCount: t.RequiredArgumentCount,
@@ -3815,12 +4518,20 @@ type SpecialFunctionType struct {
 }
 
 func (t *SpecialFunctionType) GetMembers() map[string]MemberResolver {
+	return withBuiltinMembers(t, memberResolvers(t.Members))
+}
+
+func memberResolvers(members *StringMemberOrderedMap) map[string]MemberResolver {
+	if members == nil {
+		return nil
+	}
+
 	// TODO: optimize
-	members := make(map[string]MemberResolver, t.Members.Len())
-	t.Members.Foreach(func(name string, loopMember *Member) {
+	resolvers := make(map[string]MemberResolver, members.Len())
+	members.Foreach(func(name string, loopMember *Member) {
 		// NOTE: don't capture loop variable
 		member := loopMember
-		members[name] = MemberResolver{
+		resolvers[name] = MemberResolver{
 			Kind: member.DeclarationKind,
 			Resolve: func(_ string, _ ast.Range, _ func(error)) *Member {
 				return member
@@ -3828,7 +4539,7 @@ func (t *SpecialFunctionType) GetMembers() map[string]MemberResolver {
 		}
 	})
 
-	return withBuiltinMembers(t, members)
+	return resolvers
 }
 
 // CheckedFunctionType is the the type representing a function that checks the arguments,
@@ -3843,6 +4554,11 @@ type ArgumentExpressionsCheck func(
 type CheckedFunctionType struct {
 	*FunctionType
 	ArgumentExpressionsCheck ArgumentExpressionsCheck
+	Members                  *StringMemberOrderedMap
+}
+
+func (t *CheckedFunctionType) GetMembers() map[string]MemberResolver {
+	return withBuiltinMembers(t, memberResolvers(t.Members))
 }
 
 func (t *CheckedFunctionType) CheckArgumentExpressions(
@@ -3878,6 +4594,7 @@ func init() {
 		PrivatePathType,
 		PublicPathType,
 		&CapabilityType{},
+		&InclusiveRangeType{},
 		DeployedContractType,
 		BlockType,
 		AccountKeyType,
@@ -3932,10 +4649,12 @@ var BaseValueActivation = NewVariableActivation(nil)
 
 var AllSignedFixedPointTypes = []Type{
 	&Fix64Type{},
+	&Fix128Type{},
 }
 
 var AllUnsignedFixedPointTypes = []Type{
 	&UFix64Type{},
+	&UFix128Type{},
 }
 
 var AllFixedPointTypes = append(
@@ -4012,29 +4731,69 @@ func init() {
 				panic(errors.NewUnreachableError())
 			}
 
+			functionType := &CheckedFunctionType{
+				FunctionType: &FunctionType{
+					Purity: FunctionPurityView,
+					Parameters: []*Parameter{
+						{
+							Label:          ArgumentLabelNotRequired,
+							Identifier:     "value",
+							TypeAnnotation: NewTypeAnnotation(&NumberType{}),
+						},
+					},
+					ReturnTypeAnnotation: NewTypeAnnotation(numberType),
+				},
+				ArgumentExpressionsCheck: numberFunctionArgumentExpressionsChecker(numberType),
+			}
+
+			functionType.Members = fromStringFunctionMembers(functionType, numberType)
+
 			BaseValueActivation.Set(
 				typeName,
 				baseFunctionVariable(
 					typeName,
-					&CheckedFunctionType{
-						FunctionType: &FunctionType{
-							Parameters: []*Parameter{
-								{
-									Label:          ArgumentLabelNotRequired,
-									Identifier:     "value",
-									TypeAnnotation: NewTypeAnnotation(&NumberType{}),
-								},
-							},
-							ReturnTypeAnnotation: NewTypeAnnotation(numberType),
-						},
-						ArgumentExpressionsCheck: numberFunctionArgumentExpressionsChecker(numberType),
-					},
+					functionType,
 				),
 			)
 		}
 	}
 }
 
+const FromStringFunctionName = "fromString"
+
+const fromStringFunctionDocString = `
+Attempts to parse the given string as a literal of the type.
+Returns nil if the string is not a valid literal, or if it is out of the range of the type
+`
+
+// fromStringFunctionMembers returns the static members of the conversion function
+// of the given type, i.e. the function `fun fromString(_ input: String): T?`
+//
+func fromStringFunctionMembers(functionType Type, ty Type) *StringMemberOrderedMap {
+	return GetMembersAsMap([]*Member{
+		NewPublicFunctionMember(
+			functionType,
+			FromStringFunctionName,
+			&FunctionType{
+				Purity: FunctionPurityView,
+				Parameters: []*Parameter{
+					{
+						Label:          ArgumentLabelNotRequired,
+						Identifier:     "input",
+						TypeAnnotation: NewTypeAnnotation(StringType),
+					},
+				},
+				ReturnTypeAnnotation: NewTypeAnnotation(
+					&OptionalType{
+						Type: ty,
+					},
+				),
+			},
+			fromStringFunctionDocString,
+		),
+	})
+}
+
 func baseFunctionVariable(name string, ty InvokableType) *Variable {
 	return &Variable{
 		Identifier:      name,
@@ -4059,34 +4818,39 @@ func init() {
 		panic(errors.NewUnreachableError())
 	}
 
+	functionType := &CheckedFunctionType{
+		FunctionType: &FunctionType{
+			Purity: FunctionPurityView,
+			Parameters: []*Parameter{
+				{
+					Label:          ArgumentLabelNotRequired,
+					Identifier:     "value",
+					TypeAnnotation: NewTypeAnnotation(&IntegerType{}),
+				},
+			},
+			ReturnTypeAnnotation: NewTypeAnnotation(addressType),
+		},
+		ArgumentExpressionsCheck: func(checker *Checker, argumentExpressions []ast.Expression, _ ast.Range) {
+			if len(argumentExpressions) < 1 {
+				return
+			}
+
+			intExpression, ok := argumentExpressions[0].(*ast.IntegerExpression)
+			if !ok {
+				return
+			}
+
+			CheckAddressLiteral(intExpression, checker.report)
+		},
+	}
+
+	functionType.Members = fromStringFunctionMembers(functionType, addressType)
+
 	BaseValueActivation.Set(
 		typeName,
 		baseFunctionVariable(
 			typeName,
-			&CheckedFunctionType{
-				FunctionType: &FunctionType{
-					Parameters: []*Parameter{
-						{
-							Label:          ArgumentLabelNotRequired,
-							Identifier:     "value",
-							TypeAnnotation: NewTypeAnnotation(&IntegerType{}),
-						},
-					},
-					ReturnTypeAnnotation: NewTypeAnnotation(addressType),
-				},
-				ArgumentExpressionsCheck: func(checker *Checker, argumentExpressions []ast.Expression, _ ast.Range) {
-					if len(argumentExpressions) < 1 {
-						return
-					}
-
-					intExpression, ok := argumentExpressions[0].(*ast.IntegerExpression)
-					if !ok {
-						return
-					}
-
-					CheckAddressLiteral(intExpression, checker.report)
-				},
-			},
+			functionType,
 		),
 	)
 }
@@ -4240,6 +5004,7 @@ func init() {
 		baseFunctionVariable(
 			typeName,
 			&FunctionType{
+				Purity:               FunctionPurityView,
 				TypeParameters:       []*TypeParameter{{Name: "T"}},
 				ReturnTypeAnnotation: NewTypeAnnotation(MetaType),
 			},
@@ -4272,6 +5037,12 @@ type CompositeType struct {
 	nestedTypes           *StringTypeOrderedMap
 	ContainerType         Type
 	EnumRawType           Type
+	// EnumCases are the names of the cases of an enum, in declaration order
+	EnumCases []string
+	// Extensions are the extensions declared in the composite (contract)
+	Extensions []*ExtensionType
+	// typeParameters are the type parameters of a generic structure
+	typeParameters []*TypeParameter
 }
 
 func (t *CompositeType) ExplicitInterfaceConformanceSet() *InterfaceSet {
@@ -4466,6 +5237,28 @@ func (t *CompositeType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
 	return t
 }
 
+// TypeParameters returns the type parameters of a generic structure type.
+// Instantiating a generic composite type results in an `InstantiatedType`
+//
+func (t *CompositeType) TypeParameters() []*TypeParameter {
+	return t.typeParameters
+}
+
+func (t *CompositeType) Instantiate(typeArguments []Type, _ func(err error)) Type {
+	return &InstantiatedType{
+		Type:      t,
+		Arguments: typeArguments,
+	}
+}
+
+func (*CompositeType) BaseType() Type {
+	return nil
+}
+
+func (*CompositeType) TypeArguments() []Type {
+	return nil
+}
+
 func (*CompositeType) isContainerType() bool {
 	return true
 }
@@ -4508,11 +5301,299 @@ func (t *CompositeType) initializeMemberResolvers() {
 	})
 }
 
+// InstantiatedType is a generic structure type
+// instantiated with type arguments, e.g. `Box<Int>`.
+// The members of the instantiated type are the members of the generic type,
+// where the type parameters are replaced with the type arguments
+//
+type InstantiatedType struct {
+	Type                *CompositeType
+	Arguments           []Type
+	memberResolvers     map[string]MemberResolver
+	memberResolversOnce sync.Once
+}
+
+func (*InstantiatedType) IsType() {}
+
+func (t *InstantiatedType) string(typeFormatter func(Type) string) string {
+	var builder strings.Builder
+	builder.WriteString(typeFormatter(t.Type))
+	builder.WriteRune('<')
+	for i, argument := range t.Arguments {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(typeFormatter(argument))
+	}
+	builder.WriteRune('>')
+	return builder.String()
+}
+
+func (t *InstantiatedType) String() string {
+	return t.string(func(t Type) string {
+		return t.String()
+	})
+}
+
+func (t *InstantiatedType) QualifiedString() string {
+	return t.string(func(t Type) string {
+		return t.QualifiedString()
+	})
+}
+
+func (t *InstantiatedType) ID() TypeID {
+	return TypeID(t.string(func(t Type) string {
+		return string(t.ID())
+	}))
+}
+
+func (t *InstantiatedType) Equal(other Type) bool {
+	otherInstantiation, ok := other.(*InstantiatedType)
+	if !ok {
+		return false
+	}
+
+	if !t.Type.Equal(otherInstantiation.Type) ||
+		len(t.Arguments) != len(otherInstantiation.Arguments) {
+
+		return false
+	}
+
+	for i, argument := range t.Arguments {
+		if !argument.Equal(otherInstantiation.Arguments[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *InstantiatedType) IsResourceType() bool {
+	return t.Type.IsResourceType()
+}
+
+func (t *InstantiatedType) IsInvalidType() bool {
+	for _, argument := range t.Arguments {
+		if argument.IsInvalidType() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *InstantiatedType) IsStorable(results map[*Member]bool) bool {
+	if !t.Type.IsStorable(results) {
+		return false
+	}
+
+	for _, argument := range t.Arguments {
+		if !argument.IsStorable(results) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *InstantiatedType) IsExternallyReturnable(results map[*Member]bool) bool {
+	if !t.Type.IsExternallyReturnable(results) {
+		return false
+	}
+
+	for _, argument := range t.Arguments {
+		if !argument.IsExternallyReturnable(results) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (*InstantiatedType) IsEquatable() bool {
+	return false
+}
+
+func (t *InstantiatedType) TypeAnnotationState() TypeAnnotationState {
+	for _, argument := range t.Arguments {
+		argumentTypeAnnotationState := argument.TypeAnnotationState()
+		if argumentTypeAnnotationState != TypeAnnotationStateValid {
+			return argumentTypeAnnotationState
+		}
+	}
+
+	return TypeAnnotationStateValid
+}
+
+func (t *InstantiatedType) RewriteWithRestrictedTypes() (Type, bool) {
+	rewrittenArguments := make([]Type, len(t.Arguments))
+
+	anyRewritten := false
+	for i, argument := range t.Arguments {
+		rewrittenArgument, rewritten := argument.RewriteWithRestrictedTypes()
+		rewrittenArguments[i] = rewrittenArgument
+		anyRewritten = anyRewritten || rewritten
+	}
+
+	if !anyRewritten {
+		return t, false
+	}
+
+	return &InstantiatedType{
+		Type:      t.Type,
+		Arguments: rewrittenArguments,
+	}, true
+}
+
+func (t *InstantiatedType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) bool {
+	otherInstantiation, ok := other.(*InstantiatedType)
+	if !ok ||
+		!t.Type.Equal(otherInstantiation.Type) ||
+		len(t.Arguments) != len(otherInstantiation.Arguments) {
+
+		return false
+	}
+
+	result := false
+
+	for i, argument := range t.Arguments {
+		argumentUnified := argument.Unify(
+			otherInstantiation.Arguments[i],
+			typeParameters,
+			report,
+			outerRange,
+		)
+		result = result || argumentUnified
+	}
+
+	return result
+}
+
+func (t *InstantiatedType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	newArguments := make([]Type, len(t.Arguments))
+
+	for i, argument := range t.Arguments {
+		newArgument := argument.Resolve(typeArguments)
+		if newArgument == nil {
+			return nil
+		}
+		newArguments[i] = newArgument
+	}
+
+	return &InstantiatedType{
+		Type:      t.Type,
+		Arguments: newArguments,
+	}
+}
+
+func (t *InstantiatedType) TypeParameters() []*TypeParameter {
+	return t.Type.TypeParameters()
+}
+
+func (t *InstantiatedType) Instantiate(typeArguments []Type, report func(err error)) Type {
+	return t.Type.Instantiate(typeArguments, report)
+}
+
+func (t *InstantiatedType) BaseType() Type {
+	return t.Type
+}
+
+func (t *InstantiatedType) TypeArguments() []Type {
+	return t.Arguments
+}
+
+// TypeParameterTypes returns the type arguments of the instantiation,
+// bound to the type parameters of the generic type
+//
+func (t *InstantiatedType) TypeParameterTypes() *TypeParameterTypeOrderedMap {
+	typeArguments := NewTypeParameterTypeOrderedMap()
+
+	for i, typeParameter := range t.Type.TypeParameters() {
+		if i >= len(t.Arguments) {
+			break
+		}
+		typeArguments.Set(typeParameter, t.Arguments[i])
+	}
+
+	return typeArguments
+}
+
+func (t *InstantiatedType) GetMembers() map[string]MemberResolver {
+	t.initializeMemberResolvers()
+	return t.memberResolvers
+}
+
+func (t *InstantiatedType) initializeMemberResolvers() {
+	t.memberResolversOnce.Do(func() {
+		genericMemberResolvers := t.Type.GetMembers()
+
+		t.memberResolvers = make(map[string]MemberResolver, len(genericMemberResolvers))
+
+		for name, loopResolver := range genericMemberResolvers { //nolint:maprangecheck
+			// NOTE: don't capture loop variable
+			resolver := loopResolver
+
+			t.memberResolvers[name] = MemberResolver{
+				Kind: resolver.Kind,
+				Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+					member := resolver.Resolve(identifier, targetRange, report)
+					if member == nil {
+						return nil
+					}
+
+					return t.instantiateMember(member)
+				},
+			}
+		}
+	})
+}
+
+func (t *InstantiatedType) instantiateMember(member *Member) *Member {
+
+	typeArguments := t.TypeParameterTypes()
+
+	memberType := member.TypeAnnotation.Type
+
+	// The type parameters of generic functions are not instantiated,
+	// keep them as-is
+
+	if functionType, ok := memberType.(*FunctionType); ok {
+		for _, typeParameter := range functionType.TypeParameters {
+			typeArguments.Set(
+				typeParameter,
+				&GenericType{
+					TypeParameter: typeParameter,
+				},
+			)
+		}
+	}
+
+	instantiatedMemberType := memberType.Resolve(typeArguments)
+	if instantiatedMemberType == nil {
+		return member
+	}
+
+	instantiatedMember := *member
+	instantiatedMember.TypeAnnotation = &TypeAnnotation{
+		IsResource: member.TypeAnnotation.IsResource,
+		Type:       instantiatedMemberType,
+	}
+	return &instantiatedMember
+}
+
 // Member
 
 type Member struct {
-	ContainerType  Type
-	Access         ast.Access
+	ContainerType Type
+	Access        ast.Access
+	// Entitlements are the entitlements required to access the member
+	// through a reference, if the access is `ast.AccessEntitlements`
+	Entitlements   *EntitlementAccess
 	Identifier     ast.Identifier
 	TypeAnnotation *TypeAnnotation
 	// TODO: replace with dedicated MemberKind enum
@@ -4719,93 +5800,390 @@ func (t *InterfaceType) initializeMemberResolvers() {
 			}
 		})
 
-		t.memberResolvers = withBuiltinMembers(t, members)
-	})
+		t.memberResolvers = withBuiltinMembers(t, members)
+	})
+}
+
+func (t *InterfaceType) IsResourceType() bool {
+	return t.CompositeKind == common.CompositeKindResource
+}
+
+func (t *InterfaceType) IsInvalidType() bool {
+	return false
+}
+
+func (t *InterfaceType) IsStorable(results map[*Member]bool) bool {
+
+	// If this interface type has a member which is non-storable,
+	// then the interface type is not storable.
+
+	for pair := t.Members.Oldest(); pair != nil; pair = pair.Next() {
+		if !pair.Value.IsStorable(results) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *InterfaceType) IsExternallyReturnable(results map[*Member]bool) bool {
+
+	if t.CompositeKind != common.CompositeKindStructure {
+		return false
+	}
+
+	// If this interface type has a member which is not externally returnable,
+	// then the interface type is not externally returnable.
+
+	for pair := t.Members.Oldest(); pair != nil; pair = pair.Next() {
+		if !pair.Value.IsExternallyReturnable(results) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (*InterfaceType) IsEquatable() bool {
+	// TODO:
+	return false
+}
+
+func (*InterfaceType) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (t *InterfaceType) RewriteWithRestrictedTypes() (Type, bool) {
+	switch t.CompositeKind {
+	case common.CompositeKindResource:
+		return &RestrictedType{
+			Type:         AnyResourceType,
+			Restrictions: []*InterfaceType{t},
+		}, true
+
+	case common.CompositeKindStructure:
+		return &RestrictedType{
+			Type:         AnyStructType,
+			Restrictions: []*InterfaceType{t},
+		}, true
+
+	default:
+		return t, false
+	}
+}
+
+func (*InterfaceType) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
+	// TODO:
+	return false
+}
+
+func (t *InterfaceType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
+	return t
+}
+
+func (*InterfaceType) isContainerType() bool {
+	return true
+}
+
+func (t *InterfaceType) GetNestedTypes() *StringTypeOrderedMap {
+	return t.nestedTypes
+}
+
+// ExtensionType
+//
+// An extension adds functions and fields to an existing composite type or interface type,
+// the base type. The extension itself is not a type which values can have,
+// its members are members of the base type, wherever the extension is in effect.
+//
+type ExtensionType struct {
+	Location      common.Location
+	Identifier    string
+	BaseType      Type
+	Members       *StringMemberOrderedMap
+	Fields        []string
+	ContainerType Type
+}
+
+func (*ExtensionType) IsType() {}
+
+func (t *ExtensionType) String() string {
+	return t.Identifier
+}
+
+func (t *ExtensionType) QualifiedString() string {
+	return t.QualifiedIdentifier()
+}
+
+func (t *ExtensionType) GetContainerType() Type {
+	return t.ContainerType
+}
+
+func (t *ExtensionType) GetLocation() common.Location {
+	return t.Location
+}
+
+func (t *ExtensionType) QualifiedIdentifier() string {
+	return qualifiedIdentifier(t.Identifier, t.ContainerType)
+}
+
+func (t *ExtensionType) ID() TypeID {
+	return t.Location.TypeID(t.QualifiedIdentifier())
+}
+
+func (t *ExtensionType) Equal(other Type) bool {
+	otherExtension, ok := other.(*ExtensionType)
+	if !ok {
+		return false
+	}
+
+	return otherExtension.ID() == t.ID()
+}
+
+// GetMembers returns no members:
+// the members of the extension are members of the base type
+//
+func (*ExtensionType) GetMembers() map[string]MemberResolver {
+	return map[string]MemberResolver{}
+}
+
+func (*ExtensionType) IsResourceType() bool {
+	return false
+}
+
+func (*ExtensionType) IsInvalidType() bool {
+	return false
+}
+
+func (*ExtensionType) IsStorable(_ map[*Member]bool) bool {
+	return false
+}
+
+func (*ExtensionType) IsExternallyReturnable(_ map[*Member]bool) bool {
+	return false
+}
+
+func (*ExtensionType) IsEquatable() bool {
+	return false
+}
+
+func (*ExtensionType) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (t *ExtensionType) RewriteWithRestrictedTypes() (Type, bool) {
+	return t, false
+}
+
+func (*ExtensionType) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
+	return false
+}
+
+func (t *ExtensionType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
+	return t
+}
+
+// EntitlementType
+//
+// An entitlement is a named permission. Members of composites may require entitlements,
+// and references grant entitlements to the members of the referenced value.
+// The entitlement itself is not a type which values can have.
+//
+type EntitlementType struct {
+	Location      common.Location
+	Identifier    string
+	ContainerType Type
+}
+
+func (*EntitlementType) IsType() {}
+
+func (t *EntitlementType) String() string {
+	return t.Identifier
+}
+
+func (t *EntitlementType) QualifiedString() string {
+	return t.QualifiedIdentifier()
+}
+
+func (t *EntitlementType) GetContainerType() Type {
+	return t.ContainerType
+}
+
+func (t *EntitlementType) GetLocation() common.Location {
+	return t.Location
+}
+
+func (t *EntitlementType) QualifiedIdentifier() string {
+	return qualifiedIdentifier(t.Identifier, t.ContainerType)
+}
+
+func (t *EntitlementType) ID() TypeID {
+	return t.Location.TypeID(t.QualifiedIdentifier())
+}
+
+// Equal returns true if the other type is the same entitlement.
+//
+// NOTE: The type IDs are not compared, as the entitlements of reference types
+// are compared during subtyping checks, which are performed during initialization
+//
+func (t *EntitlementType) Equal(other Type) bool {
+	otherEntitlement, ok := other.(*EntitlementType)
+	if !ok {
+		return false
+	}
+
+	if otherEntitlement.Identifier != t.Identifier ||
+		!common.LocationsMatch(otherEntitlement.Location, t.Location) {
+
+		return false
+	}
+
+	if t.ContainerType == nil || otherEntitlement.ContainerType == nil {
+		return t.ContainerType == otherEntitlement.ContainerType
+	}
+
+	return t.ContainerType.Equal(otherEntitlement.ContainerType)
+}
+
+func (*EntitlementType) GetMembers() map[string]MemberResolver {
+	return map[string]MemberResolver{}
+}
+
+func (*EntitlementType) IsResourceType() bool {
+	return false
+}
+
+func (*EntitlementType) IsInvalidType() bool {
+	return false
+}
+
+func (*EntitlementType) IsStorable(_ map[*Member]bool) bool {
+	return false
 }
 
-func (t *InterfaceType) IsResourceType() bool {
-	return t.CompositeKind == common.CompositeKindResource
+func (*EntitlementType) IsExternallyReturnable(_ map[*Member]bool) bool {
+	return false
 }
 
-func (t *InterfaceType) IsInvalidType() bool {
+func (*EntitlementType) IsEquatable() bool {
 	return false
 }
 
-func (t *InterfaceType) IsStorable(results map[*Member]bool) bool {
+func (*EntitlementType) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
 
-	// If this interface type has a member which is non-storable,
-	// then the interface type is not storable.
+func (t *EntitlementType) RewriteWithRestrictedTypes() (Type, bool) {
+	return t, false
+}
 
-	for pair := t.Members.Oldest(); pair != nil; pair = pair.Next() {
-		if !pair.Value.IsStorable(results) {
-			return false
-		}
-	}
+func (*EntitlementType) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
+	return false
+}
 
-	return true
+func (t *EntitlementType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
+	return t
 }
 
-func (t *InterfaceType) IsExternallyReturnable(results map[*Member]bool) bool {
+// EntitlementAccess is the set of entitlements a member requires.
+//
+// If the set is a disjunction, any one of the entitlements is required,
+// otherwise all entitlements are required
+//
+type EntitlementAccess struct {
+	Entitlements  []*EntitlementType
+	IsDisjunction bool
+}
 
-	if t.CompositeKind != common.CompositeKindStructure {
-		return false
+func (a *EntitlementAccess) String() string {
+	separator := ", "
+	if a.IsDisjunction {
+		separator = " | "
 	}
 
-	// If this interface type has a member which is not externally returnable,
-	// then the interface type is not externally returnable.
+	return joinEntitlements(a.Entitlements, separator, false)
+}
 
-	for pair := t.Members.Oldest(); pair != nil; pair = pair.Next() {
-		if !pair.Value.IsExternallyReturnable(results) {
-			return false
-		}
+// Equal returns true if the given entitlement access requires the same entitlements.
+// The order of the entitlements is irrelevant
+//
+func (a *EntitlementAccess) Equal(other *EntitlementAccess) bool {
+	if a == nil || other == nil {
+		return a == other
 	}
 
-	return true
-}
+	if len(a.Entitlements) > 1 &&
+		a.IsDisjunction != other.IsDisjunction {
 
-func (*InterfaceType) IsEquatable() bool {
-	// TODO:
-	return false
-}
+		return false
+	}
 
-func (*InterfaceType) TypeAnnotationState() TypeAnnotationState {
-	return TypeAnnotationStateValid
+	return entitlementsEqual(a.Entitlements, other.Entitlements)
 }
 
-func (t *InterfaceType) RewriteWithRestrictedTypes() (Type, bool) {
-	switch t.CompositeKind {
-	case common.CompositeKindResource:
-		return &RestrictedType{
-			Type:         AnyResourceType,
-			Restrictions: []*InterfaceType{t},
-		}, true
+// PermittedBy returns true if the given reference type grants the required entitlements.
+// Authorized references grant all entitlements
+//
+func (a *EntitlementAccess) PermittedBy(referenceType *ReferenceType) bool {
+	if referenceType.Authorized {
+		return true
+	}
 
-	case common.CompositeKindStructure:
-		return &RestrictedType{
-			Type:         AnyStructType,
-			Restrictions: []*InterfaceType{t},
-		}, true
+	if a.IsDisjunction {
+		for _, entitlement := range a.Entitlements {
+			if referenceType.HasEntitlement(entitlement) {
+				return true
+			}
+		}
+		return false
+	}
 
-	default:
-		return t, false
+	for _, entitlement := range a.Entitlements {
+		if !referenceType.HasEntitlement(entitlement) {
+			return false
+		}
 	}
+	return true
 }
 
-func (*InterfaceType) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
-	// TODO:
-	return false
-}
+func joinEntitlements(entitlements []*EntitlementType, separator string, sorted bool) string {
+	var names []string
+	for _, entitlement := range entitlements {
+		if sorted {
+			names = append(names, string(entitlement.ID()))
+		} else {
+			names = append(names, entitlement.QualifiedString())
+		}
+	}
 
-func (t *InterfaceType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
-	return t
-}
+	if sorted {
+		sort.Strings(names)
+	}
 
-func (*InterfaceType) isContainerType() bool {
-	return true
+	return strings.Join(names, separator)
 }
 
-func (t *InterfaceType) GetNestedTypes() *StringTypeOrderedMap {
-	return t.nestedTypes
+// entitlementsEqual returns true if the given lists of entitlements contain
+// the same entitlements, regardless of their order
+//
+func entitlementsEqual(entitlements, otherEntitlements []*EntitlementType) bool {
+	if len(entitlements) != len(otherEntitlements) {
+		return false
+	}
+
+	for _, entitlement := range entitlements {
+		found := false
+		for _, otherEntitlement := range otherEntitlements {
+			if otherEntitlement.Equal(entitlement) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
 }
 
 // DictionaryType consists of the key and value type
@@ -4937,6 +6315,21 @@ Removes the value for the given key from the dictionary.
 Returns the value as an optional if the dictionary contained the key, or nil if the dictionary did not contain the key
 `
 
+const dictionaryTypeForEachKeyFunctionDocString = `
+Calls the given function with each key of the dictionary, in insertion order.
+
+Iteration stops when the function returns false
+`
+
+const dictionaryTypeFilterFunctionDocString = `
+Returns a new dictionary which contains the entries of the dictionary for which the given function returns true.
+
+The original dictionary is not modified
+`
+
+const DictionaryTypeForEachKeyFunctionName = "forEachKey"
+const DictionaryTypeFilterFunctionName = "filter"
+
 func (t *DictionaryType) GetMembers() map[string]MemberResolver {
 	t.initializeMemberResolvers()
 	return t.memberResolvers
@@ -4954,6 +6347,7 @@ func (t *DictionaryType) initializeMemberResolvers() {
 						t,
 						identifier,
 						&FunctionType{
+							Purity: FunctionPurityView,
 							Parameters: []*Parameter{
 								{
 									Label:          ArgumentLabelNotRequired,
@@ -5075,6 +6469,106 @@ func (t *DictionaryType) initializeMemberResolvers() {
 					)
 				},
 			},
+			DictionaryTypeForEachKeyFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+					if t.KeyType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindFunction,
+								Range:           targetRange,
+							},
+						)
+					}
+
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						&FunctionType{
+							Parameters: []*Parameter{
+								{
+									Label:      ArgumentLabelNotRequired,
+									Identifier: "f",
+									TypeAnnotation: NewTypeAnnotation(
+										&FunctionType{
+											Parameters: []*Parameter{
+												{
+													Label:          ArgumentLabelNotRequired,
+													Identifier:     "key",
+													TypeAnnotation: NewTypeAnnotation(t.KeyType),
+												},
+											},
+											ReturnTypeAnnotation: NewTypeAnnotation(
+												BoolType,
+											),
+										},
+									),
+								},
+							},
+							ReturnTypeAnnotation: NewTypeAnnotation(
+								VoidType,
+							),
+						},
+						dictionaryTypeForEachKeyFunctionDocString,
+					)
+				},
+			},
+			DictionaryTypeFilterFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+					// The entries are passed to the given function and copied into the result,
+					// so the function is invalid for dictionaries of resources
+
+					if t.KeyType.IsResourceType() || t.ValueType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindFunction,
+								Range:           targetRange,
+							},
+						)
+					}
+
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						&FunctionType{
+							Parameters: []*Parameter{
+								{
+									Label:      ArgumentLabelNotRequired,
+									Identifier: "f",
+									TypeAnnotation: NewTypeAnnotation(
+										&FunctionType{
+											Parameters: []*Parameter{
+												{
+													Label:          ArgumentLabelNotRequired,
+													Identifier:     "key",
+													TypeAnnotation: NewTypeAnnotation(t.KeyType),
+												},
+												{
+													Label:          ArgumentLabelNotRequired,
+													Identifier:     "value",
+													TypeAnnotation: NewTypeAnnotation(t.ValueType),
+												},
+											},
+											ReturnTypeAnnotation: NewTypeAnnotation(
+												BoolType,
+											),
+										},
+									),
+								},
+							},
+							ReturnTypeAnnotation: NewTypeAnnotation(
+								t,
+							),
+						},
+						dictionaryTypeFilterFunctionDocString,
+					)
+				},
+			},
 		})
 	})
 }
@@ -5134,21 +6628,33 @@ func (t *DictionaryType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Typ
 	}
 }
 
-// ReferenceType represents the reference to a value
+// ReferenceType represents the reference to a value.
+//
+// An authorized reference grants all entitlements and may be downcast.
+// An unauthorized reference only grants the given entitlements, if any
+//
 type ReferenceType struct {
-	Authorized bool
-	Type       Type
+	Authorized   bool
+	Entitlements []*EntitlementType
+	Type         Type
 }
 
 func (*ReferenceType) IsType() {}
 
-func (t *ReferenceType) string(typeFormatter func(Type) string) string {
+func (t *ReferenceType) string(
+	typeFormatter func(Type) string,
+	entitlementsFormatter func([]*EntitlementType) string,
+) string {
 	if t.Type == nil {
 		return "reference"
 	}
 	var builder strings.Builder
 	if t.Authorized {
 		builder.WriteString("auth ")
+	} else if len(t.Entitlements) > 0 {
+		builder.WriteString("auth(")
+		builder.WriteString(entitlementsFormatter(t.Entitlements))
+		builder.WriteString(") ")
 	}
 	builder.WriteRune('&')
 	builder.WriteString(typeFormatter(t.Type))
@@ -5156,22 +6662,44 @@ func (t *ReferenceType) string(typeFormatter func(Type) string) string {
 }
 
 func (t *ReferenceType) String() string {
-	return t.string(func(ty Type) string {
-		return ty.String()
-	})
+	return t.string(
+		func(ty Type) string {
+			return ty.String()
+		},
+		func(entitlements []*EntitlementType) string {
+			names := make([]string, len(entitlements))
+			for i, entitlement := range entitlements {
+				names[i] = entitlement.String()
+			}
+			return strings.Join(names, ", ")
+		},
+	)
 }
 
 func (t *ReferenceType) QualifiedString() string {
-	return t.string(func(ty Type) string {
-		return ty.QualifiedString()
-	})
+	return t.string(
+		func(ty Type) string {
+			return ty.QualifiedString()
+		},
+		func(entitlements []*EntitlementType) string {
+			return joinEntitlements(entitlements, ", ", false)
+		},
+	)
 }
 
+// ID returns the type ID of the reference type.
+// The entitlements are sorted, so the ID is independent of their order
+//
 func (t *ReferenceType) ID() TypeID {
 	return TypeID(
-		t.string(func(ty Type) string {
-			return string(ty.ID())
-		}),
+		t.string(
+			func(ty Type) string {
+				return string(ty.ID())
+			},
+			func(entitlements []*EntitlementType) string {
+				return joinEntitlements(entitlements, ",", true)
+			},
+		),
 	)
 }
 
@@ -5185,9 +6713,53 @@ func (t *ReferenceType) Equal(other Type) bool {
 		return false
 	}
 
+	if !entitlementsEqual(t.Entitlements, otherReference.Entitlements) {
+		return false
+	}
+
 	return t.Type.Equal(otherReference.Type)
 }
 
+// HasEntitlement returns true if the reference grants the given entitlement.
+// Authorized references grant all entitlements
+//
+func (t *ReferenceType) HasEntitlement(entitlement *EntitlementType) bool {
+	if t.Authorized {
+		return true
+	}
+
+	for _, grantedEntitlement := range t.Entitlements {
+		if grantedEntitlement.Equal(entitlement) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GrantsAuthorization returns true if the reference grants
+// at least the authorization of the other reference,
+// i.e. if it is authorized, or the other reference is unauthorized
+// and all entitlements of the other reference are granted
+//
+func (t *ReferenceType) GrantsAuthorization(other *ReferenceType) bool {
+	if t.Authorized {
+		return true
+	}
+
+	if other.Authorized {
+		return false
+	}
+
+	for _, entitlement := range other.Entitlements {
+		if !t.HasEntitlement(entitlement) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (t *ReferenceType) IsResourceType() bool {
 	return false
 }
@@ -5216,8 +6788,9 @@ func (t *ReferenceType) RewriteWithRestrictedTypes() (Type, bool) {
 	rewrittenType, rewritten := t.Type.RewriteWithRestrictedTypes()
 	if rewritten {
 		return &ReferenceType{
-			Authorized: t.Authorized,
-			Type:       rewrittenType,
+			Authorized:   t.Authorized,
+			Entitlements: t.Entitlements,
+			Type:         rewrittenType,
 		}, true
 	} else {
 		return t, false
@@ -5260,14 +6833,31 @@ func (t *ReferenceType) IndexingType() Type {
 	return referencedType.IndexingType()
 }
 
-func (*ReferenceType) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
-	// TODO:
-	return false
+func (t *ReferenceType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) bool {
+	otherReference, ok := other.(*ReferenceType)
+	if !ok {
+		return false
+	}
+
+	return t.Type.Unify(otherReference.Type, typeParameters, report, outerRange)
 }
 
-func (t *ReferenceType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
-	// TODO:
-	return t
+func (t *ReferenceType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	newInnerType := t.Type.Resolve(typeArguments)
+	if newInnerType == nil {
+		return nil
+	}
+
+	return &ReferenceType{
+		Authorized:   t.Authorized,
+		Entitlements: t.Entitlements,
+		Type:         newInnerType,
+	}
 }
 
 // AddressType represents the address type
@@ -5342,6 +6932,7 @@ func (t *AddressType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
 const AddressTypeToBytesFunctionName = `toBytes`
 
 var arrayTypeToBytesFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		&VariableSizedType{
 			Type: &UInt8Type{},
@@ -5383,6 +6974,16 @@ func IsSubType(subType Type, superType Type) bool {
 		return true
 	}
 
+	// A generic type `T` is a subtype of `U`
+	// if the type bound of `T` is a subtype of `U`
+
+	if genericSubType, ok := subType.(*GenericType); ok {
+		typeBound := genericSubType.TypeParameter.TypeBound
+		if typeBound != nil && IsSubType(typeBound, superType) {
+			return true
+		}
+	}
+
 	switch superType {
 	case AnyType:
 		return true
@@ -5444,7 +7045,8 @@ func IsSubType(subType Type, superType Type) bool {
 	case *FixedPointType:
 		switch subType.(type) {
 		case *FixedPointType, *SignedFixedPointType,
-			*Fix64Type, *UFix64Type:
+			*Fix64Type, *UFix64Type,
+			*Fix128Type, *UFix128Type:
 
 			return true
 
@@ -5454,7 +7056,7 @@ func IsSubType(subType Type, superType Type) bool {
 
 	case *SignedFixedPointType:
 		switch subType.(type) {
-		case *SignedNumberType, *Fix64Type:
+		case *SignedNumberType, *Fix64Type, *Fix128Type:
 
 			return true
 
@@ -5531,6 +7133,25 @@ func IsSubType(subType Type, superType Type) bool {
 			return false
 		}
 
+		// An unauthorized reference type with entitlements `auth(Es) &T`
+		// is only a subtype of a reference type with entitlements `auth(Fs) &U`,
+		// if `Es` is a superset of `Fs`.
+		//
+		// The holder of the reference may only drop entitlements.
+
+		for _, entitlement := range typedSuperType.Entitlements {
+			if !typedSubType.HasEntitlement(entitlement) {
+				return false
+			}
+		}
+
+		// References to the same type only differ in their entitlements,
+		// which were checked above
+
+		if typedSubType.Type.Equal(typedSuperType.Type) {
+			return true
+		}
+
 		switch typedInnerSuperType := typedSuperType.Type.(type) {
 		case *RestrictedType:
 
@@ -5680,6 +7301,15 @@ func IsSubType(subType Type, superType Type) bool {
 			return false
 		}
 
+		// View functions are subtypes of impure functions,
+		// but impure functions are not subtypes of view functions
+
+		if typedSuperType.Purity == FunctionPurityView &&
+			typedSubType.Purity != FunctionPurityView {
+
+			return false
+		}
+
 		if len(typedSubType.Parameters) != len(typedSuperType.Parameters) {
 			return false
 		}
@@ -5786,6 +7416,16 @@ func IsSubType(subType Type, superType Type) bool {
 				return IsSubType(typedSubType, typedSuperType.Type) &&
 					typedSuperType.RestrictionSet().
 						IsSubsetOf(typedSubType.ExplicitInterfaceConformanceSet())
+
+			case *InstantiatedType:
+				// An instantiation `T<Xs>` of a generic structure
+				// is a subtype of a restricted type `AnyStruct{Us}` / `Any{Us}`:
+				// if `T<Xs>` is a subtype of the restricted supertype,
+				// and `T` conforms to `Us`.
+
+				return IsSubType(typedSubType, typedSuperType.Type) &&
+					typedSuperType.RestrictionSet().
+						IsSubsetOf(typedSubType.Type.ExplicitInterfaceConformanceSet())
 			}
 
 		default:
@@ -6494,6 +8134,7 @@ func capabilityTypeBorrowFunctionType(borrowType Type) *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity:         FunctionPurityView,
 		TypeParameters: typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(
 			&OptionalType{
@@ -6514,6 +8155,7 @@ func capabilityTypeCheckFunctionType(borrowType Type) *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity:               FunctionPurityView,
 		TypeParameters:       typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
 	}
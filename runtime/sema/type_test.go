@@ -517,7 +517,7 @@ func TestBeforeType_Strings(t *testing.T) {
 
 	t.Parallel()
 
-	expected := "(<T: AnyStruct>(_ value: T): T)"
+	expected := "(view <T: AnyStruct>(_ value: T): T)"
 
 	assert.Equal(t,
 		expected,
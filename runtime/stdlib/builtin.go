@@ -33,6 +33,7 @@ import (
 var AssertFunction = NewStandardLibraryFunction(
 	"assert",
 	&sema.FunctionType{
+		Purity: sema.FunctionPurityView,
 		Parameters: []*sema.Parameter{
 			{
 				Label:          sema.ArgumentLabelNotRequired,
@@ -81,6 +82,7 @@ func (e PanicError) Error() string {
 var PanicFunction = NewStandardLibraryFunction(
 	"panic",
 	&sema.FunctionType{
+		Purity: sema.FunctionPurityView,
 		Parameters: []*sema.Parameter{
 			{
 				Label:          sema.ArgumentLabelNotRequired,
@@ -142,6 +144,7 @@ var HelperFunctions = StandardLibraryFunctions{
 var CreatePublicKeyFunction = NewStandardLibraryFunction(
 	sema.PublicKeyTypeName,
 	&sema.FunctionType{
+		Purity: sema.FunctionPurityView,
 		Parameters: []*sema.Parameter{
 			{
 				Label:          sema.PublicKeyPublicKeyField,
@@ -198,6 +201,7 @@ func cryptoAlgorithmEnumType(enumType *sema.CompositeType, enumCases []sema.Cryp
 
 	constructorType := &sema.SpecialFunctionType{
 		FunctionType: &sema.FunctionType{
+			Purity: sema.FunctionPurityView,
 			Parameters: []*sema.Parameter{
 				{
 					Identifier:     sema.EnumRawValueFieldName,
@@ -49,10 +49,17 @@ type CryptoSignatureVerifier interface {
 type CryptoHasher interface {
 	Hash(
 		data []byte,
+		tag string,
 		hashAlgorithm HashAlgorithm,
 	) ([]byte, error)
 }
 
+type CryptoBLSAggregator interface {
+	AggregateBLSSignatures(signatures [][]byte) ([]byte, error)
+	AggregateBLSPublicKeys(publicKeys [][]byte) ([]byte, error)
+	VerifyBLSProofOfPossession(publicKey []byte, proof []byte) (bool, error)
+}
+
 var CryptoChecker = func() *sema.Checker {
 
 	code := internal.MustAssetString("contracts/crypto.cdc")
@@ -64,11 +71,14 @@ var CryptoChecker = func() *sema.Checker {
 
 	location := common.IdentifierLocation("Crypto")
 
+	valueDeclarations := BuiltinFunctions.ToSemaValueDeclarations()
+	valueDeclarations = append(valueDeclarations, BuiltinValues.ToSemaValueDeclarations()...)
+
 	var checker *sema.Checker
 	checker, err = sema.NewChecker(
 		program,
 		location,
-		sema.WithPredeclaredValues(BuiltinFunctions.ToSemaValueDeclarations()),
+		sema.WithPredeclaredValues(valueDeclarations),
 		sema.WithPredeclaredTypes(BuiltinTypes.ToTypeDeclarations()),
 	)
 	if err != nil {
@@ -171,9 +181,15 @@ func newCryptoContractHashFunction(hasher CryptoHasher) interpreter.FunctionValu
 				panic(fmt.Errorf("hash: invalid data argument: %w", err))
 			}
 
-			hashAlgorithm := getHashAlgorithmFromValue(invocation.Arguments[1])
+			tagStringValue, ok := invocation.Arguments[1].(*interpreter.StringValue)
+			if !ok {
+				panic(errors.New("hash: invalid tag argument: not a string"))
+			}
+			tag := tagStringValue.Str
+
+			hashAlgorithm := getHashAlgorithmFromValue(invocation.Arguments[2])
 
-			digest, err := hasher.Hash(data, hashAlgorithm)
+			digest, err := hasher.Hash(data, tag, hashAlgorithm)
 			if err != nil {
 				panic(err)
 
@@ -204,11 +220,123 @@ func newCryptoContractHasher(hasher CryptoHasher) *interpreter.CompositeValue {
 	return result
 }
 
+func byteArraysArgument(value interpreter.Value) ([][]byte, error) {
+	arrayValue, ok := value.(*interpreter.ArrayValue)
+	if !ok {
+		return nil, errors.New("value is not an array")
+	}
+
+	result := make([][]byte, len(arrayValue.Values))
+	for i, element := range arrayValue.Values {
+		bytes, err := interpreter.ByteArrayValueToByteSlice(element)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = bytes
+	}
+
+	return result, nil
+}
+
+// optionalByteArrayResult returns nil for a nil result,
+// which signals that the aggregation failed
+//
+func optionalByteArrayResult(bytes []byte) interpreter.OptionalValue {
+	if bytes == nil {
+		return interpreter.NilValue{}
+	}
+	return interpreter.NewSomeValueOwningNonCopying(
+		interpreter.ByteSliceToByteArrayValue(bytes),
+	)
+}
+
+func newCryptoContractAggregateBLSSignaturesFunction(aggregator CryptoBLSAggregator) interpreter.FunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			signatures, err := byteArraysArgument(invocation.Arguments[0])
+			if err != nil {
+				panic(fmt.Errorf("aggregateSignatures: invalid signatures argument: %w", err))
+			}
+
+			aggregatedSignature, err := aggregator.AggregateBLSSignatures(signatures)
+			if err != nil {
+				panic(err)
+			}
+
+			return optionalByteArrayResult(aggregatedSignature)
+		},
+	)
+}
+
+func newCryptoContractAggregateBLSPublicKeysFunction(aggregator CryptoBLSAggregator) interpreter.FunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			publicKeys, err := byteArraysArgument(invocation.Arguments[0])
+			if err != nil {
+				panic(fmt.Errorf("aggregatePublicKeys: invalid public keys argument: %w", err))
+			}
+
+			aggregatedPublicKey, err := aggregator.AggregateBLSPublicKeys(publicKeys)
+			if err != nil {
+				panic(err)
+			}
+
+			return optionalByteArrayResult(aggregatedPublicKey)
+		},
+	)
+}
+
+func newCryptoContractVerifyBLSProofOfPossessionFunction(aggregator CryptoBLSAggregator) interpreter.FunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			publicKey, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[0])
+			if err != nil {
+				panic(fmt.Errorf("verifyProofOfPossession: invalid public key argument: %w", err))
+			}
+
+			proof, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[1])
+			if err != nil {
+				panic(fmt.Errorf("verifyProofOfPossession: invalid proof argument: %w", err))
+			}
+
+			isValid, err := aggregator.VerifyBLSProofOfPossession(publicKey, proof)
+			if err != nil {
+				panic(err)
+			}
+
+			return interpreter.BoolValue(isValid)
+		},
+	)
+}
+
+func newCryptoContractBLSAggregator(aggregator CryptoBLSAggregator) *interpreter.CompositeValue {
+	implIdentifier := CryptoChecker.Location.
+		QualifiedIdentifier(cryptoContractInitializerTypes[2].ID()) +
+		"Impl"
+
+	result := interpreter.NewCompositeValue(
+		CryptoChecker.Location,
+		implIdentifier,
+		common.CompositeKindStructure,
+		nil,
+		nil,
+	)
+
+	result.Functions = map[string]interpreter.FunctionValue{
+		"aggregateSignatures":     newCryptoContractAggregateBLSSignaturesFunction(aggregator),
+		"aggregatePublicKeys":     newCryptoContractAggregateBLSPublicKeysFunction(aggregator),
+		"verifyProofOfPossession": newCryptoContractVerifyBLSProofOfPossessionFunction(aggregator),
+	}
+
+	return result
+}
+
 func NewCryptoContract(
 	inter *interpreter.Interpreter,
 	constructor interpreter.FunctionValue,
 	signatureVerifier CryptoSignatureVerifier,
 	hasher CryptoHasher,
+	blsAggregator CryptoBLSAggregator,
 	invocationRange ast.Range,
 ) (
 	*interpreter.CompositeValue,
@@ -218,6 +346,7 @@ func NewCryptoContract(
 	var cryptoContractInitializerArguments = []interpreter.Value{
 		newCryptoContractSignatureVerifier(signatureVerifier),
 		newCryptoContractHasher(hasher),
+		newCryptoContractBLSAggregator(blsAggregator),
 	}
 
 	value, err := inter.InvokeFunctionValue(
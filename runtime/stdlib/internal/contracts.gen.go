@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// contracts/crypto.cdc (5.061kB)
+// contracts/crypto.cdc (7.627kB)
 
 package internal
 
@@ -70,7 +70,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _contractsCryptoCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x4d\x6f\xe3\x36\x13\xbe\xfb\x57\xcc\xbb\xa7\x04\xaf\xe1\xa4\x40\x51\x14\x06\xb4\xc1\xb6\xdd\xb6\x41\x5a\xa0\x48\x9a\xed\x21\x08\x76\x19\x6b\x64\x11\xd1\x52\x06\x49\x39\x51\x0d\xff\xf7\x82\xa4\xc4\x6f\x39\x4e\x91\xfa\x90\xc8\xe6\x33\x5f\x0f\x67\x86\x1c\xcd\x36\xdd\x03\x08\xc9\xbb\x95\x04\xca\x24\xf2\x8a\xac\x10\x6e\xe8\x9a\x11\xd9\x71\xfc\x84\x9c\x56\x14\x39\xc0\x6e\x36\x03\x00\x50\xf0\xaa\x63\xb0\x55\x0b\xfd\x89\xfe\x4d\x7d\xc4\x28\xb1\x84\xbb\xdb\x4b\x26\xbf\xbf\x9f\xdb\x35\x49\xd6\x4b\xb8\x91\x9c\xb2\xf5\x3c\x10\xc0\xf2\x27\x22\x49\x46\x62\xd3\x3d\x34\x74\x75\x85\x7d\x66\xcd\x5a\xfa\xd0\xac\x5b\x4e\x65\xfd\x75\xe9\xfc\xb5\xbf\x39\x7c\x4d\x44\xed\x41\x7f\xf5\xbf\x6a\xd0\xe9\x12\x7e\x68\xdb\x66\xb6\x9f\xe5\xc9\x50\x12\x19\x06\x94\x5e\x17\x7f\x99\x0f\x84\xbc\x60\x78\x10\x18\x6d\xaf\x5a\x26\x39\x59\x49\xf8\x91\xf7\x1b\xd9\x66\x4d\x7e\x8e\x6c\x4d\xda\x70\xea\x61\x67\x1d\xe2\x28\x3b\xce\x40\x60\x53\x2d\x6a\x1d\x98\xfe\x77\x62\x74\xaa\xbf\x81\x42\xfb\x78\xaa\x35\xec\x9d\x3f\x03\x4d\x57\xd8\xff\x46\x85\xfc\xc8\x24\xef\x3d\x33\x0a\xd1\xa0\x84\x47\xec\x2f\x59\x89\xcf\x4b\xb8\x64\x32\x59\xf5\xb6\xf9\x8f\xf1\x31\x01\xbd\xb8\x7f\x3e\xf8\x09\xe9\xba\x96\x4b\xb8\xfd\x99\x3e\x7f\xf7\x6d\xb2\x4c\xc5\x35\x6e\xdb\x47\x2c\x87\x2d\xb7\x00\xca\xa8\x74\x9b\xa9\x3e\x81\xe7\xf3\x60\x29\xe7\x76\x88\x38\xe4\x73\x88\x0c\x1d\x0e\xd7\x62\x6f\xc7\xdf\x4f\x3d\xa2\x75\x45\xa8\xcd\x1c\xfd\x85\xc2\xba\x9e\x82\xac\xe7\x50\xb8\x28\x52\x58\xe0\x3e\x14\x61\x38\x29\xdc\xc4\x00\xc5\x10\x4c\x0a\xb0\x81\x40\xe1\x82\xb2\xb0\xfd\xe1\xd4\x1a\x8b\x40\xaf\x72\xba\xd5\x1b\x89\x4c\x72\x8a\x62\x09\x77\x7e\x02\xde\x47\x1b\x9a\x25\x6a\x10\x85\x02\xee\xee\x3d\x1f\xec\xe3\xd9\xd9\x19\x7c\x28\x4b\x01\x04\x18\x3e\x29\x32\xe1\x89\xca\x1a\x64\x8d\xb0\xa6\x5b\x64\x71\x98\x63\x75\x92\xb2\x0c\x53\xe8\xf3\x7f\x98\x29\x2e\x19\x96\x71\x0d\x06\x52\x7e\x11\x42\x11\x30\xb0\x68\x90\xad\x65\x9d\xc0\x51\xeb\x29\x02\xb5\x61\x60\x10\xd4\xc7\xf8\x34\x4f\x30\x5e\xf8\x9b\x7c\xf8\x19\x0a\xea\x69\x0a\x7c\x1a\xcc\xff\x74\xdd\x2b\x9a\x8a\x34\x02\x03\xc0\xe9\x64\x3a\x2c\xc8\x66\x83\xac\x3c\xd1\xc1\x87\xb0\xa1\x65\xea\x95\xa9\x84\xb9\xd6\x18\xa1\x93\x44\x65\x0c\x91\x5e\xbe\x50\xcd\x0e\xd0\x0a\xa8\x04\x7c\xa6\x42\x8a\x45\x24\x6d\xaa\xe3\x11\x7b\x01\x84\x23\x90\xe6\x89\xf4\x62\xb0\x8c\xe5\x1c\x1e\x3a\xad\xb0\x87\x9a\x6c\x11\xbe\xd8\x20\xbf\x40\x45\xb1\x29\x41\xa0\x04\xd9\x82\xe4\x1d\x26\x79\xb9\x46\x79\x12\x74\xb3\x28\x65\x2e\xa2\x2a\xa1\x95\xcb\x98\xf7\xd9\x94\x89\x04\x3c\x92\x18\x6d\x82\xa5\xfd\x2c\x47\xa5\xaf\xf2\x6e\xb4\x35\x59\x8c\xbf\x13\xfe\x78\x88\x59\xe0\x86\x0b\xc3\x52\xd9\xa2\x00\xd6\x4a\x28\xb1\x41\x89\x40\xd3\x42\x35\xf8\x88\x93\xb7\x23\x21\x22\xc0\xff\xa6\xca\x6b\xd5\x71\x8e\x6c\xa8\xd6\xe2\x25\x2e\x20\xca\x53\x07\x79\x45\x85\xfa\x26\x17\x47\x95\x6b\x20\x71\x7c\xed\x06\x62\x47\x16\x72\x20\x73\x44\x55\x07\x39\x0e\x41\x51\x4f\x15\x25\xef\x50\x6d\xa8\xcb\x1b\x7b\x87\x34\xe5\xb6\x25\x0d\x2d\xa1\x6a\x79\x04\xc1\x52\xdf\x88\x92\x04\xa2\xe2\x93\x92\x08\x29\xb7\x3a\x6f\x50\xba\x73\xc9\xde\x4c\xef\xe7\x09\x3a\xba\xfe\xfa\x2d\x5d\x9d\xf7\x71\x2b\xdf\x12\x6e\x3c\xfd\x4b\x93\x24\xc6\xa3\x00\x0a\x38\x5f\x9c\xa7\x6d\x5f\x20\xb2\x2b\xbd\xd9\x74\xa5\x8e\xca\xdd\x25\x93\x46\xf3\x1e\x0a\xd8\x45\xa5\xa9\xa2\xb7\x21\x00\x65\x41\x3c\xb1\x2b\x86\x61\xf8\xc8\x84\x02\x8f\xa5\x69\xaa\x91\x0a\xe3\x65\x2a\x41\x2b\xa7\x74\xf1\xfa\xea\x02\xd7\x40\xd2\xce\x0e\x69\xb3\x89\x9d\xa4\xc2\xf3\xb2\x26\xa6\x4b\x90\x86\x23\x29\x7b\x78\x40\xb5\xe7\x88\x2c\xef\x76\x40\xe5\x5d\x1a\xc5\x3d\x5c\x5c\x18\xaf\xde\xce\xf1\x6b\x5c\xb5\xbc\x8c\xd8\x7d\x22\x62\xc2\xcd\x23\x7c\x2c\x4c\xf1\xe4\x8c\xfd\x82\xa6\xaf\x92\x95\xec\x48\xa3\x0c\xa6\xb0\xe1\x2e\x11\x37\xad\x8c\xa5\xa3\xb2\xc5\x6c\xc0\xd0\xbc\xb3\xb4\x3f\x62\xef\xdd\x1d\xdf\x3e\x23\xd0\xcf\xf8\x43\x69\xfb\x3f\x33\x8f\x2d\x44\x3c\x17\x2f\xe2\x39\x38\xd8\x11\x37\x13\x3b\x8e\xec\x53\xda\xe4\x60\x9c\x95\x07\x6b\x65\xfb\x95\x50\x76\x83\x1b\xc2\x89\xa4\x2d\xfb\x93\xac\x6f\x05\xf2\xbc\xa0\xdf\x50\xdc\x73\x1e\xeb\x35\x7a\x45\xb1\xfd\x7a\xa8\xd3\xc3\xc4\xe8\x1d\x2a\x48\x11\x79\x4d\xe1\xb9\xa1\x74\x4c\xcf\x18\x90\x19\x79\xc6\xcf\x6b\x77\xdf\xef\x9f\x50\x84\x5f\xff\xaf\x63\xc9\xcc\x30\xf9\x4b\x4c\x20\xfb\xbe\x80\x6f\x16\xe7\xc7\x8e\x34\xf6\x50\x78\xf5\xc4\x9c\xbe\x66\x99\x05\x18\x3d\xf6\x84\xa3\x6b\x46\xe6\xdf\x0f\x90\xae\x5e\x0a\xa7\x77\x22\xec\x71\x56\x9b\x48\xe3\xf1\x95\x50\x84\x4e\x4a\x6c\x09\xbb\xe4\x75\xd4\x3e\x94\x31\x2f\x32\x96\xb0\x33\xaf\x6a\x06\x07\x34\x17\xc7\xa9\x9b\x27\x2a\x4e\xfd\x23\x2f\x0c\xdd\xbe\x13\x2b\x52\x67\x43\x11\xa3\x74\x18\xa0\x91\xfb\xb7\x13\xb8\x64\x54\x52\xd2\xd0\xbf\x11\x56\x2d\x13\x92\x30\x29\x22\x8b\x13\xc4\x41\x01\xef\x3a\x81\xfc\xdd\xc0\xf6\x7e\xf6\x4f\x00\x00\x00\xff\xff\x8a\x2e\xad\xea\xc5\x13\x00\x00"
+var _contractsCryptoCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\xdd\x6f\xdb\x38\x12\x7f\xf7\x5f\x31\xcd\x53\x82\x73\x9d\x7e\x1c\x0e\x85\x01\xb5\x48\xee\x7a\xbb\x41\xb2\xd8\xa2\x4e\xdb\x87\x20\x48\x69\x6b\x2c\x71\xa3\x50\x06\x49\x27\x51\x0d\xff\xef\x0b\x8a\x12\xbf\xe5\xb8\x8b\x34\x09\x62\x49\xf3\xc1\x99\xdf\xcc\x70\x86\xf2\x68\xb5\x9e\x83\x90\x7c\xbd\x90\x40\x99\x44\xbe\x24\x0b\x84\x19\x2d\x18\x91\x6b\x8e\x5f\x91\xd3\x25\x45\x0e\xb0\x19\x8d\x00\x00\x14\xfb\x72\xcd\xe0\x5e\x11\x9a\xc3\xf6\x99\xfa\x13\xbd\xc4\x14\xae\xbe\x9c\x31\xf9\xee\x7a\x6c\x68\x92\x14\x53\x98\x49\x4e\x59\x61\x1f\x2a\x01\xcc\xff\x47\x24\x49\x48\xac\xd6\xf3\x8a\x2e\xce\xb1\x49\xd0\xcc\x4a\x27\x55\x51\x73\x2a\xcb\xbb\x29\xcc\xa2\x67\x96\xbf\x24\xa2\x74\x58\x7f\x77\x6f\x5b\xa6\xa3\x29\x9c\xd6\x75\x35\xda\x8e\xd2\x60\x28\x89\x04\x02\x4a\xaf\xf5\x3f\x4f\x3b\x92\x74\x9d\x3c\x61\x4d\xa7\x65\xd0\xa0\xd3\x8b\xd9\x49\x51\x70\x2c\x88\xac\x63\xbb\x48\x47\x42\x03\x8a\x38\xbc\xb1\xf1\x11\x53\xb8\xea\x16\xb8\xb6\x6b\x7d\x18\xd0\xf1\xa9\x0f\x84\xd2\x61\xa2\xb2\x97\x0e\x9d\x21\x9f\x78\x5d\x2f\xff\x5c\x7e\xaa\x85\x40\x21\x68\xcd\x0e\xf7\x0a\xf2\x4a\x89\x99\xe7\xa9\x30\x2d\x6a\x26\x39\x59\x48\xf8\x2f\x6f\x56\xb2\x4e\x46\xe7\x26\x08\xcb\x20\xf2\xd6\x09\xd8\x18\x1b\x38\xca\x35\x67\x20\xb0\x5a\x4e\x94\x3a\xe4\xed\xc7\xa1\xd6\xa9\xfe\x8f\x75\x7c\x0f\x0e\x3c\xcd\xe6\xf2\xa8\x55\xb5\xd5\x86\x1d\x1f\x1f\xc3\xe7\x56\xa3\x00\x59\x22\x28\x55\x50\x2f\xdb\xeb\x82\xde\x23\xeb\x34\xe6\xf5\x1d\xa1\xec\xa5\xc0\x15\xe1\x44\x62\x0e\xf3\xc6\xe1\x91\xa4\x98\x18\x75\x27\xc0\x6a\xf6\x12\xef\x56\xb2\x51\x86\x00\x15\xc0\x69\x51\xca\x97\x2b\x92\xe7\x98\xc3\x03\x95\x25\xfc\x40\x5e\xc3\xbc\x91\x28\x40\xd6\xf0\xf6\x4d\x77\x4d\x58\x0e\x2b\x8e\x4b\xfa\x88\xb9\x22\xa8\x25\x94\x01\x56\xfb\x25\x29\x04\x54\x35\x2b\x90\x83\x2c\x09\x73\x64\x39\x02\xc7\xbf\x70\x21\x31\x77\xf8\x4b\x54\x56\x14\x98\x6b\xdf\xa8\xe3\xa7\x2c\x89\x74\x52\x50\x5b\xe6\x79\x05\x84\xa3\xde\x54\x28\xe6\x40\x0a\x42\x99\x90\x51\x44\xbf\x51\x59\x5e\x92\x22\x0e\xac\x5b\x67\xbf\x28\xca\x92\x14\x7b\x86\xb9\x2f\x4e\x14\x8e\x8f\xa7\x17\x33\x17\x01\xca\x64\x0d\x04\x04\x65\x45\x85\x96\x30\x89\x72\x85\xd1\x0a\xa8\x4e\x93\x8a\x0a\xa9\x82\xac\x23\x5e\x73\x20\xac\xe9\x53\xc8\x55\xad\xb4\xdf\x93\x8a\xe6\xe9\x9a\x3e\xbd\x98\xfd\xd4\xd6\x30\x00\xd6\xbc\x12\x76\x17\x9a\xa4\x76\x1d\xab\x78\x7f\x88\xf4\xa6\x00\xb7\xd8\x84\x18\x59\xca\xfe\x20\x8d\x03\x94\xb4\x5a\x01\xac\x96\x40\xa0\xc5\xa8\x5d\xd5\x55\x6a\x94\x5f\x96\xa8\xf7\x21\xa1\x40\x5e\x99\x1d\x4c\xdd\x91\xaa\x52\x96\x08\xb8\x5b\x0b\x09\x73\x9b\xba\x46\x7a\x8e\xcb\xba\x4f\xe9\x86\xb2\x02\x88\x13\x82\xdc\x82\x6e\x6b\xc1\xa1\xde\x62\x33\x18\xbb\xe1\x2d\xd9\x50\xd4\xc6\x6e\x6e\xdc\xf8\x55\x28\xe1\xd6\xdf\xbf\x21\x83\xab\x6b\xc3\xb0\xac\xb9\xd5\x09\x94\x39\x0b\x38\x6a\xd4\x1f\x5d\x5a\xda\xc4\x78\x63\x6a\x0d\x5e\x64\x89\xae\x3c\x39\xbd\x98\xdd\x9c\x5e\xcc\x5e\xbf\xb9\x79\xfb\xee\x75\xa0\xd1\x49\x30\x46\x2b\x8f\xb4\xf5\xee\x94\x07\x13\xb2\x5a\x21\xcb\x0f\xad\x0d\xe6\xea\xc8\x30\x77\x09\xd7\x99\xab\x9c\xb7\x18\x9f\x63\x03\xd9\xce\x4c\x36\x08\x8a\x43\xb5\xe2\x51\x60\x6e\x67\xaa\xe1\xb2\x8d\xad\xff\x31\x16\x4d\xfd\x75\xc7\xa3\x80\x11\x44\x84\xd4\xf4\x49\xf4\x3c\x25\x49\x9f\x03\x30\xb7\xa3\xa8\x6e\x24\x5f\x63\x5f\x38\xba\x06\xdb\x84\x0f\xf2\x9d\x8a\xae\x54\x54\x76\x0c\x95\xab\x97\xaf\x6d\x35\x34\x2a\x59\xa3\xf6\xef\x80\x62\xa0\x1b\x07\xfd\xbe\x6b\xf5\x0e\xe0\xcf\x9f\x6d\x1d\x38\x4b\x52\x09\xdc\x01\x5e\x22\x41\x9e\x1c\x6c\x82\xd8\x5b\xc3\xcd\x95\x9f\x01\x9d\xf3\xed\x87\x21\x78\x5b\xa6\x33\x06\x9e\x63\x73\x41\x85\xfc\xc8\x24\x6f\x1c\x97\x14\x47\x57\xdd\x67\x2c\xc7\xc7\x29\x9c\x31\x19\x51\x53\xe0\x47\x4c\x4f\x0e\xcc\x2e\xf3\x03\xaa\x89\x63\x0a\x5f\xfe\x4f\x1f\xff\xf3\xef\x88\x4c\xc5\x67\xbc\xaf\x6f\x31\xef\x86\x37\xc3\x40\x19\x95\x3e\x62\x9e\xe5\x01\x40\x09\xb3\x7d\x8e\x5d\x36\xfb\x9c\xbe\xc1\x3e\x2d\xb4\xb6\x7f\x1e\x96\x7e\x9b\x14\xbd\xbd\x90\x19\xd3\x63\x26\x03\x38\x64\xd6\x8b\x98\xcd\x33\x1f\x32\xdf\x9d\x98\x5d\xfb\x00\x59\xe7\x4c\xcc\x60\x1c\x81\xcc\x3a\x65\xd8\xb6\xbb\x53\xab\x1f\xa5\xd5\xef\x8a\xd3\xfb\x36\x90\xc8\x24\xa7\xa8\x1a\x87\x9b\x80\xd7\x96\xb3\x0d\x68\x12\xa8\x4e\xd4\x6f\x34\xdd\xea\xfd\x86\x74\x92\xe7\x02\x08\x30\x7c\x50\x60\x86\xf3\x61\xe0\xa6\xe9\x8a\x79\xee\xa7\xd0\xcd\x2f\xcc\x14\x43\x3a\x9a\x86\x35\xe8\x49\xb9\x45\x08\x99\x87\xc0\xa4\x42\x56\xc8\x32\x62\x57\x64\xd5\x8c\x5c\xb5\xbe\x63\x7e\x7d\xf4\x57\xbe\xb9\x41\xa1\x98\xcb\x98\x2b\x80\xc0\xbb\x8d\xb9\x7b\x18\xf4\x67\x4c\x37\xf9\x35\x0d\x36\x53\xbf\x2f\x85\xe9\xd0\x37\xf0\xd6\xf9\xa3\xd4\xd6\xdc\x52\x86\x12\xc6\x74\x30\x3d\xd5\x01\x91\x4e\xbe\xd0\x16\x1d\xd5\xd8\xa8\x04\x7c\xa4\x42\x8a\x49\x20\xdd\x5a\xac\x24\xf5\x71\x86\x54\x0f\xa4\x11\xdd\xca\x98\x8f\x61\xbe\x6e\x15\x36\x50\x92\x7b\x84\xef\xc6\xc9\xef\xb0\xa4\x58\xe5\x20\x50\xb6\xc7\x26\xbe\xc6\x28\x2f\x0b\x94\x87\x36\x5a\x67\x4c\x06\x29\xe3\x4e\x64\x5d\x73\xeb\xd9\xe1\x7d\x32\x65\x02\x81\x44\x73\x4f\xc0\xe4\x70\xb9\x2a\xaf\xfa\xb5\x06\x8b\xf1\x0f\xc2\x6f\x77\x21\x0b\x5c\x6f\x28\x1a\xa5\xbc\x46\x3d\x50\xe7\x58\xa1\x44\xa0\x71\xa1\x6a\xfe\x00\x93\xe7\x03\x61\xc7\xa8\xa8\xaa\x71\xb1\xe6\x1c\x59\x57\xad\xd9\x53\x58\x84\x79\x6a\x59\x7e\xa2\x42\xdd\x25\x27\xfd\xe3\x9d\xe5\xea\x49\xec\x5f\xbb\x9e\xd8\x9e\x85\xec\xc9\xec\x51\xd5\x92\xaf\x87\x8a\x7a\xa8\x28\xa3\xb1\xd2\x8c\x6c\xba\xdc\x52\xe3\xa4\x62\xc1\xbc\x3d\xd8\x47\x09\x44\xc5\x57\x25\xe1\x43\x6e\x74\xce\x50\xda\xbe\x64\xc6\xc0\xeb\x71\xc4\x1d\xbc\x6f\x34\x74\x3b\x6f\x7a\x22\xf7\x84\xeb\xc1\xf7\x5b\x0b\x92\xe8\x5b\x01\x64\xf0\x6a\xf2\xca\xe7\x55\x89\x26\x10\xd9\x79\x1b\x6c\xba\x50\xad\x72\x73\xc6\xa4\xd6\xbc\x85\x0c\x36\x0e\x58\xfd\x51\xcb\xb8\xa0\x8e\x5a\xae\x3f\xa1\x29\xea\xf7\xf8\x18\x3e\x32\xa1\x98\xfb\xd2\x6c\xf7\x39\x33\x9e\xc7\x12\x74\x69\x95\x4e\x7e\xbe\xba\x06\xc7\xe4\x44\xf8\x53\x46\x52\xe1\x58\x59\x92\xee\xd8\x5d\x71\x24\x79\x03\x73\x54\x69\x81\xc8\xd2\x66\x7b\x50\x5e\xc5\x5e\x5c\xc3\x87\x0f\xda\xaa\xe7\x33\xfc\x33\x2e\x6a\x9e\x07\xe8\x3e\x10\x31\x60\xe6\x1e\x36\x66\x6d\x29\x24\x17\xfb\x4d\x35\x90\x12\x81\x2c\xe4\x9a\xb4\x2f\x13\x62\xb6\x6e\x96\x08\xc6\x88\xd4\x4a\xbb\x03\xd1\xf9\xa3\x03\xd0\x6d\xde\xb1\x84\x6e\x43\xce\xec\xf8\xfc\x19\xe1\xbc\xa7\xda\x9d\xb6\x2f\xf4\x5b\xdd\x89\x08\xbf\x88\x98\x84\x5f\x3c\xb8\x3f\x86\x7b\x6a\x2f\xad\x8a\x78\x93\x33\x6f\xe8\xbb\xd5\xf4\xbb\xd7\x99\x7e\xf5\x4a\x6b\x76\x49\x8a\x2f\x02\x79\x5a\xd0\xdd\x50\xec\x75\x9a\xd7\xd9\xe8\x6f\xdd\x73\xa0\xbd\x1a\x5e\xc3\x3f\xd3\x86\x0a\x62\x8e\xb4\x26\xbf\x6f\xdc\x62\xd0\x2d\x22\x99\x70\x92\xff\xa7\xd1\x77\xf7\x4f\xc8\xfc\xdb\x7f\xb5\xbe\x24\xce\x30\x81\x9e\x6e\x4d\x4f\xf6\x7d\x06\xaf\x27\xaf\xf6\x3d\xd2\x98\xa6\x00\x9b\xe8\x88\xba\xfb\xc4\x6c\xf0\xb5\x5d\xc3\xe3\x69\x8f\x3d\x9e\x8a\x71\x42\x26\x79\x2e\xda\xeb\x00\x69\x74\x41\x66\xf5\x0e\xb8\xdd\x9f\xd5\x06\xd2\xb8\x7f\x41\x1e\x70\x47\x25\x36\x85\xcd\x2c\x7c\xb6\xf5\x65\x54\xee\x20\x9f\xc2\x46\xbd\x5f\x8f\xa8\xde\xeb\x92\x29\x6c\xbc\xef\xab\x3a\x63\xfd\xf3\xff\x7e\x36\xd8\xcc\x0e\xd7\xb7\x94\x27\xd6\xee\x53\x7b\x94\x06\xb9\x5f\xca\x05\xbb\x7f\xe6\x8b\x68\x0b\xba\xa3\x7a\x48\xf4\x8c\x80\xcc\x37\xca\xae\x7d\x7c\x0c\x67\x8c\x4a\x4a\x2a\xfa\x03\x61\x51\x33\x21\x09\x93\x22\x30\x6e\x20\x9a\x90\xc1\xc1\x5a\x20\x3f\x18\x01\x00\x6c\x47\xdb\xd1\xdf\x03\x00\xa8\x03\x0c\xd7\xcb\x1d\x00\x00"
 
 func contractsCryptoCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -86,7 +86,7 @@ func contractsCryptoCdc() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "contracts/crypto.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x48, 0x30, 0x32, 0xb0, 0xd8, 0xf6, 0xa9, 0xf6, 0x16, 0x36, 0x70, 0xbc, 0xd7, 0x21, 0xbe, 0x18, 0x8e, 0x26, 0xd9, 0x26, 0xf9, 0x61, 0x97, 0xf2, 0x76, 0x18, 0xb1, 0x87, 0x5, 0x4b, 0x58, 0x7e}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe4, 0x83, 0xfa, 0xe4, 0x9e, 0xa1, 0xe6, 0xee, 0x20, 0x21, 0x53, 0x5a, 0x5a, 0x7a, 0x78, 0x93, 0x78, 0x5e, 0x11, 0x9f, 0x39, 0xc6, 0xd, 0x9f, 0x2b, 0xad, 0x4c, 0x99, 0x5e, 0xe9, 0x36, 0xd4}}
 	return a, nil
 }
 
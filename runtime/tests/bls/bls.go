@@ -0,0 +1,258 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package bls provides BLS signatures on the BLS12-381 curve
+// for use in test runtime interfaces, backed by the pure Go
+// implementation of the curve in github.com/kilic/bls12-381.
+//
+// Signatures are points in G1, public keys are points in G2,
+// both in the compressed encoding. Signatures and proofs of possession
+// use the ciphersuites BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_
+// and BLS_POP_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_, so they are
+// interoperable with other implementations of the proof of possession scheme.
+//
+package bls
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+	"golang.org/x/crypto/hkdf"
+)
+
+// PublicKeyLength is the length of an encoded public key
+//
+const PublicKeyLength = 96
+
+// SignatureLength is the length of an encoded signature
+//
+const SignatureLength = 48
+
+const signatureDomainSeparationTag = "BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_"
+const proofOfPossessionDomainSeparationTag = "BLS_POP_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_"
+const keyGenerationSalt = "BLS-SIG-KEYGEN-SALT-"
+
+// minimumKeyMaterialLength is the minimum length of the input key material
+// required by the KeyGen procedure
+//
+const minimumKeyMaterialLength = 32
+
+// ErrEmptyAggregation is returned when aggregating an empty list
+// of signatures or public keys
+//
+var ErrEmptyAggregation = errors.New("cannot aggregate an empty list")
+
+// ErrIdentityPublicKey is returned when a public key
+// or an aggregated public key is the identity
+//
+var ErrIdentityPublicKey = errors.New("public key is the identity")
+
+// ErrInvalidSignature is returned when a signature
+// is not a valid encoding of a point in G1
+//
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrInvalidPublicKey is returned when a public key
+// is not a valid encoding of a point in G2
+//
+var ErrInvalidPublicKey = errors.New("invalid public key")
+
+// PrivateKey is a BLS private key
+//
+type PrivateKey struct {
+	scalar *big.Int
+}
+
+// GeneratePrivateKey deterministically derives a private key from the given seed,
+// using the KeyGen procedure of the BLS signature draft.
+//
+// Seeds shorter than the required 32 bytes of key material are hashed with SHA-256 first.
+//
+func GeneratePrivateKey(seed []byte) (*PrivateKey, error) {
+	keyMaterial := seed
+	if len(keyMaterial) < minimumKeyMaterialLength {
+		hash := sha256.Sum256(seed)
+		keyMaterial = hash[:]
+	}
+
+	// KeyGen, as defined in draft-irtf-cfrg-bls-signature-05, section 2.3
+
+	const length = 48
+
+	groupOrder := bls12381.NewG1().Q()
+	salt := []byte(keyGenerationSalt)
+	input := append(append([]byte{}, keyMaterial...), 0)
+	info := []byte{0, length}
+
+	for {
+		saltHash := sha256.Sum256(salt)
+		salt = saltHash[:]
+
+		uniformBytes := make([]byte, length)
+		_, err := io.ReadFull(hkdf.New(sha256.New, input, salt, info), uniformBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		scalar := new(big.Int).SetBytes(uniformBytes)
+		scalar.Mod(scalar, groupOrder)
+		if scalar.Sign() != 0 {
+			return &PrivateKey{scalar: scalar}, nil
+		}
+	}
+}
+
+// PublicKey returns the encoded public key of the private key
+//
+func (k *PrivateKey) PublicKey() []byte {
+	g2 := bls12381.NewG2()
+	point := g2.MulScalarBig(g2.New(), g2.One(), k.scalar)
+	return g2.ToCompressed(point)
+}
+
+// Sign returns the encoded signature of the given tag and message
+//
+func (k *PrivateKey) Sign(tag string, message []byte) ([]byte, error) {
+	return k.sign(taggedMessage(tag, message), signatureDomainSeparationTag)
+}
+
+// ProofOfPossession returns the encoded proof of possession of the private key,
+// i.e. the signature of the public key
+//
+func (k *PrivateKey) ProofOfPossession() ([]byte, error) {
+	return k.sign(k.PublicKey(), proofOfPossessionDomainSeparationTag)
+}
+
+func (k *PrivateKey) sign(message []byte, domainSeparationTag string) ([]byte, error) {
+	g1 := bls12381.NewG1()
+	point, err := g1.HashToCurve(message, []byte(domainSeparationTag))
+	if err != nil {
+		return nil, err
+	}
+	return g1.ToCompressed(g1.MulScalarBig(point, point, k.scalar)), nil
+}
+
+func taggedMessage(tag string, message []byte) []byte {
+	result := make([]byte, 0, len(tag)+len(message))
+	result = append(result, tag...)
+	return append(result, message...)
+}
+
+func decodePublicKey(g2 *bls12381.G2, publicKey []byte) (*bls12381.PointG2, error) {
+	point, err := g2.FromCompressed(publicKey)
+	if err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+	if g2.IsZero(point) {
+		return nil, ErrIdentityPublicKey
+	}
+	return point, nil
+}
+
+func verify(publicKey []byte, signature []byte, message []byte, domainSeparationTag string) bool {
+	engine := bls12381.NewEngine()
+
+	publicKeyPoint, err := decodePublicKey(engine.G2, publicKey)
+	if err != nil {
+		return false
+	}
+
+	signaturePoint, err := engine.G1.FromCompressed(signature)
+	if err != nil {
+		return false
+	}
+
+	messagePoint, err := engine.G1.HashToCurve(message, []byte(domainSeparationTag))
+	if err != nil {
+		return false
+	}
+
+	// e(signature, g2) == e(H(message), publicKey)
+	return engine.
+		AddPair(signaturePoint, engine.G2.One()).
+		AddPairInv(messagePoint, publicKeyPoint).
+		Check()
+}
+
+// Verify returns true if the signature is a valid signature
+// of the given tag and message for the given public key.
+//
+// Invalid encodings result in false.
+//
+func Verify(publicKey []byte, signature []byte, tag string, message []byte) bool {
+	return verify(publicKey, signature, taggedMessage(tag, message), signatureDomainSeparationTag)
+}
+
+// VerifyProofOfPossession returns true if the proof is a valid proof of possession
+// of the private key of the given public key.
+//
+// Invalid encodings result in false.
+//
+func VerifyProofOfPossession(publicKey []byte, proof []byte) bool {
+	return verify(publicKey, proof, publicKey, proofOfPossessionDomainSeparationTag)
+}
+
+// AggregateSignatures returns the encoded sum of the given signatures
+//
+func AggregateSignatures(signatures [][]byte) ([]byte, error) {
+	if len(signatures) == 0 {
+		return nil, ErrEmptyAggregation
+	}
+
+	g1 := bls12381.NewG1()
+	result := g1.Zero()
+	for _, signature := range signatures {
+		point, err := g1.FromCompressed(signature)
+		if err != nil {
+			return nil, ErrInvalidSignature
+		}
+		g1.Add(result, result, point)
+	}
+
+	return g1.ToCompressed(result), nil
+}
+
+// AggregatePublicKeys returns the encoded sum of the given public keys.
+//
+// The aggregated public key of a set of signers verifies the aggregated signature
+// of the same message, provided the proofs of possession of all keys were verified.
+//
+func AggregatePublicKeys(publicKeys [][]byte) ([]byte, error) {
+	if len(publicKeys) == 0 {
+		return nil, ErrEmptyAggregation
+	}
+
+	g2 := bls12381.NewG2()
+	result := g2.Zero()
+	for _, publicKey := range publicKeys {
+		point, err := decodePublicKey(g2, publicKey)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(result, result, point)
+	}
+
+	if g2.IsZero(result) {
+		return nil, ErrIdentityPublicKey
+	}
+
+	return g2.ToCompressed(result), nil
+}
@@ -0,0 +1,230 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bls
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+func TestHashToG1(t *testing.T) {
+
+	t.Parallel()
+
+	// Test vectors from RFC 9380, appendix J.9.1,
+	// for the suite BLS12381G1_XMD:SHA-256_SSWU_RO_,
+	// which the signature ciphersuites are based on
+
+	const tag = "QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_"
+
+	type testCase struct {
+		message string
+		x       string
+		y       string
+	}
+
+	for _, testCase := range []testCase{
+		{
+			message: "",
+			x:       "052926add2207b76ca4fa57a8734416c8dc95e24501772c814278700eed6d1e4e8cf62d9c09db0fac349612b759e79a1",
+			y:       "08ba738453bfed09cb546dbb0783dbb3a5f1f566ed67bb6be0e8c67e2e81a4cc68ee29813bb7994998f3eae0c9c6a265",
+		},
+		{
+			message: "abc",
+			x:       "03567bc5ef9c690c2ab2ecdf6a96ef1c139cc0b2f284dca0a9a7943388a49a3aee664ba5379a7655d3c68900be2f6903",
+			y:       "0b9c15f3fe6e5cf4211f346271d7b01c8f3b28be689c8429c85b67af215533311f0b8dfaaa154fa6b88176c229f2885d",
+		},
+	} {
+		g1 := bls12381.NewG1()
+		point, err := g1.HashToCurve([]byte(testCase.message), []byte(tag))
+		require.NoError(t, err)
+
+		assert.Equal(t, testCase.x+testCase.y, hex.EncodeToString(g1.ToUncompressed(point)))
+	}
+}
+
+func TestSignatureKnownAnswer(t *testing.T) {
+
+	t.Parallel()
+
+	// The expected values were cross-checked against
+	// the independent implementation github.com/supranational/blst
+
+	privateKey, err := GeneratePrivateKey(make([]byte, 32))
+	require.NoError(t, err)
+
+	publicKey := privateKey.PublicKey()
+
+	signature, err := privateKey.Sign("tag", []byte("hello"))
+	require.NoError(t, err)
+
+	proof, err := privateKey.ProofOfPossession()
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"af4c2167b8ac0c6f1857543df352634c835fabed918f075dcd94681d9967bbce"+
+			"70dffcc6662926f4e4df6610d898e7fa076f5a62c2f465fb45820bd129d28569"+
+			"d9b3be01069b8702a8f9fd293b570831e7c68e1eba2caf11c63fd2b0edab0b7f",
+		hex.EncodeToString(publicKey),
+	)
+
+	assert.Equal(t,
+		"9925e27d4ce5c7ea72cc9d9828fc7a368a2c918a499b350a427ae3e75e1dcaae"+
+			"e548787974671c97998523b76d5299ad",
+		hex.EncodeToString(signature),
+	)
+
+	assert.Equal(t,
+		"936eb471916d5795f73bd96c97a9e2c0be8fa7f0123b52a0a0bca2dd26183087"+
+			"2f88331e88866eda2114a3daf8938b74",
+		hex.EncodeToString(proof),
+	)
+}
+
+func generatePrivateKeys(t *testing.T, count int) []*PrivateKey {
+	privateKeys := make([]*PrivateKey, count)
+	for i := range privateKeys {
+		privateKey, err := GeneratePrivateKey([]byte{byte(i)})
+		require.NoError(t, err)
+		privateKeys[i] = privateKey
+	}
+	return privateKeys
+}
+
+func TestSignAndVerify(t *testing.T) {
+
+	t.Parallel()
+
+	privateKeys := generatePrivateKeys(t, 2)
+
+	message := []byte("hello")
+
+	publicKey := privateKeys[0].PublicKey()
+	require.Len(t, publicKey, PublicKeyLength)
+
+	signature, err := privateKeys[0].Sign("tag", message)
+	require.NoError(t, err)
+	require.Len(t, signature, SignatureLength)
+
+	assert.True(t, Verify(publicKey, signature, "tag", message))
+
+	assert.False(t, Verify(publicKey, signature, "other", message))
+	assert.False(t, Verify(publicKey, signature, "tag", []byte("world")))
+	assert.False(t, Verify(privateKeys[1].PublicKey(), signature, "tag", message))
+	assert.False(t, Verify(publicKey, []byte{1, 2, 3}, "tag", message))
+	assert.False(t, Verify(identityPublicKey(), identitySignature(), "tag", message))
+}
+
+func TestProofOfPossession(t *testing.T) {
+
+	t.Parallel()
+
+	privateKeys := generatePrivateKeys(t, 2)
+
+	publicKey := privateKeys[0].PublicKey()
+	proof, err := privateKeys[0].ProofOfPossession()
+	require.NoError(t, err)
+
+	assert.True(t, VerifyProofOfPossession(publicKey, proof))
+
+	assert.False(t, VerifyProofOfPossession(privateKeys[1].PublicKey(), proof))
+
+	// A signature of the public key is not a proof of possession
+
+	signature, err := privateKeys[0].Sign("", publicKey)
+	require.NoError(t, err)
+
+	assert.False(t, VerifyProofOfPossession(publicKey, signature))
+}
+
+func TestAggregation(t *testing.T) {
+
+	t.Parallel()
+
+	privateKeys := generatePrivateKeys(t, 3)
+
+	message := []byte("hello")
+
+	var publicKeys [][]byte
+	var signatures [][]byte
+
+	for _, privateKey := range privateKeys {
+		publicKeys = append(publicKeys, privateKey.PublicKey())
+
+		signature, err := privateKey.Sign("tag", message)
+		require.NoError(t, err)
+
+		signatures = append(signatures, signature)
+	}
+
+	aggregatedSignature, err := AggregateSignatures(signatures)
+	require.NoError(t, err)
+
+	aggregatedPublicKey, err := AggregatePublicKeys(publicKeys)
+	require.NoError(t, err)
+
+	assert.True(t, Verify(aggregatedPublicKey, aggregatedSignature, "tag", message))
+
+	partialPublicKey, err := AggregatePublicKeys(publicKeys[:2])
+	require.NoError(t, err)
+
+	assert.False(t, Verify(partialPublicKey, aggregatedSignature, "tag", message))
+
+	_, err = AggregateSignatures(nil)
+	assert.Equal(t, ErrEmptyAggregation, err)
+
+	_, err = AggregatePublicKeys(nil)
+	assert.Equal(t, ErrEmptyAggregation, err)
+
+	_, err = AggregateSignatures([][]byte{{1, 2, 3}})
+	assert.Equal(t, ErrInvalidSignature, err)
+
+	_, err = AggregatePublicKeys([][]byte{{1, 2, 3}})
+	assert.Equal(t, ErrInvalidPublicKey, err)
+
+	_, err = AggregatePublicKeys([][]byte{identityPublicKey()})
+	assert.Equal(t, ErrIdentityPublicKey, err)
+
+	// Keys which cancel each other out cannot be aggregated.
+	// The sign of a compressed point is encoded in the third-most significant bit
+
+	negatedPublicKey := append([]byte{}, publicKeys[0]...)
+	negatedPublicKey[0] ^= 0x20
+
+	_, err = AggregatePublicKeys([][]byte{publicKeys[0], negatedPublicKey})
+	assert.Equal(t, ErrIdentityPublicKey, err)
+}
+
+// identityPublicKey returns the compressed encoding of the identity in G2
+//
+func identityPublicKey() []byte {
+	g2 := bls12381.NewG2()
+	return g2.ToCompressed(g2.Zero())
+}
+
+// identitySignature returns the compressed encoding of the identity in G1
+//
+func identitySignature() []byte {
+	g1 := bls12381.NewG1()
+	return g1.ToCompressed(g1.Zero())
+}
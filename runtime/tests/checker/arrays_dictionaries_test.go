@@ -103,7 +103,28 @@ func TestCheckInvalidDictionaryKeys(t *testing.T) {
 
 	errs := ExpectCheckerErrors(t, err, 1)
 
-	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	assert.IsType(t, &sema.InvalidDictionaryKeyTypeError{}, errs[0])
+}
+
+func TestCheckDictionaryCommonSuperType(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let z = {"a": 1, "b": nil, "c": Int8(3)}
+	`)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.DictionaryType{
+			KeyType: sema.StringType,
+			ValueType: &sema.OptionalType{
+				Type: &sema.SignedIntegerType{},
+			},
+		},
+		RequireGlobalValue(t, checker.Elaboration, "z"),
+	)
 }
 
 func TestCheckInvalidDictionaryValues(t *testing.T) {
@@ -111,12 +132,15 @@ func TestCheckInvalidDictionaryValues(t *testing.T) {
 	t.Parallel()
 
 	_, err := ParseAndCheck(t, `
-      let z = {"a": 1, "b": true}
+      resource R {}
+
+      let z <- {"a": 1, "b": <-create R()}
 	`)
 
-	errs := ExpectCheckerErrors(t, err, 1)
+	errs := ExpectCheckerErrors(t, err, 2)
 
 	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[1])
 }
 
 func TestCheckDictionaryIndexingString(t *testing.T) {
@@ -662,6 +686,165 @@ func TestCheckInvalidArrayContainsNotEquatable(t *testing.T) {
 	assert.IsType(t, &sema.NotEquatableTypeError{}, errs[0])
 }
 
+func TestCheckArrayFunctionalOperations(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let xs = [3, 1, 2]
+
+      let evens = xs.filter(fun (x: Int): Bool {
+          return x % 2 == 0
+      })
+
+      let strings = xs.map(fun (x: Int): String {
+          return x.toString()
+      })
+
+      let sum = xs.reduce(initial: 0, fun (acc: Int, x: Int): Int {
+          return acc + x
+      })
+
+      let index = xs.firstIndex(of: 2)
+
+      let reversed = xs.reverse()
+
+      let slice = xs.slice(from: 1, upTo: 3)
+
+      let fixed: [Int; 3] = [1, 2, 3]
+
+      let fixedStrings = fixed.map(fun (x: Int): String {
+          return x.toString()
+      })
+
+      let fixedReversed = fixed.reverse()
+
+      fun test() {
+          let ys = [3, 1, 2]
+          ys.sort(by: fun (a: Int, b: Int): Bool {
+              return a < b
+          })
+      }
+    `)
+
+	require.NoError(t, err)
+
+	for name, expectedType := range map[string]sema.Type{
+		"evens":         &sema.VariableSizedType{Type: &sema.IntType{}},
+		"strings":       &sema.VariableSizedType{Type: sema.StringType},
+		"sum":           &sema.IntType{},
+		"index":         &sema.OptionalType{Type: &sema.IntType{}},
+		"reversed":      &sema.VariableSizedType{Type: &sema.IntType{}},
+		"slice":         &sema.VariableSizedType{Type: &sema.IntType{}},
+		"fixedStrings":  &sema.ConstantSizedType{Type: sema.StringType, Size: 3},
+		"fixedReversed": &sema.ConstantSizedType{Type: &sema.IntType{}, Size: 3},
+	} {
+		assert.Equal(t,
+			expectedType,
+			RequireGlobalValue(t, checker.Elaboration, name),
+			name,
+		)
+	}
+}
+
+func TestCheckInvalidArrayFilterFunctionType(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let xs = [1, 2, 3]
+
+      let ys = xs.filter(fun (x: String): Bool {
+          return true
+      })
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidArrayReduceInitialType(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let xs = [1, 2, 3]
+
+      let sum = xs.reduce(initial: "", fun (acc: Int, x: Int): Int {
+          return acc + x
+      })
+    `)
+
+	// The type parameter is inferred from the initial value,
+	// so the function's parameter and return types mismatch
+
+	errs := ExpectCheckerErrors(t, err, 3)
+
+	assert.IsType(t, &sema.TypeParameterTypeMismatchError{}, errs[0])
+	assert.IsType(t, &sema.TypeParameterTypeMismatchError{}, errs[1])
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[2])
+}
+
+func TestCheckInvalidArrayFirstIndexNotEquatable(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let z = [[1], [2], [3]]
+      let index = z.firstIndex(of: [1])
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotEquatableTypeError{}, errs[0])
+}
+
+func TestCheckDictionaryFunctionalOperations(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let dict = {"a": 1, "b": 2}
+
+      let filtered = dict.filter(fun (key: String, value: Int): Bool {
+          return value > 1
+      })
+
+      fun test() {
+          dict.forEachKey(fun (key: String): Bool {
+              return key != "b"
+          })
+      }
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.DictionaryType{
+			KeyType:   sema.StringType,
+			ValueType: &sema.IntType{},
+		},
+		RequireGlobalValue(t, checker.Elaboration, "filtered"),
+	)
+}
+
+func TestCheckInvalidDictionaryForEachKeyFunctionType(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test() {
+          let dict = {"a": 1}
+          dict.forEachKey(fun (key: String) {})
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
 func TestCheckEmptyArray(t *testing.T) {
 
 	t.Parallel()
@@ -849,17 +1032,118 @@ func TestCheckInvalidDictionarySubtyping(t *testing.T) {
 	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
 }
 
+func TestCheckArrayElementsCommonSuperType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let z = [nil, 1]
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: &sema.OptionalType{
+					Type: &sema.IntType{},
+				},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "z"),
+		)
+
+		assert.Empty(t, checker.Hints())
+	})
+
+	t.Run("numbers", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let z = [1, UInt8(2), 3.0]
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: &sema.NumberType{},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "z"),
+		)
+	})
+
+	t.Run("common interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test(): Int
+          }
+
+          struct S1: I {
+              fun test(): Int { return 1 }
+          }
+
+          struct S2: I {
+              fun test(): Int { return 2 }
+          }
+
+          let z = [S1(), S2()]
+          let y = z[0].test()
+        `)
+
+		require.NoError(t, err)
+
+		zType := RequireGlobalValue(t, checker.Elaboration, "z")
+		require.IsType(t, &sema.VariableSizedType{}, zType)
+
+		elementType := zType.(*sema.VariableSizedType).Type
+		require.IsType(t, &sema.RestrictedType{}, elementType)
+		assert.Equal(t, "AnyStruct{I}", elementType.QualifiedString())
+	})
+
+	t.Run("unrelated types", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let z = [1, "a"]
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.AnyStructType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "z"),
+		)
+
+		hints := checker.Hints()
+		require.Len(t, hints, 1)
+		assert.IsType(t, &sema.TypeWideningHint{}, hints[0])
+	})
+}
+
 func TestCheckInvalidArrayElements(t *testing.T) {
 
 	t.Parallel()
 
 	_, err := ParseAndCheck(t, `
-      let z = [0, true]
+      resource R {}
+
+      let z <- [0, <-create R()]
 	`)
 
-	errs := ExpectCheckerErrors(t, err, 1)
+	errs := ExpectCheckerErrors(t, err, 2)
 
 	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[1])
 }
 
 func TestCheckConstantSizedArrayDeclaration(t *testing.T) {
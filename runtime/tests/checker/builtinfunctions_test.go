@@ -19,6 +19,7 @@
 package checker
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -113,3 +114,145 @@ func TestCheckToBigEndianBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckSaturatingArithmetic(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		for _, functionName := range []string{
+			sema.NumericTypeSaturatingAddFunctionName,
+			sema.NumericTypeSaturatingSubtractFunctionName,
+			sema.NumericTypeSaturatingMultiplyFunctionName,
+			sema.NumericTypeSaturatingDivideFunctionName,
+		} {
+
+			functionName := functionName
+
+			t.Run(fmt.Sprintf("%s.%s", ty, functionName), func(t *testing.T) {
+
+				t.Parallel()
+
+				checker, err := parseAndCheckWithTestValue(t,
+					fmt.Sprintf(
+						`
+                          let res = test.%s(test)
+                        `,
+						functionName,
+					),
+					ty,
+				)
+
+				switch ty.(type) {
+				case *sema.Int8Type, *sema.Int16Type, *sema.Int32Type, *sema.Int64Type,
+					*sema.Int128Type, *sema.Int256Type,
+					*sema.UInt8Type, *sema.UInt16Type, *sema.UInt32Type, *sema.UInt64Type,
+					*sema.UInt128Type, *sema.UInt256Type,
+					*sema.Fix64Type, *sema.UFix64Type, *sema.Fix128Type, *sema.UFix128Type:
+
+					require.NoError(t, err)
+
+					assert.Equal(t,
+						ty,
+						RequireGlobalValue(t, checker.Elaboration, "res"),
+					)
+
+				default:
+					// Unbounded integer types cannot overflow, word types wrap around,
+					// and abstract number types have no bounds
+
+					errs := ExpectCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+				}
+			})
+		}
+	}
+}
+
+func TestCheckInvalidSaturatingArithmeticArgumentType(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let a: UInt8 = 1
+      let b: UInt16 = 2
+      let c = a.saturatingAdd(b)
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckFromString(t *testing.T) {
+
+	t.Parallel()
+
+	for _, numberOrAddressType := range append(
+		sema.AllNumberTypes[:],
+		&sema.AddressType{},
+	) {
+
+		switch numberOrAddressType.(type) {
+		case *sema.NumberType, *sema.SignedNumberType,
+			*sema.IntegerType, *sema.SignedIntegerType,
+			*sema.FixedPointType, *sema.SignedFixedPointType:
+			continue
+		}
+
+		ty := numberOrAddressType
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let res = %s.fromString("42")
+                    `,
+					ty,
+				),
+			)
+
+			require.NoError(t, err)
+
+			resType := RequireGlobalValue(t, checker.Elaboration, "res")
+
+			assert.Equal(t,
+				&sema.OptionalType{Type: ty},
+				resType,
+			)
+		})
+	}
+
+	t.Run("invalid argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let res = Int.fromString(42)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("abstract number type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let res = Integer.fromString("42")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
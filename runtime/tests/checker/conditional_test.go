@@ -65,11 +65,66 @@ func TestCheckInvalidConditionalExpressionElse(t *testing.T) {
       }
 	`)
 
-	errs := ExpectCheckerErrors(t, err, 2)
+	errs := ExpectCheckerErrors(t, err, 1)
 
 	assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+}
+
+func TestCheckConditionalExpressionCommonSuperType(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let x = true ? 2 : false
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.AnyStructType,
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+
+	hints := checker.Hints()
+	require.Len(t, hints, 1)
+	assert.IsType(t, &sema.TypeWideningHint{}, hints[0])
+}
+
+func TestCheckConditionalExpressionCommonInterface(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      resource interface I {
+          fun test(): Int
+      }
+
+      resource R1: I {
+          fun test(): Int { return 1 }
+      }
+
+      resource R2: I {
+          fun test(): Int { return 2 }
+      }
+
+      fun test(): Int {
+          let r <- true ? <-create R1() : <-create R2()
+          let value = r.test()
+          destroy r
+          return value
+      }
+
+      let x = true ? nil : 1
+    `)
+
+	require.NoError(t, err)
 
-	assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	assert.Equal(t,
+		&sema.OptionalType{
+			Type: &sema.IntType{},
+		},
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
 }
 
 func TestCheckInvalidConditionalExpressionTypes(t *testing.T) {
@@ -77,27 +132,40 @@ func TestCheckInvalidConditionalExpressionTypes(t *testing.T) {
 	t.Parallel()
 
 	_, err := ParseAndCheck(t, `
-      fun test() {
-          let x = true ? 2 : false
+      resource R {}
+
+      fun test(r: @R) {
+          let x <- true ? 2 : <-r
+          destroy x
       }
 	`)
 
-	errs := ExpectCheckerErrors(t, err, 1)
+	errs := ExpectCheckerErrors(t, err, 4)
 
 	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[1])
+	assert.IsType(t, &sema.InvalidDestructionError{}, errs[2])
+	assert.IsType(t, &sema.ResourceLossError{}, errs[3])
 }
 
-// TODO: return common super type for conditional
-func TestCheckInvalidAnyConditional(t *testing.T) {
+func TestCheckAnyConditional(t *testing.T) {
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
+	checker, err := ParseAndCheck(t, `
       let x: AnyStruct = true
       let y = true ? 1 : x
     `)
 
-	errs := ExpectCheckerErrors(t, err, 1)
+	require.NoError(t, err)
 
-	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	assert.Equal(t,
+		sema.AnyStructType,
+		RequireGlobalValue(t, checker.Elaboration, "y"),
+	)
+
+	// The type of one branch is already `AnyStruct`,
+	// so no type information is lost
+
+	assert.Empty(t, checker.Hints())
 }
@@ -375,9 +375,10 @@ func TestCheckInvalidFunctionPostConditionWithFunction(t *testing.T) {
       }
     `)
 
-	errs := ExpectCheckerErrors(t, err, 1)
+	errs := ExpectCheckerErrors(t, err, 2)
 
 	assert.IsType(t, &sema.FunctionExpressionInConditionError{}, errs[0])
+	assert.IsType(t, &sema.PurityError{}, errs[1])
 }
 
 func TestCheckFunctionPostConditionWithMessageUsingStringLiteral(t *testing.T) {
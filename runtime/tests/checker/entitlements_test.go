@@ -0,0 +1,611 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckEntitlementDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("top-level", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          entitlement Withdraw
+        `)
+		require.NoError(t, err)
+
+		entitlementType := RequireGlobalType(t, checker.Elaboration, "Withdraw")
+		require.IsType(t, &sema.EntitlementType{}, entitlementType)
+		assert.Equal(t, sema.TypeID("S.test.Withdraw"), entitlementType.ID())
+	})
+
+	t.Run("nested in contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          contract C {
+              entitlement Withdraw
+
+              resource R {
+                  access(Withdraw) fun withdraw() {}
+              }
+          }
+
+          fun test(r: &C.R) {}
+
+          fun test2(r: auth(C.Withdraw) &C.R) {
+              r.withdraw()
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.Contains(t,
+			checker.Elaboration.EntitlementTypes,
+			sema.TypeID("S.test.C.Withdraw"),
+		)
+	})
+
+	t.Run("nested in resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              entitlement Withdraw
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidNestedDeclarationError{}, errs[0])
+	})
+
+	t.Run("used as type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          entitlement Withdraw
+
+          let x: Withdraw? = nil
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidEntitlementUsageError{}, errs[0])
+	})
+
+	t.Run("non-entitlement in access", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              access(Int) fun test() {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidNonEntitlementTypeError{}, errs[0])
+	})
+
+	t.Run("non-entitlement in reference type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          fun test(s: auth(S) &S) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidNonEntitlementTypeError{}, errs[0])
+	})
+}
+
+func TestCheckEntitlementAccess(t *testing.T) {
+
+	t.Parallel()
+
+	const vault = `
+      entitlement Withdraw
+      entitlement Deposit
+
+      resource Vault {
+          access(Withdraw) var balance: Int
+
+          init() {
+              self.balance = 0
+          }
+
+          access(Withdraw) fun withdraw() {}
+
+          access(Deposit) fun deposit() {}
+
+          access(Withdraw | Deposit) fun either() {}
+
+          access(Withdraw, Deposit) fun both() {}
+
+          pub fun getBalance(): Int {
+              return self.balance
+          }
+      }
+    `
+
+	test := func(referenceType string, call string, expectedError bool) {
+
+		t.Run(referenceType+" "+call, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				vault+`
+                  fun test(ref: `+referenceType+`) {
+                      `+call+`
+                  }
+                `,
+			)
+
+			if expectedError {
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.InvalidEntitlementAccessError{}, errs[0])
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	test("&Vault", "ref.getBalance()", false)
+	test("&Vault", "ref.withdraw()", true)
+	test("&Vault", "ref.balance", true)
+	test("&Vault", "ref.either()", true)
+	test("&Vault", "ref.both()", true)
+
+	test("auth(Withdraw) &Vault", "ref.withdraw()", false)
+	test("auth(Withdraw) &Vault", "ref.balance", false)
+	test("auth(Withdraw) &Vault", "ref.deposit()", true)
+	test("auth(Withdraw) &Vault", "ref.either()", false)
+	test("auth(Withdraw) &Vault", "ref.both()", true)
+
+	test("auth(Deposit) &Vault", "ref.either()", false)
+
+	test("auth(Withdraw, Deposit) &Vault", "ref.both()", false)
+	test("auth(Deposit, Withdraw) &Vault", "ref.both()", false)
+
+	test("auth &Vault", "ref.withdraw()", false)
+	test("auth &Vault", "ref.both()", false)
+
+	test("&Vault?", "ref?.withdraw()", true)
+	test("auth(Withdraw) &Vault?", "ref?.withdraw()", false)
+
+	t.Run("owned value", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t,
+			vault+`
+              fun test(vault: @Vault) {
+                  vault.withdraw()
+                  vault.both()
+                  destroy vault
+              }
+            `,
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("nested value accessed through reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t,
+			vault+`
+              resource Holder {
+                  pub let vault: @Vault
+
+                  init() {
+                      self.vault <- create Vault()
+                  }
+
+                  destroy() {
+                      destroy self.vault
+                  }
+              }
+
+              fun test(holder: &Holder) {
+                  holder.vault.withdraw()
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidEntitlementAccessError{}, errs[0])
+	})
+
+	const holder = `
+      resource Holder {
+          pub let vaults: @[Vault]
+          pub let dict: @{String: Vault}
+          pub let maybe: @Vault?
+
+          init() {
+              self.vaults <- [<-create Vault()]
+              self.dict <- {"a": <-create Vault()}
+              self.maybe <- create Vault()
+          }
+
+          destroy() {
+              destroy self.vaults
+              destroy self.dict
+              destroy self.maybe
+          }
+      }
+    `
+
+	testNested := func(referenceType string, call string, expectedError bool) {
+
+		t.Run(referenceType+" "+call, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				vault+holder+`
+                  fun test(ref: `+referenceType+`) {
+                      `+call+`
+                  }
+                `,
+			)
+
+			if expectedError {
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.InvalidEntitlementAccessError{}, errs[0])
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	testNested("&Holder", "ref.vaults[0].withdraw()", true)
+	testNested("&Holder", `ref.dict["a"]?.withdraw()`, true)
+	testNested("&Holder", "ref.maybe?.withdraw()", true)
+	testNested("&Holder", "ref.vaults[0].getBalance()", false)
+
+	testNested("auth(Withdraw) &Holder", "ref.vaults[0].withdraw()", false)
+	testNested("auth(Withdraw) &Holder", `ref.dict["a"]?.withdraw()`, false)
+	testNested("auth(Withdraw) &Holder", "ref.maybe?.withdraw()", false)
+	testNested("auth(Withdraw) &Holder", "ref.vaults[0].deposit()", true)
+
+	t.Run("nested value accessed through reference and force unwrap", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          entitlement Withdraw
+
+          struct Box {
+              access(Withdraw) fun withdraw() {}
+          }
+
+          struct Holder {
+              pub let box: Box?
+
+              init() {
+                  self.box = Box()
+              }
+          }
+
+          fun test(holder: &Holder) {
+              holder.box!.withdraw()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidEntitlementAccessError{}, errs[0])
+	})
+}
+
+func TestCheckEntitlementReferenceCreation(t *testing.T) {
+
+	t.Parallel()
+
+	const declarations = `
+      entitlement E
+      entitlement F
+
+      struct S {}
+
+      struct Holder {
+          pub let s: S
+          pub let array: [S]
+          pub let dict: {String: S}
+
+          init() {
+              self.s = S()
+              self.array = [S()]
+              self.dict = {"a": S()}
+          }
+      }
+    `
+
+	test := func(baseType string, referencedExpression string, referenceType string, expectedError bool) {
+
+		t.Run(baseType+" "+referencedExpression+" as "+referenceType, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				declarations+`
+                  fun test(ref: `+baseType+`): `+referenceType+` {
+                      return &`+referencedExpression+` as `+referenceType+`
+                  }
+                `,
+			)
+
+			if expectedError {
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.InvalidReferenceEntitlementsError{}, errs[0])
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	test("&Holder", "ref.s", "&S", false)
+	test("&Holder", "ref.s", "auth(E) &S", true)
+	test("&Holder", "ref.s", "auth &S", true)
+	test("&Holder", "ref.array[0]", "auth(E) &S", true)
+	test("&Holder", `ref.dict["a"]!`, "auth(E) &S", true)
+
+	test("auth(E) &Holder", "ref.s", "auth(E) &S", false)
+	test("auth(E) &Holder", "ref.array[0]", "auth(E) &S", false)
+	test("auth(E) &Holder", "ref.s", "auth(F) &S", true)
+	test("auth(E) &Holder", "ref.s", "auth(E, F) &S", true)
+	test("auth(E) &Holder", "ref.s", "auth &S", true)
+
+	test("auth &Holder", "ref.s", "auth(E, F) &S", false)
+	test("auth &Holder", "ref.s", "auth &S", false)
+
+	t.Run("owned value", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t,
+			declarations+`
+              fun test(holder: Holder): auth(E) &S {
+                  return &holder.s as auth(E) &S
+              }
+            `,
+		)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckEntitlementReferenceSubtyping(t *testing.T) {
+
+	t.Parallel()
+
+	const declarations = `
+      entitlement E
+      entitlement F
+
+      struct S {}
+    `
+
+	test := func(subType string, superType string, expectedError bool) {
+
+		t.Run(subType+" <: "+superType, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				declarations+`
+                  fun test(ref: `+subType+`): `+superType+` {
+                      return ref
+                  }
+                `,
+			)
+
+			if expectedError {
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	test("auth(E) &S", "&S", false)
+	test("auth(E, F) &S", "auth(E) &S", false)
+	test("auth(E, F) &S", "auth(F, E) &S", false)
+	test("auth &S", "auth(E, F) &S", false)
+
+	test("&S", "auth(E) &S", true)
+	test("auth(E) &S", "auth(E, F) &S", true)
+	test("auth(E) &S", "auth(F) &S", true)
+	test("auth(E) &S", "auth &S", true)
+
+	t.Run("failable cast", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t,
+			declarations+`
+              fun test(ref: &S): auth(E) &S? {
+                  return ref as? auth(E) &S
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckEntitlementConformance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("same entitlements", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          entitlement E
+          entitlement F
+
+          resource interface I {
+              access(E, F) fun test()
+          }
+
+          resource R: I {
+              access(F, E) fun test() {}
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("public implementation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          entitlement E
+
+          resource interface I {
+              access(E) fun test()
+          }
+
+          resource R: I {
+              pub fun test() {}
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("different entitlements", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          entitlement E
+          entitlement F
+
+          resource interface I {
+              access(E) fun test()
+          }
+
+          resource R: I {
+              access(F) fun test() {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+
+	t.Run("entitlement implementation of public requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          entitlement E
+
+          resource interface I {
+              pub fun test()
+          }
+
+          resource R: I {
+              access(E) fun test() {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+
+	t.Run("restricted reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          entitlement E
+
+          resource interface I {
+              access(E) fun test()
+          }
+
+          resource R: I {
+              access(E) fun test() {}
+          }
+
+          fun test(ref: &R{I}) {
+              ref.test()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidEntitlementAccessError{}, errs[0])
+	})
+}
+
+func TestCheckEntitlementCapability(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      entitlement Withdraw
+
+      resource Vault {
+          access(Withdraw) fun withdraw() {}
+      }
+
+      fun test(cap: Capability<auth(Withdraw) &Vault>, cap2: Capability<&Vault>) {
+          cap.borrow()!.withdraw()
+          cap2.borrow()!.withdraw()
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.InvalidEntitlementAccessError{}, errs[0])
+}
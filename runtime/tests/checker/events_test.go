@@ -169,6 +169,28 @@ func TestCheckEventDeclaration(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          event E(color: Color, info: Info)
+
+          enum Color: UInt8 {
+              case red
+          }
+
+          struct Info {
+              let color: Color
+              init() {
+                  self.color = Color.red
+              }
+          }
+		`)
+
+		require.NoError(t, err)
+	})
+
 	t.Run("RedeclaredEvent", func(t *testing.T) {
 
 		t.Parallel()
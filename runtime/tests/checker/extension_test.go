@@ -0,0 +1,528 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckExtension(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          extension Describe for S {
+              pub var note: String?
+
+              pub fun describe(): String {
+                  return self.id.toString()
+              }
+          }
+
+          let s = S(id: 1)
+          let description = s.describe()
+          let note = s.note
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "description"),
+		)
+
+		assert.Equal(t,
+			&sema.OptionalType{Type: sema.StringType},
+			RequireGlobalValue(t, checker.Elaboration, "note"),
+		)
+
+		extensionType := RequireGlobalType(t, checker.Elaboration, "Describe")
+		require.IsType(t, &sema.ExtensionType{}, extensionType)
+		assert.Equal(t, sema.TypeID("S.test.Describe"), extensionType.ID())
+	})
+
+	t.Run("resource, reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          extension Tags for R {
+              pub var tag: String?
+
+              pub fun setTag(_ tag: String) {
+                  self.tag = tag
+              }
+          }
+
+          fun test(): String? {
+              let r <- create R()
+              r.setTag("rare")
+              let ref = &r as &R
+              let tag = ref.tag
+              destroy r
+              return tag
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface NFT {
+              pub let id: UInt64
+          }
+
+          resource R: NFT {
+              pub let id: UInt64
+
+              init() {
+                  self.id = 1
+              }
+          }
+
+          extension Describe for NFT {
+              pub fun describe(): String {
+                  return self.id.toString()
+              }
+          }
+
+          fun test(): [String] {
+              let r <- create R()
+              let restricted <- r as @R{NFT}
+              let ref = &restricted as &{NFT}
+              let descriptions = [restricted.describe(), ref.describe()]
+              destroy restricted
+              return descriptions
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+
+              struct S {}
+
+              pub extension E for S {
+                  pub fun get(): Int {
+                      return C.value
+                  }
+              }
+
+              let value: Int
+
+              init() {
+                  self.value = 1
+              }
+          }
+
+          let x = C.S().get()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("not in effect for other types", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {}
+
+          struct S {}
+
+          extension E for I {
+              pub fun test() {}
+          }
+
+          fun test() {
+              S().test()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidExtension(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("base type", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, baseType := range []string{"Int", "E", "C", "Ev"} {
+
+			t.Run(baseType, func(t *testing.T) {
+
+				_, err := ParseAndCheck(t, `
+                  enum E: UInt8 {}
+                  contract C {}
+                  event Ev()
+
+                  extension X for `+baseType+` {}
+                `)
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.InvalidExtensionBaseTypeError{}, errs[0])
+			})
+		}
+	})
+
+	t.Run("members", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          extension E for S {
+              init() {}
+
+              struct T {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.InvalidExtensionMemberError{}, errs[0])
+		assert.Equal(t,
+			common.DeclarationKindInitializer,
+			errs[0].(*sema.InvalidExtensionMemberError).DeclarationKind,
+		)
+
+		require.IsType(t, &sema.InvalidExtensionMemberError{}, errs[1])
+		assert.Equal(t,
+			common.DeclarationKindStructure,
+			errs[1].(*sema.InvalidExtensionMemberError).DeclarationKind,
+		)
+	})
+
+	t.Run("fields", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          struct S {}
+
+          extension E for S {
+              pub let a: Int?
+              pub var b: Int
+              pub var c: @R?
+              pub var d: ((): Int)?
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 4)
+
+		for i, name := range []string{"a", "b", "c", "d"} {
+			require.IsType(t, &sema.InvalidExtensionFieldError{}, errs[i])
+			assert.Equal(t, name, errs[i].(*sema.InvalidExtensionFieldError).Name)
+		}
+	})
+
+	t.Run("conflict with base type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let id: Int
+
+              init() {
+                  self.id = 1
+              }
+          }
+
+          extension E for S {
+              pub fun id(): Int {
+                  return 2
+              }
+
+              pub fun getType(): Int {
+                  return 3
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.ExtensionMemberConflictError{}, errs[0])
+		assert.Equal(t, "id", errs[0].(*sema.ExtensionMemberConflictError).Name)
+
+		require.IsType(t, &sema.ExtensionMemberConflictError{}, errs[1])
+		assert.Equal(t, "getType", errs[1].(*sema.ExtensionMemberConflictError).Name)
+	})
+
+	t.Run("conflict with other extension", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          extension E1 for S {
+              pub fun test() {}
+          }
+
+          extension E2 for S {
+              pub fun test() {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ExtensionMemberConflictError{}, errs[0])
+		conflictError := errs[0].(*sema.ExtensionMemberConflictError)
+		assert.Equal(t, "E2", conflictError.ExtensionType.Identifier)
+		assert.Equal(t,
+			"member `test` of extension `E2` conflicts with member of extension `E1`",
+			conflictError.Error(),
+		)
+	})
+
+	t.Run("use as type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          extension E for S {}
+
+          let e: E? = nil
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidExtensionUsageError{}, errs[0])
+	})
+
+	t.Run("nested in struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              extension E for S {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidNestedDeclarationError{}, errs[0])
+	})
+}
+
+func TestCheckExtensionAccess(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheckWithOptions(t,
+		`
+          pub struct S {}
+
+          pub extension E for S {
+              access(self) var secret: String?
+
+              pub fun reveal(): String? {
+                  return self.secret
+              }
+
+              pub fun setSecret(_ secret: String) {
+                  self.secret = secret
+              }
+          }
+
+          pub fun test() {
+              let s = S()
+              s.setSecret("42")
+              s.secret
+          }
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithAccessCheckMode(sema.AccessCheckModeStrict),
+			},
+		},
+	)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.InvalidAccessError{}, errs[0])
+
+	accessError := errs[0].(*sema.InvalidAccessError)
+	require.NotNil(t, accessError.Extension)
+	assert.Equal(t, "E", accessError.Extension.Identifier)
+	assert.Equal(t,
+		"cannot access `secret`: field of extension `E` has private access",
+		accessError.Error(),
+	)
+}
+
+func TestCheckExtensionImport(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          pub struct S {}
+
+          pub contract C {
+              pub extension Nested for S {
+                  pub fun nested(): Int {
+                      return 1
+                  }
+              }
+          }
+
+          pub extension TopLevel for S {
+              pub fun topLevel(): Int {
+                  return 2
+              }
+          }
+        `,
+		ParseAndCheckOptions{
+			Location: utils.ImportedLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	check := func(code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithImportHandler(
+						func(checker *sema.Checker, location common.Location) (sema.Import, error) {
+							return sema.ElaborationImport{
+								Elaboration: importedChecker.Elaboration,
+							}, nil
+						},
+					),
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("imported extension", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import S, TopLevel from "imported"
+
+          let x = S().topLevel()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("extension of imported contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import S, C from "imported"
+
+          let x = S().nested()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("not imported", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import S from "imported"
+
+          let x = S().topLevel()
+          let y = S().nested()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[1])
+	})
+}
+
+func TestCheckExtensionOrigins(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheckWithOptions(t,
+		`
+          struct S {}
+
+          extension E for S {
+              pub fun test() {}
+          }
+
+          let x = S().test()
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithOriginsAndOccurrencesEnabled(true),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	occurrence := checker.Occurrences.Find(sema.Position{Line: 8, Column: 22})
+	require.NotNil(t, occurrence)
+	require.NotNil(t, occurrence.Origin)
+
+	assert.Equal(t, common.DeclarationKindFunction, occurrence.Origin.DeclarationKind)
+	require.NotNil(t, occurrence.Origin.Extension)
+	assert.Equal(t, "E", occurrence.Origin.Extension.Identifier)
+}
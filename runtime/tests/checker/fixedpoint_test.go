@@ -644,7 +644,7 @@ func TestCheckFixedPointLiteralScales(t *testing.T) {
 			}
 
 			var i uint = 1
-			for ; i < scale*2; i++ {
+			for ; i < sema.Fix128Scale*2; i++ {
 
 				_, err := ParseAndCheck(t,
 					fmt.Sprintf(
@@ -659,6 +659,13 @@ func TestCheckFixedPointLiteralScales(t *testing.T) {
 
 				if i <= scale {
 					assert.NoError(t, err)
+				} else if i <= sema.Fix128Scale {
+					// Literals with more decimal places than the 64-bit types support
+					// are inferred to have a 128-bit fixed-point type
+
+					errs := ExpectCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[0])
 				} else {
 					errs := ExpectCheckerErrors(t, err, 2)
 
@@ -669,3 +676,51 @@ func TestCheckFixedPointLiteralScales(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckFixedPointLiteralInference(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let a = 1.5
+      let b = -1.5
+      let c = 0.123456789
+      let d = -0.123456789
+      let e = 1000000000000.0
+      let f = -1000000000000.0
+    `)
+
+	require.NoError(t, err)
+
+	for name, expectedType := range map[string]sema.Type{
+		"a": &sema.UFix64Type{},
+		"b": &sema.Fix64Type{},
+		"c": &sema.UFix128Type{},
+		"d": &sema.Fix128Type{},
+		"e": &sema.UFix128Type{},
+		"f": &sema.Fix128Type{},
+	} {
+		assert.Equal(t,
+			expectedType,
+			RequireGlobalValue(t, checker.Elaboration, name),
+			name,
+		)
+	}
+}
+
+func TestCheckFix128Conversions(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let a: UFix128 = 0.123456789012345678901234
+      let b: Fix128 = Fix128(a)
+      let c: UFix64 = UFix64(a)
+      let d: UFix128 = UFix128(c)
+      let e: Int = Int(b)
+      let f: Fix128 = Fix128(e)
+      let g: Fix128 = b * f / Fix128(2.0)
+    `)
+
+	require.NoError(t, err)
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/sema"
 )
@@ -58,6 +59,22 @@ func TestCheckForConstantSized(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCheckForInclusiveRange(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test() {
+          let r = InclusiveRange(UInt8(1), UInt8(10))
+          for i in r {
+              let x: UInt8 = i
+          }
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
 func TestCheckForEmpty(t *testing.T) {
 
 	t.Parallel()
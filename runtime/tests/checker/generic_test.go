@@ -0,0 +1,535 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckGenericFunctionDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun first<T>(_ values: [T]): T? {
+              if values.length == 0 {
+                  return nil
+              }
+              return values[0]
+          }
+
+          let x = first([1, 2])
+          let y = first(["a"])
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{Type: &sema.IntType{}},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+
+		assert.Equal(t,
+			&sema.OptionalType{Type: sema.StringType},
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("explicit type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun first<T>(_ values: [T]): T? {
+              if values.length == 0 {
+                  return nil
+              }
+              return values[0]
+          }
+
+          let x = first<String>([])
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{Type: sema.StringType},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun sum<T: Integer>(_ a: T, _ b: T): T {
+              return a + b
+          }
+
+          let x = sum(UInt8(1), UInt8(2))
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.UInt8Type{},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("resource type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun identity<T: @AnyResource>(_ value: @T): @T {
+              return <-value
+          }
+
+          fun test() {
+              let r <- identity(<-create R())
+              destroy r
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("nested generic invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun wrap<T>(_ value: T): [T] {
+              return [value]
+          }
+
+          fun wrapTwice<U>(_ value: U): [[U]] {
+              return wrap(wrap(value))
+          }
+
+          let x = wrapTwice(true)
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: &sema.VariableSizedType{
+					Type: sema.BoolType,
+				},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("outer type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun apply<T>(_ x: T, _ f: ((T): T)): T {
+              fun inner(_ y: T): T {
+                  return f(y)
+              }
+              return inner(x)
+          }
+
+          let x = apply(1, fun (_ x: Int): Int { return x + 1 })
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("composite function", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              fun pair<T>(_ value: T): [T] {
+                  return [value, value]
+              }
+          }
+
+          let x = S().pair("a")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{Type: sema.StringType},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+}
+
+func TestCheckInvalidGenericFunctionDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("mismatched type arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun pair<T>(_ a: T, _ b: T): [T] {
+              return [a, b]
+          }
+
+          let x = pair(1, "2")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeParameterTypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("outer type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T>(_ x: T) {
+              fun inner(_ y: T) {}
+              inner(1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeParameterTypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun sum<T: Integer>(_ a: T, _ b: T): T {
+              return a + b
+          }
+
+          let x = sum("1", "2")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource argument for unbounded type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun identity<T>(_ value: T): T {
+              return value
+          }
+
+          fun test() {
+              let r <- identity(<-create R())
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource type bound, duplicated value", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun duplicate<T: @AnyResource>(_ value: @T): @[T] {
+              return <-[<-value, <-value]
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+
+	t.Run("resource type bound, missing move annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun identity<T: @AnyResource>(_ value: T): @T {
+              return <-value
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
+	})
+
+	t.Run("duplicate type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T, T>() {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.RedeclarationError{}, errs[0])
+		assert.Equal(t,
+			common.DeclarationKindTypeParameter,
+			errs[0].(*sema.RedeclarationError).Kind,
+		)
+	})
+
+	t.Run("type parameter not in scope", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T>() {}
+
+          let x: T? = nil
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("interface function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test<T>()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.UnsupportedTypeParametersError{}, errs[0])
+		assert.Equal(t,
+			"function declarations in structure interface declarations cannot have type parameters",
+			errs[0].(*sema.UnsupportedTypeParametersError).Error(),
+		)
+	})
+}
+
+func TestCheckGenericStructure(t *testing.T) {
+
+	t.Parallel()
+
+	const boxDeclaration = `
+      struct Box<T> {
+          pub let value: T
+
+          init(value: T) {
+              self.value = value
+          }
+
+          pub fun get(): T {
+              return self.value
+          }
+
+          pub fun map<U>(_ f: ((T): U)): Box<U> {
+              return Box(value: f(self.value))
+          }
+      }
+    `
+
+	t.Run("inferred type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, boxDeclaration+`
+          let box = Box(value: 1)
+          let value = box.value
+          let got = box.get()
+        `)
+		require.NoError(t, err)
+
+		boxType := RequireGlobalValue(t, checker.Elaboration, "box")
+		require.IsType(t, &sema.InstantiatedType{}, boxType)
+		assert.Equal(t, "Box<Int>", boxType.QualifiedString())
+		assert.Equal(t, sema.TypeID("S.test.Box<Int>"), boxType.ID())
+
+		assert.Equal(t,
+			&sema.IntType{},
+			RequireGlobalValue(t, checker.Elaboration, "value"),
+		)
+
+		assert.Equal(t,
+			&sema.IntType{},
+			RequireGlobalValue(t, checker.Elaboration, "got"),
+		)
+	})
+
+	t.Run("explicit type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, boxDeclaration+`
+          let box: Box<Int?> = Box<Int?>(value: nil)
+          let value = box.value
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{Type: &sema.IntType{}},
+			RequireGlobalValue(t, checker.Elaboration, "value"),
+		)
+	})
+
+	t.Run("generic function", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, boxDeclaration+`
+          let box = Box(value: 1).map(fun (value: Int): String {
+              return value.toString()
+          })
+          let value = box.value
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "value"),
+		)
+	})
+
+	t.Run("parameter of generic function", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, boxDeclaration+`
+          fun unbox<T>(_ box: Box<T>): T {
+              return box.value
+          }
+
+          let value = unbox(Box(value: true))
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.BoolType,
+			RequireGlobalValue(t, checker.Elaboration, "value"),
+		)
+	})
+
+	t.Run("casting", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, boxDeclaration+`
+          let any: AnyStruct = Box(value: 1)
+          let box: Box<Int>? = any as? Box<Int>
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckInvalidGenericStructure(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("mismatched type arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              pub let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let box: Box<String> = Box(value: 1)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {}
+
+          let box: Box<Int, Int> = Box<Int>()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTypeArgumentCountError{}, errs[0])
+	})
+
+	t.Run("non-generic structure", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          let s: S<Int> = S()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnparameterizedTypeInstantiationError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R<T> {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.UnsupportedTypeParametersError{}, errs[0])
+		assert.Equal(t,
+			common.DeclarationKindResource,
+			errs[0].(*sema.UnsupportedTypeParametersError).DeclarationKind,
+		)
+	})
+}
@@ -0,0 +1,166 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckInclusiveRangeConstructor(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let r = InclusiveRange(1, 10)
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.InclusiveRangeType{
+				MemberType: &sema.IntType{},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "r"),
+		)
+	})
+
+	t.Run("step", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let r = InclusiveRange(UInt8(10), UInt8(0), step: UInt8(2))
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.InclusiveRangeType{
+				MemberType: &sema.UInt8Type{},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "r"),
+		)
+	})
+
+	t.Run("explicit type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let r = InclusiveRange<Integer>(Int64(1), Int64(10))
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.InclusiveRangeType{
+				MemberType: &sema.IntegerType{},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "r"),
+		)
+	})
+
+	t.Run("type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let r: InclusiveRange<Int> = InclusiveRange(1, 10)
+          let s: InclusiveRange = r
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid non-integer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let r = InclusiveRange(1.0, 10.0)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let r: InclusiveRange<String>? = nil
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid mismatched types", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let r = InclusiveRange(UInt8(1), Int16(10))
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeParameterTypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+}
+
+func TestCheckInclusiveRangeMembers(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let r = InclusiveRange(UInt16(1), UInt16(10), step: UInt16(3))
+      let start = r.start
+      let end = r.end
+      let step = r.step
+      let contains = r.contains(4)
+    `)
+
+	require.NoError(t, err)
+
+	for _, name := range []string{"start", "end", "step"} {
+		assert.Equal(t,
+			&sema.UInt16Type{},
+			RequireGlobalValue(t, checker.Elaboration, name),
+		)
+	}
+
+	assert.Equal(t,
+		sema.BoolType,
+		RequireGlobalValue(t, checker.Elaboration, "contains"),
+	)
+}
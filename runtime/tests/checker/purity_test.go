@@ -0,0 +1,638 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+func TestCheckViewFunction(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      view fun double(_ x: Int): Int {
+          let y = x * 2
+          return y
+      }
+
+      view fun test(): Int {
+          var x = 1
+          x = double(x)
+          let xs = [x]
+          xs[0] = 2
+          return xs[0] + x.toString().length
+      }
+    `)
+
+	require.NoError(t, err)
+
+	functionType := RequireGlobalValue(t, checker.Elaboration, "test").(*sema.FunctionType)
+	assert.Equal(t, sema.FunctionPurityView, functionType.Purity)
+}
+
+func TestCheckInvalidViewFunctionCallingImpureFunction(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun impure(): Int {
+          return 1
+      }
+
+      view fun test(): Int {
+          return impure()
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.PurityError{}, errs[0])
+	assert.Equal(t,
+		"cannot call non-view function",
+		errs[0].(*sema.PurityError).Operation,
+	)
+}
+
+func TestCheckInvalidViewFunctionAssignment(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var x = 1
+
+          view fun test() {
+              x = 2
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+		assert.Equal(t,
+			"cannot assign to non-local state",
+			errs[0].(*sema.PurityError).Operation,
+		)
+	})
+
+	t.Run("global array element", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs = [1]
+
+          view fun test() {
+              xs[0] = 2
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("parameter of outer function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              var y = x
+              let f = view fun () {
+                  y = 2
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              var x: Int
+
+              init() {
+                  self.x = 1
+              }
+
+              view fun test() {
+                  self.x = 2
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              var x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+
+          view fun test(_ s: &S) {
+              let ref = s
+              ref.x = 2
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("swap", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var x = 1
+
+          view fun test() {
+              var y = 2
+              x <-> y
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidViewFunctionSideEffects(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("emit", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          event Foo()
+
+          view fun test() {
+              emit Foo()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+		assert.Equal(t,
+			"cannot emit event",
+			errs[0].(*sema.PurityError).Operation,
+		)
+	})
+
+	t.Run("destroy", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          view fun test(r: @R) {
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+		assert.Equal(t,
+			"cannot destroy resource",
+			errs[0].(*sema.PurityError).Operation,
+		)
+	})
+
+	t.Run("move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          view fun test(r: @R): @R {
+              return <-r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+		assert.Equal(t,
+			"cannot move resource",
+			errs[0].(*sema.PurityError).Operation,
+		)
+	})
+
+	t.Run("create", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          view fun test() {
+              let r <- create R()
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+		assert.IsType(t, &sema.PurityError{}, errs[1])
+		assert.IsType(t, &sema.PurityError{}, errs[2])
+	})
+
+	t.Run("storage write", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(account: AuthAccount) {
+              account.save(1, to: /storage/one)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+}
+
+func TestCheckViewFunctionNestedImpureFunction(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      var x = 1
+
+      view fun test() {
+          fun impure() {
+              x = 2
+          }
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckConditionsPurity(t *testing.T) {
+
+	t.Parallel()
+
+	parseAndCheckWithViewConditions := func(t *testing.T, code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithViewConditionsEnabled(true),
+				},
+			},
+		)
+		return err
+	}
+
+	parseAndCheckWithoutViewConditions := func(t *testing.T, code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithViewConditionsEnabled(false),
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("enabled by default", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun isPositive(_ x: Int): Bool {
+              return x > 0
+          }
+
+          fun test(x: Int) {
+              pre {
+                  isPositive(x)
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("view function call", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheckWithViewConditions(t, `
+          view fun isPositive(_ x: Int): Bool {
+              return x > 0
+          }
+
+          fun test(x: Int): Int {
+              pre {
+                  isPositive(x)
+              }
+              post {
+                  isPositive(result)
+                  before(x) == x
+              }
+              return x
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("impure function call", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheckWithViewConditions(t, `
+          fun isPositive(_ x: Int): Bool {
+              return x > 0
+          }
+
+          fun test(x: Int) {
+              pre {
+                  isPositive(x)
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("impure function call in before", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheckWithViewConditions(t, `
+          fun impure(_ x: Int): Int {
+              return x
+          }
+
+          fun test(x: Int) {
+              post {
+                  before(impure(x)) == x
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("log", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              fun test(x: Int) {
+                  pre {
+                      [log(x)].length == 1
+                  }
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPredeclaredValues(
+						stdlib.StandardLibraryFunctions{
+							stdlib.LogFunction,
+						}.ToSemaValueDeclarations(),
+					),
+					sema.WithViewConditionsEnabled(true),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheckWithoutViewConditions(t, `
+          struct P {
+              let a: Int
+
+              init(a: Int) {
+                  self.a = a
+              }
+          }
+
+          struct S {
+              fun valid(): Bool {
+                  return true
+              }
+
+              fun test(x: Int) {
+                  pre {
+                      self.valid()
+                      P(a: x).a > 0
+                  }
+                  post {
+                      before(P(a: x).a) > 0
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("disabled, conditions of view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheckWithoutViewConditions(t, `
+          fun isPositive(_ x: Int): Bool {
+              return x > 0
+          }
+
+          view fun test(x: Int) {
+              pre {
+                  isPositive(x)
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+}
+
+func TestCheckViewFunctionInterfaceRequirement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("view implementation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              view fun test(): Int
+          }
+
+          struct S: I {
+              view fun test(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("impure implementation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              view fun test(): Int
+          }
+
+          struct S: I {
+              fun test(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+
+	t.Run("view implementation of impure requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test(): Int
+          }
+
+          struct S: I {
+              view fun test(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckViewFunctionTypeSubtyping(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("view to impure", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let f: ((Int): Int) = view fun (x: Int): Int {
+              return x
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("view to view", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun id(_ x: Int): Int {
+              return x
+          }
+
+          let f: (view (Int): Int) = id
+
+          view fun test(): Int {
+              return f(1)
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("impure to view", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let f: (view (Int): Int) = fun (x: Int): Int {
+              return x
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
@@ -3539,6 +3539,165 @@ func TestCheckInvalidResourceArrayConcat(t *testing.T) {
 	assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
 }
 
+func TestCheckInvalidResourceArrayFunctionalOperations(t *testing.T) {
+
+	t.Parallel()
+
+	for name, code := range map[string]string{
+		"filter": `
+          let ys <- xs.filter(isValid)
+          destroy ys
+        `,
+		"map": `
+          let ys <- xs.map(identity)
+          destroy ys
+        `,
+		"reduce": `
+          let count = xs.reduce(initial: 0, count)
+        `,
+		"reverse": `
+          let ys <- xs.reverse()
+          destroy ys
+        `,
+		"slice": `
+          let ys <- xs.slice(from: 0, upTo: 1)
+          destroy ys
+        `,
+		"sort": `
+          xs.sort(by: less)
+        `,
+	} {
+		t.Run(name, func(t *testing.T) {
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      resource X {}
+
+                      fun isValid(_ x: @X): Bool {
+                          destroy x
+                          return true
+                      }
+
+                      fun identity(_ x: @X): @X {
+                          return <-x
+                      }
+
+                      fun count(_ count: Int, _ x: @X): Int {
+                          destroy x
+                          return count + 1
+                      }
+
+                      fun less(_ a: @X, _ b: @X): Bool {
+                          destroy a
+                          destroy b
+                          return true
+                      }
+
+                      fun test() {
+                          let xs: @[X] <- [<-create X()]
+                          %s
+                          destroy xs
+                      }
+                    `,
+					code,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckInvalidResourceArrayFirstIndex(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource X {}
+
+      fun test() {
+          let xs: @[X] <- [<-create X()]
+          xs.firstIndex(of: <-create X())
+          destroy xs
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 2)
+
+	assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+	assert.IsType(t, &sema.NotEquatableTypeError{}, errs[1])
+}
+
+func TestCheckInvalidResourceCapturingInArrayFilter(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource X {}
+
+      fun test() {
+          let x <- create X()
+          let ys = [1, 2, 3].filter(fun (y: Int): Bool {
+              destroy x
+              return true
+          })
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.ResourceCapturingError{}, errs[0])
+}
+
+func TestCheckResourceDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource X {}
+
+      fun isValid(_ key: String): Bool {
+          return true
+      }
+
+      fun test() {
+          let xs: @{String: X} <- {"a": <-create X()}
+          xs.forEachKey(isValid)
+          destroy xs
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidResourceDictionaryFilter(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource X {}
+
+      fun isValid(_ key: String, _ value: @X): Bool {
+          destroy value
+          return true
+      }
+
+      fun test() {
+          let xs: @{String: X} <- {"a": <-create X()}
+          let ys <- xs.filter(isValid)
+          destroy xs
+          destroy ys
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidResourceDictionaryMemberError{}, errs[0])
+}
+
 func TestCheckResourceDictionaryRemove(t *testing.T) {
 
 	t.Parallel()
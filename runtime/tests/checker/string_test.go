@@ -272,3 +272,183 @@ func TestCheckInvalidStringIndexingAssignmentWithCharacterLiteral(t *testing.T)
 
 	assert.IsType(t, &sema.NotIndexingAssignableTypeError{}, errs[0])
 }
+
+func TestCheckStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let id: UInt64 = 1
+      let recipient: Address = 0x1
+      let amount: UFix64 = 1.5
+      let name = "Alice"
+      let initial: Character = "A"
+      let flag = true
+      let path = /storage/test
+
+      let x = "\(id) \(recipient) \(amount) \(name) \(initial) \(flag) \(path) \(1 + 2)"
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+}
+
+func TestCheckInvalidStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = "\([1, 2])"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateExpressionError{}, errs[0])
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let y: Int? = 1
+          let x = "\(y)"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateExpressionError{}, errs[0])
+	})
+
+	t.Run("structure", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          let x = "\(S())"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateExpressionError{}, errs[0])
+	})
+
+	t.Run("character target", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let y = "a"
+          let x: Character = "\(y)"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckStringFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let s = "Hello, World"
+
+      let parts = s.split(separator: ", ")
+      let joined = String.join(parts, separator: " ")
+      let contains = s.contains("World")
+      let index = s.index(of: "World")
+      let replaced = s.replaceAll(of: "World", with: "Cadence")
+      let lower = s.toLower()
+      let upper = s.toUpper()
+      let bytes = s.utf8
+      let decoded = String.fromUTF8(bytes)
+      let hex = String.encodeHex(bytes)
+      let empty = String()
+    `)
+
+	require.NoError(t, err)
+
+	byteArrayType := &sema.VariableSizedType{
+		Type: &sema.UInt8Type{},
+	}
+
+	for name, expectedType := range map[string]sema.Type{
+		"parts":    &sema.VariableSizedType{Type: sema.StringType},
+		"joined":   sema.StringType,
+		"contains": sema.BoolType,
+		"index":    &sema.OptionalType{Type: &sema.IntType{}},
+		"replaced": sema.StringType,
+		"lower":    sema.StringType,
+		"upper":    sema.StringType,
+		"bytes":    byteArrayType,
+		"decoded":  &sema.OptionalType{Type: sema.StringType},
+		"hex":      sema.StringType,
+		"empty":    sema.StringType,
+	} {
+		assert.Equal(t,
+			expectedType,
+			RequireGlobalValue(t, checker.Elaboration, name),
+			name,
+		)
+	}
+}
+
+func TestCheckInvalidStringFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("split without label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let parts = "a,b".split(",")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("join with non-string array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let joined = String.join([1, 2], separator: ",")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("utf8 assignment", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let s = "a"
+              s.utf8 = []
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidAssignmentAccessError{}, errs[0])
+		assert.IsType(t, &sema.AssignmentToConstantMemberError{}, errs[1])
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
 )
 
 func TestCheckSwitchStatementTest(t *testing.T) {
@@ -356,3 +357,168 @@ func TestCheckInvalidSwitchStatementMissingStatements(t *testing.T) {
 
 	assert.IsType(t, &sema.MissingSwitchCaseStatementsError{}, errs[0])
 }
+
+func TestCheckSwitchStatementEnumExhaustive(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      fun test(e: E): Int {
+          switch e {
+          case E.a:
+              return 1
+          case E.b:
+              return 2
+          }
+          return 0
+      }
+    `)
+
+	require.NoError(t, err)
+
+	assert.Empty(t, checker.Hints())
+	assert.Empty(t, checker.Elaboration.SwitchStatementMissingEnumCases)
+}
+
+func TestCheckSwitchStatementEnumMissingCases(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          case b
+          case c
+      }
+
+      fun test(e: E): Int {
+          switch e {
+          case E.b:
+              return 2
+          }
+          return 0
+      }
+    `)
+
+	require.NoError(t, err)
+
+	hints := checker.Hints()
+	require.Len(t, hints, 1)
+	require.IsType(t, &sema.MissingEnumCasesHint{}, hints[0])
+
+	hint := hints[0].(*sema.MissingEnumCasesHint)
+	assert.Equal(t, []string{"a", "c"}, hint.MissingCases)
+	assert.Equal(t,
+		"switch over `E` is not exhaustive, missing cases: `E.a`, `E.c`",
+		hint.Hint(),
+	)
+
+	require.Len(t, checker.Elaboration.SwitchStatementMissingEnumCases, 1)
+	for _, missingCases := range checker.Elaboration.SwitchStatementMissingEnumCases { //nolint:maprangecheck
+		assert.Equal(t, []string{"a", "c"}, missingCases)
+	}
+}
+
+func TestCheckSwitchStatementEnumMissingCasesDefault(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      fun test(e: E): Int {
+          switch e {
+          case E.a:
+              return 1
+          default:
+              return 0
+          }
+      }
+    `)
+
+	require.NoError(t, err)
+
+	assert.Empty(t, checker.Hints())
+	assert.Empty(t, checker.Elaboration.SwitchStatementMissingEnumCases)
+}
+
+func TestCheckSwitchStatementNativeEnumMissingCases(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheckWithOptions(t,
+		`
+          fun test(algo: HashAlgorithm): Int {
+              switch algo {
+              case HashAlgorithm.SHA2_256:
+                  return 1
+              case HashAlgorithm.SHA3_256:
+                  return 2
+              }
+              return 0
+          }
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithPredeclaredValues(stdlib.BuiltinValues.ToSemaValueDeclarations()),
+			},
+		},
+	)
+
+	require.NoError(t, err)
+
+	hints := checker.Hints()
+	require.Len(t, hints, 1)
+	require.IsType(t, &sema.MissingEnumCasesHint{}, hints[0])
+
+	assert.Equal(t,
+		[]string{"SHA2_384", "SHA3_384", "KECCAK_256"},
+		hints[0].(*sema.MissingEnumCasesHint).MissingCases,
+	)
+}
+
+func TestCheckSwitchStatementEnumDuplicateCase(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      fun test(e: E): Int {
+          switch e {
+          case E.a:
+              return 1
+          case E.b:
+              return 2
+          case E.a:
+              return 3
+          }
+          return 0
+      }
+    `)
+
+	require.NoError(t, err)
+
+	hints := checker.Hints()
+	require.Len(t, hints, 1)
+	require.IsType(t, &sema.DuplicateEnumSwitchCaseHint{}, hints[0])
+
+	hint := hints[0].(*sema.DuplicateEnumSwitchCaseHint)
+	assert.Equal(t, "a", hint.Case)
+	assert.Equal(t, 9, hint.PreviousRange.StartPos.Line)
+	assert.Equal(t,
+		"unreachable switch case: `E.a` is already handled by the case on line 9",
+		hint.Hint(),
+	)
+}
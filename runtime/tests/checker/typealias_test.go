@@ -0,0 +1,416 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("top-level", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias Balances = {Address: UFix64}
+
+          let balances: Balances = {}
+        `)
+		require.NoError(t, err)
+
+		balancesType := RequireGlobalValue(t, checker.Elaboration, "balances")
+
+		assert.Equal(t,
+			&sema.DictionaryType{
+				KeyType:   &sema.AddressType{},
+				ValueType: &sema.UFix64Type{},
+			},
+			balancesType,
+		)
+
+		// Aliases are transparent
+
+		assert.Equal(t, sema.TypeID("{Address:UFix64}"), balancesType.ID())
+	})
+
+	t.Run("composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {}
+
+          typealias T = S
+
+          let t: T = S()
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			RequireGlobalType(t, checker.Elaboration, "S"),
+			RequireGlobalValue(t, checker.Elaboration, "t"),
+		)
+		assert.Equal(t,
+			sema.TypeID("S.test.S"),
+			RequireGlobalValue(t, checker.Elaboration, "t").ID(),
+		)
+	})
+
+	t.Run("use before declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias Matrix = [Row]
+
+          typealias Row = [Int]
+
+          let matrix: Matrix = [[1]]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: &sema.VariableSizedType{
+					Type: &sema.IntType{},
+				},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "matrix"),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          typealias Rs = [R]
+
+          fun test(rs: @Rs) {
+              destroy rs
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource, missing annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          typealias Rs = [R]
+
+          fun test(rs: Rs) {
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
+	})
+}
+
+func TestCheckNestedTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+
+              typealias IDs = {UInt64: Bool}
+
+              pub struct S {
+                  pub let ids: IDs
+
+                  init(ids: IDs) {
+                      self.ids = ids
+                  }
+              }
+
+              pub var ids: IDs
+
+              init(ids: IDs) {
+                  self.ids = ids
+              }
+
+              pub fun test(): IDs {
+                  let ids: IDs = {}
+                  return ids
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+
+              typealias IDs = [UInt64]
+
+              pub var ids: IDs
+          }
+
+          struct S: I {
+              pub var ids: [UInt64]
+
+              init() {
+                  self.ids = []
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("not accessible outside", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              typealias IDs = [UInt64]
+          }
+
+          let ids: IDs = []
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("self-referential", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias T = [T]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+	})
+
+	t.Run("cyclic", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias A = B?
+
+          typealias B = {String: C}
+
+          typealias C = A
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+		assert.Equal(t, "A", errs[0].(*sema.CyclicTypeAliasError).Name)
+	})
+
+	t.Run("cyclic, nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              typealias A = [B]
+
+              typealias B = [A]
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+	})
+
+	t.Run("undeclared type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias T = [X]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("redeclaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias T = Int
+
+          typealias T = String
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("redeclaration of built-in type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias Int = String
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("redeclaration, nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              typealias T = Int
+
+              struct T {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+		assert.IsType(t, &sema.RedeclarationError{}, errs[1])
+	})
+
+	t.Run("private", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          priv typealias T = Int
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessModifierError{}, errs[0])
+	})
+}
+
+func TestCheckImportTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          pub struct S {}
+
+          pub typealias Balances = {Address: S}
+        `,
+		ParseAndCheckOptions{
+			Location: utils.ImportedLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	checker, err := ParseAndCheckWithOptions(t,
+		`
+          import S, Balances from "imported"
+
+          let balances: Balances = {}
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithImportHandler(
+					func(checker *sema.Checker, location common.Location) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.TypeID("{Address:S.imported.S}"),
+		RequireGlobalValue(t, checker.Elaboration, "balances").ID(),
+	)
+}
+
+func TestCheckOccurrencesTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheckWithOptions(t,
+		`
+      pub typealias Balances = {Address: UFix64}
+
+      pub let balances: Balances = {}
+    `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithOriginsAndOccurrencesEnabled(true),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	occurrence := checker.Occurrences.Find(sema.Position{Line: 4, Column: 24})
+	require.NotNil(t, occurrence)
+	require.NotNil(t, occurrence.Origin)
+
+	assert.Equal(t, common.DeclarationKindTypeAlias, occurrence.Origin.DeclarationKind)
+	assert.Equal(t, "{Address: UFix64}", occurrence.Origin.Type.QualifiedString())
+	require.NotNil(t, occurrence.Origin.StartPos)
+	assert.Equal(t, 2, occurrence.Origin.StartPos.Line)
+	assert.Equal(t, 20, occurrence.Origin.StartPos.Column)
+}
@@ -136,3 +136,146 @@ func TestCheckInvalidWhileContinueStatement(t *testing.T) {
 
 	assert.IsType(t, &sema.ControlStatementError{}, errs[0])
 }
+
+func TestCheckLabeledLoops(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("break and continue outer loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  inner: for x in [1, 2, 3] {
+                      if x == 1 {
+                          continue outer
+                      }
+                      if x == 2 {
+                          continue inner
+                      }
+                      break outer
+                  }
+              }
+          }
+        `)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("break outer loop in switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              outer: while true {
+                  switch x {
+                  case 1:
+                      break outer
+                  }
+              }
+          }
+        `)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("undeclared label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  break inner
+              }
+              while true {
+                  continue outer
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.UndeclaredControlStatementLabelError{}, errs[0])
+		assert.IsType(t, &sema.UndeclaredControlStatementLabelError{}, errs[1])
+	})
+
+	t.Run("label in switch outside of loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              switch x {
+              case 1:
+                  break outer
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UndeclaredControlStatementLabelError{}, errs[0])
+	})
+
+	t.Run("label of loop in enclosing function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  let f = fun () {
+                      while true {
+                          break outer
+                      }
+                  }
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UndeclaredControlStatementLabelError{}, errs[0])
+	})
+
+	t.Run("redeclared label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  outer: while true {
+                      break outer
+                  }
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclaredLoopLabelError{}, errs[0])
+	})
+
+	t.Run("same label on sibling loops", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              loop: while true {
+                  break loop
+              }
+              loop: while true {
+                  continue loop
+              }
+          }
+        `)
+
+		assert.NoError(t, err)
+	})
+}
@@ -54,6 +54,8 @@ func testAccount(t *testing.T, auth bool, code string) (*interpreter.Interpreter
 				return 0
 			},
 			returnZero,
+			returnZeroUFix64,
+			returnZeroUFix64,
 			panicFunction,
 			panicFunction,
 			interpreter.AuthAccountContractsValue{},
@@ -74,8 +76,13 @@ func testAccount(t *testing.T, auth bool, code string) (*interpreter.Interpreter
 				return 0
 			},
 			returnZero,
+			returnZeroUFix64,
+			returnZeroUFix64,
 			interpreter.NewPublicAccountKeysValue(
 				nil,
+				nil,
+				nil,
+				nil,
 			),
 		),
 		Kind: common.DeclarationKindConstant,
@@ -142,6 +149,10 @@ func returnZero() interpreter.UInt64Value {
 	return interpreter.UInt64Value(0)
 }
 
+func returnZeroUFix64() interpreter.UFix64Value {
+	return interpreter.UFix64Value(0)
+}
+
 func TestInterpretAuthAccount_save(t *testing.T) {
 
 	t.Parallel()
@@ -1406,3 +1417,47 @@ func TestCheckAccount_StorageFields(t *testing.T) {
 		}
 	}
 }
+
+func TestInterpretAccount_BalanceFields(t *testing.T) {
+	t.Parallel()
+
+	for accountType, auth := range map[string]bool{
+		"AuthAccount":   true,
+		"PublicAccount": false,
+	} {
+
+		for _, fieldName := range []string{
+			"balance",
+			"availableBalance",
+		} {
+
+			testName := fmt.Sprintf(
+				"%s.%s",
+				accountType,
+				fieldName,
+			)
+
+			t.Run(testName, func(t *testing.T) {
+
+				code := fmt.Sprintf(
+					`
+	                      fun test(): UFix64 {
+	                          return account.%s
+	                      }
+	                    `,
+					fieldName,
+				)
+				inter, _ := testAccount(
+					t,
+					auth,
+					code,
+				)
+
+				value, err := inter.Invoke("test")
+				require.NoError(t, err)
+
+				assert.Equal(t, interpreter.UFix64Value(0), value)
+			})
+		}
+	}
+}
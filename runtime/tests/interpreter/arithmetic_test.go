@@ -20,9 +20,11 @@ package interpreter_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
@@ -200,3 +202,133 @@ func TestInterpretModOperator(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretSaturatingArithmetic(t *testing.T) {
+
+	t.Parallel()
+
+	type bounds struct {
+		min string
+		max string
+	}
+
+	testBounds := map[string]bounds{
+		"Int8":    {"-128", "127"},
+		"Int16":   {"-32768", "32767"},
+		"Int32":   {"-2147483648", "2147483647"},
+		"Int64":   {"-9223372036854775808", "9223372036854775807"},
+		"Int128":  {"-170141183460469231731687303715884105728", "170141183460469231731687303715884105727"},
+		"Int256":  {"-57896044618658097711785492504343953926634992332820282019728792003956564819968", "57896044618658097711785492504343953926634992332820282019728792003956564819967"},
+		"UInt8":   {"0", "255"},
+		"UInt16":  {"0", "65535"},
+		"UInt32":  {"0", "4294967295"},
+		"UInt64":  {"0", "18446744073709551615"},
+		"UInt128": {"0", "340282366920938463463374607431768211455"},
+		"UInt256": {"0", "115792089237316195423570985008687907853269984665640564039457584007913129639935"},
+		"Fix64":   {"-92233720368.54775808", "92233720368.54775807"},
+		"UFix64":  {"0.0", "184467440737.09551615"},
+		"Fix128":  {"-170141183460469.231731687303715884105728", "170141183460469.231731687303715884105727"},
+		"UFix128": {"0.0", "340282366920938.463463374607431768211455"},
+	}
+
+	for _, ty := range sema.AllNumberTypes {
+		if !sema.SupportsSaturatingArithmetic(ty) {
+			continue
+		}
+
+		if _, ok := testBounds[ty.String()]; !ok {
+			panic(fmt.Sprintf("broken test: missing %s", ty))
+		}
+	}
+
+	for ty, bounds := range testBounds {
+
+		ty := ty
+		bounds := bounds
+
+		t.Run(ty, func(t *testing.T) {
+
+			t.Parallel()
+
+			suffix := ""
+			if strings.Contains(bounds.max, ".") {
+				suffix = ".0"
+			}
+
+			signed := strings.HasPrefix(bounds.min, "-")
+
+			code := fmt.Sprintf(
+				`
+                  let min: %[1]s = %[2]s
+                  let max: %[1]s = %[3]s
+                  let zero: %[1]s = 0%[4]s
+                  let one: %[1]s = 1%[4]s
+                  let two: %[1]s = 2%[4]s
+                  let three: %[1]s = 3%[4]s
+                  let four: %[1]s = 4%[4]s
+
+                  let addOverflow = max.saturatingAdd(one)
+                  let subtractUnderflow = min.saturatingSubtract(one)
+                  let multiplyOverflow = max.saturatingMultiply(two)
+
+                  let add = one.saturatingAdd(two)
+                  let subtract = three.saturatingSubtract(two)
+                  let multiply = two.saturatingMultiply(two)
+                  let divide = four.saturatingDivide(two)
+
+                  fun divideByZero(): %[1]s {
+                      return one.saturatingDivide(zero)
+                  }
+                `,
+				ty,
+				bounds.min,
+				bounds.max,
+				suffix,
+			)
+
+			if signed {
+				code += fmt.Sprintf(
+					`
+                      let minusOne: %[1]s = -1%[2]s
+                      let addUnderflow = min.saturatingAdd(minusOne)
+                      let subtractOverflow = max.saturatingSubtract(minusOne)
+                      let multiplyUnderflow = min.saturatingMultiply(two)
+                      let divideOverflow = min.saturatingDivide(minusOne)
+                    `,
+					ty,
+					suffix,
+				)
+			}
+
+			inter := parseCheckAndInterpret(t, code)
+
+			expected := map[string]string{
+				"addOverflow":       "max",
+				"subtractUnderflow": "min",
+				"multiplyOverflow":  "max",
+				"add":               "three",
+				"subtract":          "one",
+				"multiply":          "four",
+				"divide":            "two",
+			}
+
+			if signed {
+				expected["addUnderflow"] = "min"
+				expected["subtractOverflow"] = "max"
+				expected["multiplyUnderflow"] = "min"
+				expected["divideOverflow"] = "max"
+			}
+
+			for name, expectedName := range expected {
+				assert.Equal(t,
+					inter.Globals[expectedName].GetValue(),
+					inter.Globals[name].GetValue(),
+					name,
+				)
+			}
+
+			_, err := inter.Invoke("divideByZero")
+			require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+		})
+	}
+}
@@ -0,0 +1,342 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/tests/checker"
+)
+
+func intArrayValue(ints ...int64) *interpreter.ArrayValue {
+	values := make([]interpreter.Value, len(ints))
+	for i, value := range ints {
+		values[i] = interpreter.NewIntValueFromInt64(value)
+	}
+	return interpreter.NewArrayValueUnownedNonCopying(values...)
+}
+
+func TestInterpretArrayFilter(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3, 4, 5, 6]
+
+      let evens = xs.filter(fun (x: Int): Bool {
+          return x % 2 == 0
+      })
+
+      let none = xs.filter(fun (x: Int): Bool {
+          return false
+      })
+    `)
+
+	assert.Equal(t,
+		intArrayValue(2, 4, 6),
+		inter.Globals["evens"].GetValue(),
+	)
+
+	assert.Equal(t,
+		intArrayValue(),
+		inter.Globals["none"].GetValue(),
+	)
+
+	assert.Equal(t,
+		intArrayValue(1, 2, 3, 4, 5, 6),
+		inter.Globals["xs"].GetValue(),
+	)
+}
+
+func TestInterpretArrayMap(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3]
+
+      let strings = xs.map(fun (x: Int): String {
+          return x.toString()
+      })
+
+      let fixed: [Int; 2] = [4, 5]
+
+      let doubled = fixed.map(fun (x: Int): Int {
+          return x * 2
+      })
+    `)
+
+	assert.Equal(t,
+		stringArrayValue("1", "2", "3"),
+		inter.Globals["strings"].GetValue(),
+	)
+
+	assert.Equal(t,
+		intArrayValue(8, 10),
+		inter.Globals["doubled"].GetValue(),
+	)
+}
+
+func TestInterpretArrayReduce(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3, 4]
+
+      let sum = xs.reduce(initial: 0, fun (acc: Int, x: Int): Int {
+          return acc + x
+      })
+
+      let joined = xs.reduce(initial: "", fun (acc: String, x: Int): String {
+          return acc.concat(x.toString())
+      })
+
+      let empty = [0].slice(from: 0, upTo: 0).reduce(initial: 42, fun (acc: Int, x: Int): Int {
+          return acc + x
+      })
+    `)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(10),
+		inter.Globals["sum"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("1234"),
+		inter.Globals["joined"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(42),
+		inter.Globals["empty"].GetValue(),
+	)
+}
+
+func TestInterpretArrayFirstIndex(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = ["a", "b", "c", "b"]
+
+      let b = xs.firstIndex(of: "b")
+      let d = xs.firstIndex(of: "d")
+    `)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewIntValueFromInt64(1),
+		),
+		inter.Globals["b"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["d"].GetValue(),
+	)
+}
+
+func TestInterpretArrayReverse(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3]
+      let reversed = xs.reverse()
+    `)
+
+	assert.Equal(t,
+		intArrayValue(3, 2, 1),
+		inter.Globals["reversed"].GetValue(),
+	)
+
+	assert.Equal(t,
+		intArrayValue(1, 2, 3),
+		inter.Globals["xs"].GetValue(),
+	)
+}
+
+func TestInterpretArraySlice(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3, 4]
+
+      let middle = xs.slice(from: 1, upTo: 3)
+      let empty = xs.slice(from: 4, upTo: 4)
+
+      fun invalid(): [Int] {
+          return xs.slice(from: 3, upTo: 5)
+      }
+
+      fun invalidOrder(): [Int] {
+          return xs.slice(from: 2, upTo: 1)
+      }
+    `)
+
+	assert.Equal(t,
+		intArrayValue(2, 3),
+		inter.Globals["middle"].GetValue(),
+	)
+
+	assert.Equal(t,
+		intArrayValue(),
+		inter.Globals["empty"].GetValue(),
+	)
+
+	for _, name := range []string{"invalid", "invalidOrder"} {
+		_, err := inter.Invoke(name)
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.ArraySliceIndicesError{})
+	}
+}
+
+func TestInterpretArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): [String] {
+          let xs = ["bb", "c", "aaa", "d", "ee"]
+
+          // Sort by length: elements of the same length keep their order
+          xs.sort(by: fun (a: String, b: String): Bool {
+              return a.length < b.length
+          })
+
+          return xs
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		stringArrayValue("c", "d", "bb", "ee", "aaa"),
+		value,
+	)
+}
+
+func TestInterpretDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): [String] {
+          let dict = {"a": 1, "b": 2, "c": 3}
+          let keys: [String] = []
+
+          dict.forEachKey(fun (key: String): Bool {
+              keys.append(key)
+              return key != "b"
+          })
+
+          return keys
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		stringArrayValue("a", "b"),
+		value,
+	)
+}
+
+func TestInterpretDictionaryFilter(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let dict = {"a": 1, "b": 2, "c": 3}
+
+      let filtered = dict.filter(fun (key: String, value: Int): Bool {
+          return key != "b" && value < 3
+      })
+
+      let keys = filtered.keys
+      let values = filtered.values
+      let length = dict.length
+    `)
+
+	assert.Equal(t,
+		stringArrayValue("a"),
+		inter.Globals["keys"].GetValue(),
+	)
+
+	assert.Equal(t,
+		intArrayValue(1),
+		inter.Globals["values"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(3),
+		inter.Globals["length"].GetValue(),
+	)
+}
+
+func TestInterpretArrayFunctionalOperationsMetering(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := checker.ParseAndCheck(t, `
+      fun isEven(_ x: Int): Bool {
+          return x % 2 == 0
+      }
+
+      fun test() {
+          let xs = [1, 2, 3, 4]
+          xs.filter(isEven)
+          xs.reverse()
+          {"a": 1, "b": 2}.forEachKey(fun (key: String): Bool {
+              return true
+          })
+      }
+    `)
+	require.NoError(t, err)
+
+	iterations := 0
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithOnLoopIterationHandler(
+			func(_ *interpreter.Interpreter, _ int) {
+				iterations++
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, iterations)
+}
@@ -20,12 +20,15 @@ package interpreter_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/checker"
 )
 
 func TestInterpretToString(t *testing.T) {
@@ -66,6 +69,12 @@ func TestInterpretToString(t *testing.T) {
 
 	for _, ty := range sema.AllFixedPointTypes {
 
+		expected := "12.34000000"
+		switch ty.(type) {
+		case *sema.Fix128Type, *sema.UFix128Type:
+			expected = "12.340000000000000000000000"
+		}
+
 		t.Run(ty.String(), func(t *testing.T) {
 
 			inter := parseCheckAndInterpret(t,
@@ -79,7 +88,7 @@ func TestInterpretToString(t *testing.T) {
 			)
 
 			assert.Equal(t,
-				interpreter.NewStringValue("12.34000000"),
+				interpreter.NewStringValue(expected),
 				inter.Globals["y"].GetValue(),
 			)
 		})
@@ -263,12 +272,23 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 			"-1.0":  {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			"0.0":   {0},
+			"42.0":  {34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+			"-1.0":  {255, 44, 61, 228, 49, 51, 18, 95, 0, 0, 0},
+		},
 		// UFix*
 		"UFix64": {
 			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0},
 			"42.0":  {0, 0, 0, 0, 250, 86, 234, 0},
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			"0.0":   {0},
+			"42.0":  {34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+		},
 	}
 
 	// Ensure the test cases are complete
@@ -311,3 +331,168 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 		}
 	}
 }
+
+func TestInterpretFromString(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, ty sema.Type, input string, expected interpreter.Value) {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+                  let res = %s.fromString(%q)
+                `,
+				ty,
+				input,
+			),
+		)
+
+		assert.Equal(t,
+			expected,
+			inter.Globals["res"].GetValue(),
+		)
+	}
+
+	for _, ty := range sema.AllIntegerTypes {
+
+		switch ty.(type) {
+		case *sema.IntegerType, *sema.SignedIntegerType:
+			continue
+		}
+
+		ty := ty
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			test(t, ty, "50", interpreter.NewSomeValueOwningNonCopying(
+				testIntegerTypesAndValues[ty.String()],
+			))
+
+			test(t, ty, "0x32", interpreter.NewSomeValueOwningNonCopying(
+				testIntegerTypesAndValues[ty.String()],
+			))
+
+			for _, invalid := range []string{
+				"", "abc", "50.0", "50 + 1",
+				" 50", "50 ", "50\n", "50 // x", "50/* x */", "/* x */50", "(50)", "--50",
+			} {
+				test(t, ty, invalid, interpreter.NilValue{})
+			}
+
+			maxInt := ty.(sema.IntegerRangedType).MaxInt()
+			if maxInt != nil {
+				tooLarge := new(big.Int).Add(maxInt, big.NewInt(1))
+				test(t, ty, tooLarge.String(), interpreter.NilValue{})
+			}
+		})
+	}
+
+	for _, ty := range sema.AllFixedPointTypes {
+
+		switch ty.(type) {
+		case *sema.FixedPointType, *sema.SignedFixedPointType:
+			continue
+		}
+
+		ty := ty
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			test(t, ty, "50.0", interpreter.NewSomeValueOwningNonCopying(
+				testFixedPointValues[ty.String()],
+			))
+
+			for _, invalid := range []string{
+				"", "abc", "50", "50.", "1000000000000000.0",
+				" 50.0", "50.0 ", "50.0 // x", "50.0/* x */",
+			} {
+				test(t, ty, invalid, interpreter.NilValue{})
+			}
+		})
+	}
+
+	t.Run("negative", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, &sema.Int8Type{}, "-128", interpreter.NewSomeValueOwningNonCopying(
+			interpreter.Int8Value(-128),
+		))
+
+		test(t, &sema.Int8Type{}, "-129", interpreter.NilValue{})
+
+		test(t, &sema.UInt8Type{}, "-1", interpreter.NilValue{})
+
+		test(t, &sema.Fix64Type{}, "-1.5", interpreter.NewSomeValueOwningNonCopying(
+			interpreter.Fix64Value(-150000000),
+		))
+
+		test(t, &sema.UFix64Type{}, "-1.5", interpreter.NilValue{})
+	})
+
+	t.Run("scale", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, &sema.UFix64Type{}, "0.000000001", interpreter.NilValue{})
+	})
+
+	t.Run("Address", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, &sema.AddressType{}, "0x1", interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewAddressValueFromBytes([]byte{0x1}),
+		))
+
+		for _, invalid := range []string{
+			"", "abc", "0x1_0000_0000_0000_0000", "1.0",
+			" 0x1", "0x1 ", "0x1 // x", "0x1.foo",
+		} {
+			test(t, &sema.AddressType{}, invalid, interpreter.NilValue{})
+		}
+	})
+}
+
+func TestInterpretFromStringComputation(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := checker.ParseAndCheck(t, `
+      fun test() {
+          Int.fromString("12345")
+          UFix64.fromString("1.5 // comment")
+          Address.fromString("0x1")
+      }
+    `)
+	require.NoError(t, err)
+
+	var intensities []uint
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithOnComputationHandler(
+			func(_ *interpreter.Interpreter, intensity uint) {
+				intensities = append(intensities, intensity)
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]uint{5, 14, 3},
+		intensities,
+	)
+}
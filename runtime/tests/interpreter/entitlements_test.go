@@ -0,0 +1,259 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/checker"
+)
+
+func TestInterpretEntitlementCapability(t *testing.T) {
+
+	t.Parallel()
+
+	inter, _ := testAccount(
+		t,
+		true,
+		`
+          entitlement Withdraw
+
+          resource Vault {
+              access(Withdraw) var balance: Int
+
+              init() {
+                  self.balance = 42
+              }
+
+              access(Withdraw) fun withdraw(): Int {
+                  return self.balance
+              }
+          }
+
+          fun saveAndLink() {
+              account.save(<-create Vault(), to: /storage/vault)
+
+              account.link<&Vault>(/public/read, target: /storage/vault)
+              account.link<auth(Withdraw) &Vault>(/public/withdraw, target: /storage/vault)
+          }
+
+          fun withdraw(): Int {
+              return account.getCapability(/public/withdraw).borrow<auth(Withdraw) &Vault>()!.withdraw()
+          }
+
+          fun withdrawFromRead(): auth(Withdraw) &Vault? {
+              return account.getCapability(/public/read).borrow<auth(Withdraw) &Vault>()
+          }
+
+          fun read(): &Vault? {
+              return account.getCapability(/public/withdraw).borrow<&Vault>()
+          }
+        `,
+	)
+
+	_, err := inter.Invoke("saveAndLink")
+	require.NoError(t, err)
+
+	t.Run("borrow with entitlement", func(t *testing.T) {
+
+		value, err := inter.Invoke("withdraw")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.NewIntValueFromInt64(42), value)
+	})
+
+	t.Run("borrow with entitlement not granted by link", func(t *testing.T) {
+
+		value, err := inter.Invoke("withdrawFromRead")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.NilValue{}, value)
+	})
+
+	t.Run("borrow without entitlement", func(t *testing.T) {
+
+		value, err := inter.Invoke("read")
+		require.NoError(t, err)
+
+		require.IsType(t, &interpreter.SomeValue{}, value)
+	})
+}
+
+func TestInterpretEntitlementReferenceCast(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      entitlement E
+      entitlement F
+
+      struct S {}
+
+      let s = S()
+
+      let unentitled: AnyStruct = &s as &S
+      let entitled: AnyStruct = &s as auth(E) &S
+
+      let unentitledAsEntitled = unentitled as? auth(E) &S
+      let entitledAsEntitled = entitled as? auth(E) &S
+      let entitledAsOtherEntitled = entitled as? auth(F) &S
+      let entitledAsUnentitled = entitled as? &S
+    `)
+
+	require.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["unentitledAsEntitled"].GetValue(),
+	)
+
+	require.IsType(t,
+		&interpreter.SomeValue{},
+		inter.Globals["entitledAsEntitled"].GetValue(),
+	)
+
+	require.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["entitledAsOtherEntitled"].GetValue(),
+	)
+
+	require.IsType(t,
+		&interpreter.SomeValue{},
+		inter.Globals["entitledAsUnentitled"].GetValue(),
+	)
+}
+
+func TestInterpretEntitlementNestedAccess(t *testing.T) {
+
+	t.Parallel()
+
+	const declarations = `
+      entitlement Withdraw
+
+      struct Vault {
+          access(Withdraw) fun withdraw(): Int {
+              return 42
+          }
+      }
+
+      struct Holder {
+          pub let vault: Vault
+          pub let vaults: [Vault]
+          pub let dict: {String: Vault}
+
+          init() {
+              self.vault = Vault()
+              self.vaults = [Vault()]
+              self.dict = {"a": Vault()}
+          }
+      }
+
+      let holder = Holder()
+    `
+
+	t.Run("entitled", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t,
+			declarations+`
+              let ref = &holder as auth(Withdraw) &Holder
+
+              fun index(): Int {
+                  return ref.vaults[0].withdraw()
+              }
+
+              fun optionalChaining(): Int? {
+                  return ref.dict["a"]?.withdraw()
+              }
+
+              fun reference(): Int {
+                  let vaultRef = &ref.vault as auth(Withdraw) &Vault
+                  return vaultRef.withdraw()
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("index")
+		require.NoError(t, err)
+		require.Equal(t, interpreter.NewIntValueFromInt64(42), value)
+
+		value, err = inter.Invoke("optionalChaining")
+		require.NoError(t, err)
+		require.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(42)),
+			value,
+		)
+
+		value, err = inter.Invoke("reference")
+		require.NoError(t, err)
+		require.Equal(t, interpreter.NewIntValueFromInt64(42), value)
+	})
+
+	test := func(name string, code string, expectedErrorType error) {
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_ = parseCheckAndInterpretWithOptions(t,
+				declarations+`
+                  let ref = &holder as &Holder
+
+                  fun test() {
+                      `+code+`
+                  }
+                `,
+				ParseCheckAndInterpretOptions{
+					HandleCheckerError: func(err error) {
+						errs := checker.ExpectCheckerErrors(t, err, 1)
+
+						require.IsType(t, expectedErrorType, errs[0])
+					},
+				},
+			)
+		})
+	}
+
+	test("unentitled index", "ref.vaults[0].withdraw()", &sema.InvalidEntitlementAccessError{})
+	test("unentitled optional chaining", `ref.dict["a"]?.withdraw()`, &sema.InvalidEntitlementAccessError{})
+	test("unentitled reference", "&ref.vault as auth(Withdraw) &Vault", &sema.InvalidReferenceEntitlementsError{})
+
+	t.Run("unentitled reference cast", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t,
+			declarations+`
+              let ref = &holder as &Holder
+
+              fun test(): auth(Withdraw) &Vault? {
+                  let vaultRef: AnyStruct = &ref.vault as &Vault
+                  return vaultRef as? auth(Withdraw) &Vault
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+		require.Equal(t, interpreter.NilValue{}, value)
+	})
+}
@@ -0,0 +1,196 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretExtension(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          extension Notes for S {
+              pub(set) var note: String?
+
+              pub fun describe(): String {
+                  return self.id.toString().concat(": ").concat(self.note ?? "none")
+              }
+          }
+
+          let s = S(id: 1)
+
+          let before = s.describe()
+
+          fun test(): String {
+              s.note = "first"
+              return s.describe()
+          }
+        `)
+
+		assert.Equal(t,
+			interpreter.NewStringValue("1: none"),
+			inter.Globals["before"].GetValue(),
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewStringValue("1: first"),
+			value,
+		)
+	})
+
+	t.Run("struct copy", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {}
+
+          extension Notes for S {
+              pub(set) var note: String?
+          }
+
+          fun test(): [String?] {
+              let s1 = S()
+              s1.note = "a"
+              let s2 = s1
+              s2.note = "b"
+              return [s1.note, s2.note]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewSomeValueOwningNonCopying(interpreter.NewStringValue("a")),
+				interpreter.NewSomeValueOwningNonCopying(interpreter.NewStringValue("b")),
+			),
+			value,
+		)
+	})
+
+	t.Run("resource interface, reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource interface NFT {
+              pub let id: UInt64
+          }
+
+          resource R: NFT {
+              pub let id: UInt64
+
+              init(id: UInt64) {
+                  self.id = id
+              }
+          }
+
+          extension Rarity for NFT {
+              pub var tier: String?
+
+              pub fun setTier(_ tier: String) {
+                  self.tier = tier
+              }
+          }
+
+          fun test(): String? {
+              let r <- create R(id: 2) as @R{NFT}
+              let ref = &r as &{NFT}
+              ref.setTier("rare")
+              let tier = r.tier
+              destroy r
+              return tier
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(interpreter.NewStringValue("rare")),
+			value,
+		)
+	})
+
+	t.Run("contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithOptions(t, `
+          contract C {
+
+              pub struct S {}
+
+              pub extension E for S {
+                  pub fun get(): Int {
+                      return C.value
+                  }
+              }
+
+              pub let value: Int
+
+              init() {
+                  self.value = 42
+              }
+          }
+
+          fun test(): Int {
+              return C.S().get()
+          }
+        `,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					makeContractValueHandler(nil, nil, nil),
+				},
+			},
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(42),
+			value,
+		)
+	})
+}
@@ -51,8 +51,14 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 	tests := map[string]interpreter.Value{
 		// Fix*
 		"Fix64": interpreter.Fix64Value(123000000),
+		"Fix128": interpreter.NewFix128ValueFromBigInt(
+			new(big.Int).Mul(big.NewInt(123), new(big.Int).Quo(sema.Fix128FactorBig, big.NewInt(100))),
+		),
 		// UFix*
 		"UFix64": interpreter.UFix64Value(123000000),
+		"UFix128": interpreter.NewUFix128ValueFromBigInt(
+			new(big.Int).Mul(big.NewInt(123), new(big.Int).Quo(sema.Fix128FactorBig, big.NewInt(100))),
+		),
 	}
 
 	for _, fixedPointType := range sema.AllFixedPointTypes {
@@ -101,8 +107,10 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 }
 
 var testFixedPointValues = map[string]interpreter.Value{
-	"Fix64":  interpreter.Fix64Value(50 * sema.Fix64Factor),
-	"UFix64": interpreter.UFix64Value(50 * sema.Fix64Factor),
+	"Fix64":   interpreter.Fix64Value(50 * sema.Fix64Factor),
+	"UFix64":  interpreter.UFix64Value(50 * sema.Fix64Factor),
+	"Fix128":  interpreter.NewFix128ValueWithInteger(big.NewInt(50)),
+	"UFix128": interpreter.NewUFix128ValueWithInteger(big.NewInt(50)),
 }
 
 func init() {
@@ -494,3 +502,198 @@ func TestInterpretFixedPointConversions(t *testing.T) {
 		}
 	})
 }
+
+func TestInterpretFix128(t *testing.T) {
+
+	t.Parallel()
+
+	fix128 := func(s string) *big.Int {
+		value, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			panic(fmt.Sprintf("invalid integer: %s", s))
+		}
+		return value
+	}
+
+	t.Run("literals", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a = 0.123456789012345678901234
+          let b = -0.000000000000000000000001
+          let c: UFix128 = 1.5
+          let d = 1000000000000.5
+        `)
+
+		assert.Equal(t,
+			interpreter.NewUFix128ValueFromBigInt(fix128("123456789012345678901234")),
+			inter.Globals["a"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewFix128ValueFromBigInt(big.NewInt(-1)),
+			inter.Globals["b"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewUFix128ValueFromBigInt(fix128("1500000000000000000000000")),
+			inter.Globals["c"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewUFix128ValueFromBigInt(fix128("1000000000000500000000000000000000000")),
+			inter.Globals["d"].GetValue(),
+		)
+	})
+
+	t.Run("arithmetic", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a: Fix128 = 1.5
+          let b: Fix128 = -0.25
+          let sum = a + b
+          let difference = a - b
+          let product = a * b
+          let quotient = a / b
+          let c: Fix128 = 0.4
+          let remainder = a % c
+          let precise = UFix128(1.0) / UFix128(3.0)
+        `)
+
+		assert.Equal(t,
+			interpreter.NewFix128ValueFromBigInt(fix128("1250000000000000000000000")),
+			inter.Globals["sum"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewFix128ValueFromBigInt(fix128("1750000000000000000000000")),
+			inter.Globals["difference"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewFix128ValueFromBigInt(fix128("-375000000000000000000000")),
+			inter.Globals["product"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewFix128ValueWithInteger(big.NewInt(-6)),
+			inter.Globals["quotient"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewFix128ValueFromBigInt(fix128("300000000000000000000000")),
+			inter.Globals["remainder"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewUFix128ValueFromBigInt(fix128("333333333333333333333333")),
+			inter.Globals["precise"].GetValue(),
+		)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix128 {
+              let x: UFix128 = 340282366920938.463463374607431768211455
+              return x + 0.000000000000000000000001
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("underflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix128 {
+              let x: UFix128 = 0.5
+              let y: UFix128 = 1.0
+              return x - y
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.UnderflowError{})
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix128 {
+              let x: Fix128 = 1.0
+              let y: Fix128 = 0.0
+              return x / y
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+	})
+
+	t.Run("conversions", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a: UFix128 = 12.345678901234567890123456
+          let b = UFix64(a)
+          let c = Fix128(b)
+          let d = UInt8(a)
+          let e = Fix128(Int256(-42))
+        `)
+
+		assert.Equal(t,
+			interpreter.UFix64Value(1234567890),
+			inter.Globals["b"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewFix128ValueFromBigInt(fix128("12345678900000000000000000")),
+			inter.Globals["c"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.UInt8Value(12),
+			inter.Globals["d"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewFix128ValueWithInteger(big.NewInt(-42)),
+			inter.Globals["e"].GetValue(),
+		)
+	})
+
+	t.Run("invalid UFix128 to UFix64", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix64 {
+              let x: UFix128 = 1000000000000.0
+              return UFix64(x)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+}
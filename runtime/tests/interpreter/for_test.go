@@ -151,3 +151,99 @@ func TestInterpretForStatementEmpty(t *testing.T) {
 		value,
 	)
 }
+
+func TestInterpretLabeledLoops(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("break outer loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var sum = 0
+               outer: for x in [1, 2, 3] {
+                   for y in [10, 20, 30] {
+                       if x == 2 && y == 20 {
+                           break outer
+                       }
+                       sum = sum + x * y
+                   }
+               }
+               return sum
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		// 1*10 + 1*20 + 1*30 + 2*10
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(80),
+			value,
+		)
+	})
+
+	t.Run("continue outer loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var sum = 0
+               var x = 0
+               outer: while x < 3 {
+                   x = x + 1
+                   inner: for y in [10, 20, 30] {
+                       if y == 20 {
+                           continue outer
+                       }
+                       sum = sum + x * y
+                       continue inner
+                   }
+                   sum = sum + 1000
+               }
+               return sum
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		// 1*10 + 2*10 + 3*10
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(60),
+			value,
+		)
+	})
+
+	t.Run("break outer loop in switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var sum = 0
+               outer: for x in [1, 2, 3] {
+                   switch x {
+                   case 1:
+                       break
+                   case 2:
+                       break outer
+                   }
+                   sum = sum + x
+               }
+               return sum
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(1),
+			value,
+		)
+	})
+}
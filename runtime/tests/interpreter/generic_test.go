@@ -0,0 +1,242 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretGenericFunctionDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun first<T>(_ values: [T]): T? {
+              if values.length == 0 {
+                  return nil
+              }
+              return values[0]
+          }
+
+          let x = first([1, 2])
+          let y = first<String>([])
+        `)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(1)),
+			inter.Globals["x"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NilValue{},
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("casting to type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun cast<T>(_ value: AnyStruct): T? {
+              return value as? T
+          }
+
+          let x = cast<Int>(1)
+          let y = cast<String>(1)
+        `)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(1)),
+			inter.Globals["x"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NilValue{},
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("type argument in nested invocation and closure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun typeOf<T>(): Type {
+              return Type<T>()
+          }
+
+          fun typeGetter<U>(): ((): Type) {
+              return fun (): Type {
+                  return typeOf<[U]>()
+              }
+          }
+
+          let getter = typeGetter<String>()
+          let x = getter() == Type<[String]>()
+          let y = typeOf<Int>() == Type<Int>()
+        `)
+
+		assert.Equal(t,
+			interpreter.BoolValue(true),
+			inter.Globals["x"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.BoolValue(true),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("resource type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {}
+
+          fun identity<T: @AnyResource>(_ value: @T): @T {
+              return <-value
+          }
+
+          fun test(): Bool {
+              let r <- identity(<-create R())
+              let isR = r.getType() == Type<@R>()
+              destroy r
+              return isR
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.BoolValue(true), value)
+	})
+}
+
+func TestInterpretGenericStructure(t *testing.T) {
+
+	t.Parallel()
+
+	const boxDeclaration = `
+      struct Box<T> {
+          pub let value: T
+
+          init(value: T) {
+              self.value = value
+          }
+
+          pub fun contains<U>(): Bool {
+              return (self.value as? U) != nil
+          }
+
+          pub fun map<U>(_ f: ((T): U)): Box<U> {
+              return Box(value: f(self.value))
+          }
+      }
+    `
+
+	t.Run("members", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, boxDeclaration+`
+          let box = Box(value: 1)
+          let value = box.value
+          let containsInt = box.contains<Int>()
+          let containsString = box.contains<String>()
+          let mapped = box.map(fun (value: Int): String {
+              return value.toString()
+          }).value
+        `)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(1),
+			inter.Globals["value"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.BoolValue(true),
+			inter.Globals["containsInt"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.BoolValue(false),
+			inter.Globals["containsString"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewStringValue("1"),
+			inter.Globals["mapped"].GetValue(),
+		)
+	})
+
+	t.Run("type arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, boxDeclaration+`
+          let box = Box(value: "a")
+          let identifier = box.getType().identifier
+          let copyIdentifier = box.map(fun (value: String): [String] {
+              return [value]
+          }).getType().identifier
+        `)
+
+		assert.Equal(t,
+			interpreter.NewStringValue("S.test.Box<String>"),
+			inter.Globals["identifier"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.NewStringValue("S.test.Box<[String]>"),
+			inter.Globals["copyIdentifier"].GetValue(),
+		)
+	})
+
+	t.Run("casting", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, boxDeclaration+`
+          let any: AnyStruct = Box(value: 1)
+          let x = (any as? Box<Int>) != nil
+          let y = (any as? Box<String>) != nil
+        `)
+
+		assert.Equal(t,
+			interpreter.BoolValue(true),
+			inter.Globals["x"].GetValue(),
+		)
+
+		assert.Equal(t,
+			interpreter.BoolValue(false),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+}
@@ -0,0 +1,310 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretForStatementInclusiveRange(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("ascending", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var sum = 0
+               for i in InclusiveRange(1, 10) {
+                   sum = sum + i
+               }
+               return sum
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(55),
+			value,
+		)
+	})
+
+	t.Run("descending with step", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): [Int8] {
+               let values: [Int8] = []
+               for i in InclusiveRange(Int8(5), Int8(-2), step: Int8(-3)) {
+                   values.append(i)
+               }
+               return values
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.Int8Value(5),
+				interpreter.Int8Value(2),
+				interpreter.Int8Value(-1),
+			),
+			value,
+		)
+	})
+
+	t.Run("single value", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var count = 0
+               for i in InclusiveRange(3, 3, step: -1) {
+                   count = count + 1
+               }
+               return count
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("bounds of type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var count = 0
+               for i in InclusiveRange(UInt8(0), UInt8(255)) {
+                   count = count + 1
+               }
+               for i in InclusiveRange(Word8(0), Word8(255)) {
+                   count = count + 1
+               }
+               return count
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(512),
+			value,
+		)
+	})
+
+	t.Run("break and continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): [Int] {
+               let values: [Int] = []
+               for i in InclusiveRange(1, 100) {
+                   if i % 2 == 0 {
+                       continue
+                   }
+                   if i > 6 {
+                       break
+                   }
+                   values.append(i)
+               }
+               return values
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewIntValueFromInt64(1),
+				interpreter.NewIntValueFromInt64(3),
+				interpreter.NewIntValueFromInt64(5),
+			),
+			value,
+		)
+	})
+
+	t.Run("return", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): UInt64 {
+               for i in InclusiveRange(UInt64(10), UInt64(20)) {
+                   if i > UInt64(12) {
+                       return i
+                   }
+               }
+               return 0
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.UInt64Value(13),
+			value,
+		)
+	})
+}
+
+func TestInterpretInclusiveRangeMembers(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let r = InclusiveRange(UInt16(1), UInt16(10), step: UInt16(3))
+      let start = r.start
+      let end = r.end
+      let step = r.step
+      let defaultStep = InclusiveRange(-5, 5).step
+    `)
+
+	assert.Equal(t,
+		interpreter.UInt16Value(1),
+		inter.Globals["start"].GetValue(),
+	)
+	assert.Equal(t,
+		interpreter.UInt16Value(10),
+		inter.Globals["end"].GetValue(),
+	)
+	assert.Equal(t,
+		interpreter.UInt16Value(3),
+		inter.Globals["step"].GetValue(),
+	)
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(1),
+		inter.Globals["defaultStep"].GetValue(),
+	)
+}
+
+func TestInterpretInclusiveRangeContains(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let r = InclusiveRange(1, 10, step: 3)
+      let a = r.contains(1)
+      let b = r.contains(7)
+      let c = r.contains(10)
+      let d = r.contains(8)
+      let e = r.contains(13)
+      let f = r.contains(-2)
+
+      let descending = InclusiveRange(Int32(10), Int32(-10), step: Int32(-5))
+      let g = descending.contains(-5)
+      let h = descending.contains(-10)
+      let i = descending.contains(-11)
+      let j = descending.contains(4)
+    `)
+
+	for name, expected := range map[string]bool{ //nolint:maprangecheck
+		"a": true,
+		"b": true,
+		"c": true,
+		"d": false,
+		"e": false,
+		"f": false,
+		"g": true,
+		"h": true,
+		"i": false,
+		"j": false,
+	} {
+		assert.Equal(t,
+			interpreter.BoolValue(expected),
+			inter.Globals[name].GetValue(),
+			name,
+		)
+	}
+}
+
+func TestInterpretInclusiveRangeConstructionError(t *testing.T) {
+
+	t.Parallel()
+
+	for _, code := range []string{
+		`InclusiveRange(1, 10, step: 0)`,
+		`InclusiveRange(1, 10, step: -1)`,
+		`InclusiveRange(10, 1)`,
+		`InclusiveRange<Integer>(1, UInt8(10))`,
+	} {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test() {
+              let r = `+code+`
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.InclusiveRangeConstructionError{}, code)
+	}
+}
+
+func TestInterpretInclusiveRangeLoopIterationMetering(t *testing.T) {
+
+	t.Parallel()
+
+	var iterations int
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		`
+          fun test() {
+              for i in InclusiveRange(1, 5) {}
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithOnLoopIterationHandler(
+					func(_ *interpreter.Interpreter, _ int) {
+						iterations++
+					},
+				),
+			},
+		},
+	)
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, iterations)
+}
@@ -676,6 +676,38 @@ func TestInterpretStringSlicing(t *testing.T) {
 	}
 }
 
+func TestInterpretStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let id: UInt64 = 42
+      let recipient: Address = 0x1
+      let amount: UFix64 = 1.5
+      let name = "Alice"
+      let initial: Character = "A"
+      let flag = true
+      let path = /storage/test
+
+      fun format(_ value: Int): String {
+          return "<\(value)>"
+      }
+
+      let x = "Minted \(id) for \(recipient): \(amount), \(name), \(initial), \(flag), \(path)"
+      let y = "\(-1 + 2)\(format(3))\("\(4)")\n"
+    `)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("Minted 42 for 0x1: 1.50000000, Alice, A, true, /storage/test"),
+		inter.Globals["x"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("1<3>4\n"),
+		inter.Globals["y"].GetValue(),
+	)
+}
+
 func TestInterpretReturnWithoutExpression(t *testing.T) {
 
 	t.Parallel()
@@ -1274,6 +1306,61 @@ func TestInterpretConditionalOperator(t *testing.T) {
 	)
 }
 
+func TestInterpretCommonSuperTypeInference(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let x = true ? 1 : nil
+      let y = false ? nil : 2
+      let z = [nil, 3]
+
+      struct interface I {
+          fun test(): Int
+      }
+
+      struct S1: I {
+          fun test(): Int { return 1 }
+      }
+
+      struct S2: I {
+          fun test(): Int { return 2 }
+      }
+
+      let values = [S1(), S2()]
+      let sum = values[0].test() + values[1].test()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewIntValueFromInt64(1),
+		),
+		inter.Globals["x"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewIntValueFromInt64(2),
+		),
+		inter.Globals["y"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NilValue{},
+			interpreter.NewSomeValueOwningNonCopying(
+				interpreter.NewIntValueFromInt64(3),
+			),
+		),
+		inter.Globals["z"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(3),
+		inter.Globals["sum"].GetValue(),
+	)
+}
+
 func TestInterpretFunctionBindingInFunction(t *testing.T) {
 
 	t.Parallel()
@@ -5898,9 +5985,11 @@ func TestInterpretEmitEventParameterTypes(t *testing.T) {
 		"Word32": {value: interpreter.Word32Value(42)},
 		"Word64": {value: interpreter.Word64Value(42)},
 		// Fix*
-		"Fix64": {value: interpreter.Fix64Value(123000000)},
+		"Fix64":  {value: interpreter.Fix64Value(123000000)},
+		"Fix128": {value: interpreter.NewFix128ValueWithInteger(big.NewInt(123))},
 		// UFix*
-		"UFix64": {value: interpreter.UFix64Value(123000000)},
+		"UFix64":  {value: interpreter.UFix64Value(123000000)},
+		"UFix128": {value: interpreter.NewUFix128ValueWithInteger(big.NewInt(123))},
 		// Struct
 		"S": {
 			literal: `S()`,
@@ -6818,6 +6907,8 @@ func TestInterpretContractAccountFieldUse(t *testing.T) {
 									return 0
 								},
 								returnZero,
+								returnZeroUFix64,
+								returnZeroUFix64,
 								panicFunction,
 								panicFunction,
 								interpreter.AuthAccountContractsValue{},
@@ -7639,6 +7730,8 @@ func TestInterpretResourceOwnerFieldUse(t *testing.T) {
 				return 0
 			},
 			returnZero,
+			returnZeroUFix64,
+			returnZeroUFix64,
 			panicFunction,
 			panicFunction,
 			interpreter.AuthAccountContractsValue{},
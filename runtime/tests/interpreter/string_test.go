@@ -0,0 +1,268 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/tests/checker"
+)
+
+func stringArrayValue(strs ...string) *interpreter.ArrayValue {
+	values := make([]interpreter.Value, len(strs))
+	for i, str := range strs {
+		values[i] = interpreter.NewStringValue(str)
+	}
+	return interpreter.NewArrayValueUnownedNonCopying(values...)
+}
+
+func TestInterpretStringSplit(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = "a, b, c".split(separator: ", ")
+      let b = "abc".split(separator: ",")
+      let c = ",a,,b,".split(separator: ",")
+      let d = "cafe\u{301}!".split(separator: "")
+      let e = "cafe\u{301}".split(separator: "e")
+      let f = "".split(separator: ",")
+    `)
+
+	for name, expected := range map[string]*interpreter.ArrayValue{
+		"a": stringArrayValue("a", "b", "c"),
+		"b": stringArrayValue("abc"),
+		"c": stringArrayValue("", "a", "", "b", ""),
+		"d": stringArrayValue("c", "a", "f", "é", "!"),
+		// the "e" of the combining character "é" does not match
+		"e": stringArrayValue("café"),
+		"f": stringArrayValue(""),
+	} {
+		assert.Equal(t,
+			expected,
+			inter.Globals[name].GetValue(),
+			name,
+		)
+	}
+}
+
+func TestInterpretStringJoin(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = String.join(["a", "b", "c"], separator: ", ")
+      let b = String.join([], separator: ", ")
+      let c = String.join("a-b-c".split(separator: "-"), separator: "+")
+    `)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("a, b, c"),
+		inter.Globals["a"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue(""),
+		inter.Globals["b"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("a+b+c"),
+		inter.Globals["c"].GetValue(),
+	)
+}
+
+func TestInterpretStringSearch(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let s = "caf\u{E9} cafe\u{301} abc"
+
+      let containsABC = s.contains("abc")
+      let containsX = s.contains("x")
+      let containsEmpty = s.contains("")
+      let containsE = "cafe\u{301}".contains("e")
+
+      let indexOfABC = s.index(of: "abc")
+      let indexOfCafe = s.index(of: "cafe\u{301}")
+      let indexOfX = s.index(of: "x")
+      let indexOfEmpty = s.index(of: "")
+    `)
+
+	for name, expected := range map[string]interpreter.Value{
+		"containsABC":   interpreter.BoolValue(true),
+		"containsX":     interpreter.BoolValue(false),
+		"containsEmpty": interpreter.BoolValue(true),
+		"containsE":     interpreter.BoolValue(false),
+		"indexOfABC":    interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(10)),
+		"indexOfCafe":   interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(5)),
+		"indexOfX":      interpreter.NilValue{},
+		"indexOfEmpty":  interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(0)),
+	} {
+		assert.Equal(t,
+			expected,
+			inter.Globals[name].GetValue(),
+			name,
+		)
+	}
+}
+
+func TestInterpretStringReplaceAll(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = "one fish two fish".replaceAll(of: "fish", with: "cat")
+      let b = "abc".replaceAll(of: "x", with: "y")
+      let c = "abc".replaceAll(of: "", with: "-")
+      let d = "cafe\u{301} cafe".replaceAll(of: "e", with: "E")
+    `)
+
+	for name, expected := range map[string]string{
+		"a": "one cat two cat",
+		"b": "abc",
+		"c": "-a-b-c-",
+		"d": "café cafE",
+	} {
+		assert.Equal(t,
+			interpreter.NewStringValue(expected),
+			inter.Globals[name].GetValue(),
+			name,
+		)
+	}
+}
+
+func TestInterpretStringCase(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let lower = "Hello, WORLD! \u{C4}".toLower()
+      let upper = "Hello, world! \u{E4}".toUpper()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("hello, world! ä"),
+		inter.Globals["lower"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("HELLO, WORLD! Ä"),
+		inter.Globals["upper"].GetValue(),
+	)
+}
+
+func TestInterpretStringUTF8(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let bytes = "a\u{E9}".utf8
+      let decoded = String.fromUTF8(bytes)
+      let invalid = String.fromUTF8("fffe".decodeHex())
+      let hex = String.encodeHex(bytes)
+      let roundTrip = String.encodeHex(hex.decodeHex())
+      let empty = String()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.UInt8Value(0x61),
+			interpreter.UInt8Value(0xc3),
+			interpreter.UInt8Value(0xa9),
+		),
+		inter.Globals["bytes"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewStringValue("aé"),
+		),
+		inter.Globals["decoded"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["invalid"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("61c3a9"),
+		inter.Globals["hex"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("61c3a9"),
+		inter.Globals["roundTrip"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue(""),
+		inter.Globals["empty"].GetValue(),
+	)
+}
+
+func TestInterpretStringComputation(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := checker.ParseAndCheck(t, `
+      fun test() {
+          let s = "abcdefghij"
+          s.split(separator: ",")
+          s.contains("xyz")
+          s.toUpper()
+          String.join([s, s], separator: "+")
+          s.replaceAll(of: "", with: "xyz")
+          s.replaceAll(of: "c", with: "xyz")
+      }
+    `)
+	require.NoError(t, err)
+
+	var intensities []uint
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithOnComputationHandler(
+			func(_ *interpreter.Interpreter, intensity uint) {
+				intensities = append(intensities, intensity)
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		// replaceAll is metered on the number of matches:
+		// the empty string matches at all 11 boundaries
+		[]uint{11, 13, 10, 22, 10 + 0 + 11*3, 10 + 1 + 1*3},
+		intensities,
+	)
+}
@@ -224,6 +224,8 @@ func TestInterpretTransactions(t *testing.T) {
 				return 0
 			},
 			returnZero,
+			returnZeroUFix64,
+			returnZeroUFix64,
 			panicFunction,
 			panicFunction,
 			interpreter.AuthAccountContractsValue{},
@@ -235,6 +237,8 @@ func TestInterpretTransactions(t *testing.T) {
 				return 0
 			},
 			returnZero,
+			returnZeroUFix64,
+			returnZeroUFix64,
 			panicFunction,
 			panicFunction,
 			interpreter.AuthAccountContractsValue{},
@@ -277,6 +281,8 @@ func TestInterpretTransactions(t *testing.T) {
 					return 0
 				},
 				returnZero,
+				returnZeroUFix64,
+				returnZeroUFix64,
 				panicFunction,
 				panicFunction,
 				interpreter.AuthAccountContractsValue{},
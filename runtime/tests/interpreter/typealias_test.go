@@ -0,0 +1,83 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      pub struct Scoreboard {
+
+          pub typealias Scores = {String: [Int]}
+
+          pub var scores: Scores
+
+          init() {
+              self.scores = {"a": [1, 2]}
+          }
+
+          pub fun add(name: String, score: Int) {
+              let scores: Scores = self.scores
+              scores[name] = [score]
+              self.scores = scores
+          }
+      }
+
+      typealias AllScores = {String: [Int]}
+
+      fun test(): AllScores {
+          let scoreboard = Scoreboard()
+          scoreboard.add(name: "b", score: 3)
+          return scoreboard.scores
+      }
+
+      let identifier = Type<AllScores>().identifier
+
+      typealias Board = Scoreboard
+
+      let isAliasedType = Scoreboard().getType() == Type<Board>()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("{String:[Int]}"),
+		inter.Globals["identifier"].GetValue(),
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["isAliasedType"].GetValue(),
+	)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	require.IsType(t, &interpreter.DictionaryValue{}, value)
+	assert.Equal(t, 2, value.(*interpreter.DictionaryValue).Count())
+}
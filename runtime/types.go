@@ -49,6 +49,7 @@ const (
 	SignatureAlgorithmUnknown         = sema.SignatureAlgorithmUnknown
 	SignatureAlgorithmECDSA_P256      = sema.SignatureAlgorithmECDSA_P256
 	SignatureAlgorithmECDSA_Secp256k1 = sema.SignatureAlgorithmECDSA_Secp256k1
+	SignatureAlgorithmBLS_BLS12_381   = sema.SignatureAlgorithmBLS_BLS12_381
 )
 
 type HashAlgorithm = sema.HashAlgorithm
@@ -58,11 +59,12 @@ type HashAlgorithm = sema.HashAlgorithm
 
 const (
 	// Supported hashing algorithms
-	HashAlgorithmUnknown  = sema.HashAlgorithmUnknown
-	HashAlgorithmSHA2_256 = sema.HashAlgorithmSHA2_256
-	HashAlgorithmSHA2_384 = sema.HashAlgorithmSHA2_384
-	HashAlgorithmSHA3_256 = sema.HashAlgorithmSHA3_256
-	HashAlgorithmSHA3_384 = sema.HashAlgorithmSHA3_384
+	HashAlgorithmUnknown    = sema.HashAlgorithmUnknown
+	HashAlgorithmSHA2_256   = sema.HashAlgorithmSHA2_256
+	HashAlgorithmSHA2_384   = sema.HashAlgorithmSHA2_384
+	HashAlgorithmSHA3_256   = sema.HashAlgorithmSHA3_256
+	HashAlgorithmSHA3_384   = sema.HashAlgorithmSHA3_384
+	HashAlgorithmKECCAK_256 = sema.HashAlgorithmKECCAK_256
 )
 
 type AccountKey struct {
@@ -884,6 +884,84 @@ func (v UFix64) String() string {
 	return format.UFix64(uint64(v))
 }
 
+// Fix128
+
+// Fix128 is a 128-bit signed fixed-point number.
+// The value is the scaled integer representation, i.e. the number multiplied by 10^24
+//
+type Fix128 struct {
+	Value *big.Int
+}
+
+func NewFix128(s string) (Fix128, error) {
+	v, err := fixedpoint.ParseFix128(s)
+	if err != nil {
+		return Fix128{}, err
+	}
+	return Fix128{v}, nil
+}
+
+func (Fix128) isValue() {}
+
+func (Fix128) Type() Type {
+	return Fix128Type{}
+}
+
+func (v Fix128) ToGoValue() interface{} {
+	return v.Big()
+}
+
+func (v Fix128) Big() *big.Int {
+	return v.Value
+}
+
+func (v Fix128) ToBigEndianBytes() []byte {
+	return interpreter.SignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v Fix128) String() string {
+	return format.Fix128(v.Value)
+}
+
+// UFix128
+
+// UFix128 is a 128-bit unsigned fixed-point number.
+// The value is the scaled integer representation, i.e. the number multiplied by 10^24
+//
+type UFix128 struct {
+	Value *big.Int
+}
+
+func NewUFix128(s string) (UFix128, error) {
+	v, err := fixedpoint.ParseUFix128(s)
+	if err != nil {
+		return UFix128{}, err
+	}
+	return UFix128{v}, nil
+}
+
+func (UFix128) isValue() {}
+
+func (UFix128) Type() Type {
+	return UFix128Type{}
+}
+
+func (v UFix128) ToGoValue() interface{} {
+	return v.Big()
+}
+
+func (v UFix128) Big() *big.Int {
+	return v.Value
+}
+
+func (v UFix128) ToBigEndianBytes() []byte {
+	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v UFix128) String() string {
+	return format.UFix128(v.Value)
+}
+
 // Array
 
 type Array struct {
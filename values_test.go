@@ -40,6 +40,8 @@ func TestStringer(t *testing.T) {
 
 	ufix64, _ := NewUFix64("64.01")
 	fix64, _ := NewFix64("-32.11")
+	ufix128, _ := NewUFix128("128.01")
+	fix128, _ := NewFix128("-64.11")
 
 	stringerTests := map[string]testCase{
 		"UInt": {
@@ -118,6 +120,14 @@ func TestStringer(t *testing.T) {
 			value:    fix64,
 			expected: "-32.11000000",
 		},
+		"UFix128": {
+			value:    ufix128,
+			expected: "128.010000000000000000000000",
+		},
+		"Fix128": {
+			value:    fix128,
+			expected: "-64.110000000000000000000000",
+		},
 		"Void": {
 			value:    NewVoid(),
 			expected: "()",
@@ -278,6 +288,16 @@ func TestStringer(t *testing.T) {
 
 func TestToBigEndianBytes(t *testing.T) {
 
+	fix128 := func(s string) Fix128 {
+		v, _ := NewFix128(s)
+		return v
+	}
+
+	ufix128 := func(s string) UFix128 {
+		v, _ := NewUFix128(s)
+		return v
+	}
+
 	typeTests := map[string]map[NumberValue][]byte{
 		// Int*
 		"Int": {
@@ -428,12 +448,23 @@ func TestToBigEndianBytes(t *testing.T) {
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 			Fix64(-1_00000000): {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			fix128("0.0"):   {0},
+			fix128("42.0"):  {34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			fix128("42.24"): {34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+			fix128("-1.0"):  {255, 44, 61, 228, 49, 51, 18, 95, 0, 0, 0},
+		},
 		// UFix*
 		"UFix64": {
 			Fix64(0):           {0, 0, 0, 0, 0, 0, 0, 0},
 			Fix64(42_00000000): {0, 0, 0, 0, 250, 86, 234, 0},
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			ufix128("0.0"):   {0},
+			ufix128("42.0"):  {34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			ufix128("42.24"): {34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+		},
 	}
 
 	// Ensure the test cases are complete